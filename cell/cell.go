@@ -22,6 +22,12 @@ type Option interface {
 }
 
 // Options stores the provided options.
+//
+// Options are applied in the order they are provided, an option provided
+// later overrides the value set by an option provided earlier, see Apply.
+// When combining options of multiple layers, e.g. the background of a
+// container and the colors set by a widget, use Merge which only overrides
+// the values the upper layer actually sets.
 type Options struct {
 	FgColor       Color
 	BgColor       Color
@@ -35,16 +41,45 @@ type Options struct {
 }
 
 // Set allows existing options to be passed as an option.
+// Replaces all the values in other, including the values that are left unset
+// (at their zero value) in these options. Use Merge to override only the set
+// values.
 func (o *Options) Set(other *Options) {
 	*other = *o
 }
 
-// NewOptions returns a new Options instance after applying the provided options.
-func NewOptions(opts ...Option) *Options {
-	o := &Options{}
+// Apply applies the provided options in order. An option provided later
+// overrides the value set by an option provided earlier.
+func (o *Options) Apply(opts ...Option) {
 	for _, opt := range opts {
 		opt.Set(o)
 	}
+}
+
+// Merge overrides the values in these options with the values set in the
+// other options. Only the colors that aren't ColorDefault and the attributes
+// that are enabled in other override the values here, the rest is kept.
+// I.e. the other options are a layer on top of these options.
+func (o *Options) Merge(other *Options) {
+	if !other.FgColor.IsDefault() {
+		o.FgColor = other.FgColor
+	}
+	if !other.BgColor.IsDefault() {
+		o.BgColor = other.BgColor
+	}
+	o.Bold = o.Bold || other.Bold
+	o.Italic = o.Italic || other.Italic
+	o.Underline = o.Underline || other.Underline
+	o.Strikethrough = o.Strikethrough || other.Strikethrough
+	o.Inverse = o.Inverse || other.Inverse
+	o.Blink = o.Blink || other.Blink
+	o.Dim = o.Dim || other.Dim
+}
+
+// NewOptions returns a new Options instance after applying the provided options.
+func NewOptions(opts ...Option) *Options {
+	o := &Options{}
+	o.Apply(opts...)
 	return o
 }
 
@@ -105,3 +105,130 @@ func TestNewOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestOptionsApply(t *testing.T) {
+	tests := []struct {
+		desc string
+		base *Options
+		opts []Option
+		want *Options
+	}{
+		{
+			desc: "no options keep the values",
+			base: &Options{FgColor: ColorRed, Bold: true},
+			want: &Options{FgColor: ColorRed, Bold: true},
+		},
+		{
+			desc: "later colors override earlier ones",
+			base: &Options{FgColor: ColorRed, BgColor: ColorBlue},
+			opts: []Option{
+				FgColor(ColorGreen),
+				BgColor(ColorYellow),
+				BgColor(ColorCyan),
+			},
+			want: &Options{FgColor: ColorGreen, BgColor: ColorCyan},
+		},
+		{
+			desc: "attributes are added to the existing ones",
+			base: &Options{Bold: true},
+			opts: []Option{
+				Underline(),
+				Dim(),
+			},
+			want: &Options{Bold: true, Underline: true, Dim: true},
+		},
+		{
+			desc: "options struct overrides all the earlier values",
+			base: &Options{FgColor: ColorRed, Bold: true},
+			opts: []Option{
+				BgColor(ColorBlue),
+				&Options{FgColor: ColorGreen},
+			},
+			want: &Options{FgColor: ColorGreen},
+		},
+		{
+			desc: "options after the options struct override its values",
+			base: &Options{},
+			opts: []Option{
+				&Options{FgColor: ColorGreen, BgColor: ColorRed},
+				BgColor(ColorBlue),
+				Italic(),
+			},
+			want: &Options{FgColor: ColorGreen, BgColor: ColorBlue, Italic: true},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := tc.base
+			got.Apply(tc.opts...)
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("Apply => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOptionsMerge(t *testing.T) {
+	tests := []struct {
+		desc  string
+		base  *Options
+		other *Options
+		want  *Options
+	}{
+		{
+			desc:  "merging empty options keeps the values",
+			base:  &Options{FgColor: ColorRed, BgColor: ColorBlue, Bold: true},
+			other: &Options{},
+			want:  &Options{FgColor: ColorRed, BgColor: ColorBlue, Bold: true},
+		},
+		{
+			desc:  "set foreground color overrides",
+			base:  &Options{FgColor: ColorRed, BgColor: ColorBlue},
+			other: &Options{FgColor: ColorGreen},
+			want:  &Options{FgColor: ColorGreen, BgColor: ColorBlue},
+		},
+		{
+			desc:  "set background color overrides",
+			base:  &Options{FgColor: ColorRed, BgColor: ColorBlue},
+			other: &Options{BgColor: ColorYellow},
+			want:  &Options{FgColor: ColorRed, BgColor: ColorYellow},
+		},
+		{
+			desc:  "default colors don't override",
+			base:  &Options{FgColor: ColorRed, BgColor: ColorBlue},
+			other: &Options{FgColor: ColorDefault, BgColor: ColorDefault},
+			want:  &Options{FgColor: ColorRed, BgColor: ColorBlue},
+		},
+		{
+			desc: "attributes are combined",
+			base: &Options{Bold: true, Italic: true},
+			other: &Options{
+				Underline:     true,
+				Strikethrough: true,
+				Inverse:       true,
+				Blink:         true,
+				Dim:           true,
+			},
+			want: &Options{
+				Bold:          true,
+				Italic:        true,
+				Underline:     true,
+				Strikethrough: true,
+				Inverse:       true,
+				Blink:         true,
+				Dim:           true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := tc.base
+			got.Merge(tc.other)
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("Merge => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -31,6 +31,13 @@ func (cc Color) String() string {
 	return fmt.Sprintf("Color:%d", cc)
 }
 
+// IsDefault asserts whether this is the ColorDefault, i.e. whether the
+// terminal uses its own default color instead of a color forced by the
+// application.
+func (cc Color) IsDefault() bool {
+	return cc == ColorDefault
+}
+
 // colorNames maps Color values to human readable names.
 var colorNames = map[Color]string{
 	ColorDefault: "ColorDefault",
@@ -46,6 +53,10 @@ var colorNames = map[Color]string{
 
 // The supported terminal colors.
 const (
+	// ColorDefault is the default foreground or background color of the
+	// terminal. This is the zero value of Color, so cells that don't have a
+	// color set use it. Setting it explicitly resets a previously set color
+	// and doesn't force any particular color, e.g. black.
 	ColorDefault Color = iota
 
 	// The 16 Xterm colors.
@@ -121,3 +132,146 @@ func ColorRGB24(r, g, b int) Color {
 	}
 	return ColorRGB6(r/51, g/51, b/51)
 }
+
+// rgb is a color in the 24 bit RGB color space.
+type rgb struct {
+	r, g, b int
+}
+
+// systemRGB are the RGB values of the 16 Xterm system colors.
+var systemRGB = [16]rgb{
+	{0x00, 0x00, 0x00}, {0x80, 0x00, 0x00}, {0x00, 0x80, 0x00}, {0x80, 0x80, 0x00},
+	{0x00, 0x00, 0x80}, {0x80, 0x00, 0x80}, {0x00, 0x80, 0x80}, {0xc0, 0xc0, 0xc0},
+	{0x80, 0x80, 0x80}, {0xff, 0x00, 0x00}, {0x00, 0xff, 0x00}, {0xff, 0xff, 0x00},
+	{0x00, 0x00, 0xff}, {0xff, 0x00, 0xff}, {0x00, 0xff, 0xff}, {0xff, 0xff, 0xff},
+}
+
+// cubeLevels are the values of the individual RGB components used by the
+// 6x6x6 Xterm color cube.
+var cubeLevels = [6]int{0x00, 0x5f, 0x87, 0xaf, 0xd7, 0xff}
+
+// toRGB returns the approximate RGB value of the color as displayed by an
+// Xterm compatible terminal. Returns false for ColorDefault and for colors
+// outside of the 256 color palette whose RGB value isn't known.
+func toRGB(c Color) (rgb, bool) {
+	n := int(c) - 1 // Colors are off-by-one due to ColorDefault being zero.
+	switch {
+	case n < 0 || n > 255:
+		return rgb{}, false
+	case n < 16:
+		return systemRGB[n], true
+	case n < 232:
+		n -= 16
+		return rgb{cubeLevels[n/36], cubeLevels[n/6%6], cubeLevels[n%6]}, true
+	default:
+		v := 8 + 10*(n-232)
+		return rgb{v, v, v}, true
+	}
+}
+
+// fromRGB returns the color from the 6x6x6 color cube or the grayscale ramp
+// of the 256 color palette that is the closest to the RGB value.
+func fromRGB(v rgb) Color {
+	nearestLevel := func(x int) int {
+		best := 0
+		for i, l := range cubeLevels {
+			if abs(x-l) < abs(x-cubeLevels[best]) {
+				best = i
+			}
+		}
+		return best
+	}
+	ri, gi, bi := nearestLevel(v.r), nearestLevel(v.g), nearestLevel(v.b)
+	cube := rgb{cubeLevels[ri], cubeLevels[gi], cubeLevels[bi]}
+
+	grayIdx := ((v.r+v.g+v.b)/3 - 8 + 5) / 10
+	if grayIdx < 0 {
+		grayIdx = 0
+	}
+	if grayIdx > 23 {
+		grayIdx = 23
+	}
+	gv := 8 + 10*grayIdx
+	gray := rgb{gv, gv, gv}
+
+	if distance(v, gray) < distance(v, cube) {
+		return ColorNumber(232 + grayIdx)
+	}
+	return ColorRGB6(ri, gi, bi)
+}
+
+// distance returns the squared Euclidean distance of the two RGB values.
+func distance(a, b rgb) int {
+	dr, dg, db := a.r-b.r, a.g-b.g, a.b-b.b
+	return dr*dr + dg*dg + db*db
+}
+
+// abs returns the absolute value of x.
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Blend returns a color that is between the colors a and b. The value t
+// determines the position between them, zero (or less) returns a and one (or
+// more) returns b, 0.5 returns the color in the middle.
+//
+// The colors are blended in the RGB color space using the approximate RGB
+// values the colors have in an Xterm compatible terminal. The result is
+// approximated by the closest color of the 256 color palette, so make sure
+// your terminal is set to the terminalapi.ColorMode256 mode.
+// Since the RGB value of ColorDefault isn't known, blending with it returns
+// a if t is smaller than 0.5 and b otherwise.
+func Blend(a, b Color, t float64) Color {
+	switch {
+	case t <= 0:
+		return a
+	case t >= 1:
+		return b
+	}
+
+	av, aOK := toRGB(a)
+	bv, bOK := toRGB(b)
+	if !aOK || !bOK {
+		if t < 0.5 {
+			return a
+		}
+		return b
+	}
+
+	mix := func(x, y int) int {
+		return int(float64(x) + (float64(y)-float64(x))*t + 0.5)
+	}
+	return fromRGB(rgb{mix(av.r, bv.r), mix(av.g, bv.g), mix(av.b, bv.b)})
+}
+
+// Darken returns a darker shade of the color. The factor determines how much
+// darker the color gets, zero (or less) returns the color unchanged and one
+// (or more) returns ColorBlack. Returns ColorDefault unchanged.
+//
+// Unlike the Dim cell option which relies on the terminal, this computes the
+// darker color. See Blend for details about how it is approximated.
+func Darken(c Color, factor float64) Color {
+	if _, ok := toRGB(c); !ok {
+		return c
+	}
+	return Blend(c, ColorBlack, factor)
+}
+
+// Contrast returns either ColorBlack or ColorWhite, whichever is more legible
+// as the foreground color on top of the provided background color.
+// Returns ColorDefault if the RGB value of the color isn't known, e.g. for
+// ColorDefault.
+func Contrast(bg Color) Color {
+	v, ok := toRGB(bg)
+	if !ok {
+		return ColorDefault
+	}
+	// Perceived brightness, see https://www.w3.org/TR/AERT/#color-contrast.
+	if (299*v.r+587*v.g+114*v.b)/1000 > 128 {
+		return ColorBlack
+	}
+	return ColorWhite
+}
@@ -19,6 +19,49 @@ import (
 	"testing"
 )
 
+func TestColorIsDefault(t *testing.T) {
+	tests := []struct {
+		desc  string
+		color Color
+		want  bool
+	}{
+		{
+			desc:  "zero value is the default color",
+			color: Color(0),
+			want:  true,
+		},
+		{
+			desc:  "ColorDefault",
+			color: ColorDefault,
+			want:  true,
+		},
+		{
+			desc:  "ColorBlack isn't the default color",
+			color: ColorBlack,
+			want:  false,
+		},
+		{
+			desc:  "out of range color number resets to the default",
+			color: ColorNumber(256),
+			want:  true,
+		},
+		{
+			desc:  "color number zero isn't the default color",
+			color: ColorNumber(0),
+			want:  false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := tc.color.IsDefault()
+			if got != tc.want {
+				t.Errorf("%v.IsDefault => %v, want %v", tc.color, got, tc.want)
+			}
+		})
+	}
+}
+
 func TestColorNumber(t *testing.T) {
 	tests := []struct {
 		desc   string
@@ -205,3 +248,210 @@ func TestColorRGB24(t *testing.T) {
 		})
 	}
 }
+
+func TestBlend(t *testing.T) {
+	tests := []struct {
+		desc string
+		a, b Color
+		t    float64
+		want Color
+	}{
+		{
+			desc: "zero returns the first color",
+			a:    ColorRed,
+			b:    ColorBlue,
+			t:    0,
+			want: ColorRed,
+		},
+		{
+			desc: "negative value returns the first color",
+			a:    ColorRed,
+			b:    ColorBlue,
+			t:    -1,
+			want: ColorRed,
+		},
+		{
+			desc: "one returns the second color",
+			a:    ColorRed,
+			b:    ColorBlue,
+			t:    1,
+			want: ColorBlue,
+		},
+		{
+			desc: "value larger than one returns the second color",
+			a:    ColorRed,
+			b:    ColorBlue,
+			t:    2,
+			want: ColorBlue,
+		},
+		{
+			desc: "midpoint between red and blue",
+			a:    ColorRed,
+			b:    ColorBlue,
+			t:    0.5,
+			want: ColorRGB6(2, 0, 2),
+		},
+		{
+			desc: "midpoint between black and white is on the grayscale ramp",
+			a:    ColorBlack,
+			b:    ColorWhite,
+			t:    0.5,
+			want: ColorNumber(244),
+		},
+		{
+			desc: "quarter between black and white",
+			a:    ColorBlack,
+			b:    ColorWhite,
+			t:    0.25,
+			want: ColorNumber(238),
+		},
+		{
+			desc: "blending a color from the cube with itself",
+			a:    ColorRGB6(1, 2, 3),
+			b:    ColorRGB6(1, 2, 3),
+			t:    0.5,
+			want: ColorRGB6(1, 2, 3),
+		},
+		{
+			desc: "blending a color from the grayscale ramp with itself",
+			a:    ColorNumber(240),
+			b:    ColorNumber(240),
+			t:    0.5,
+			want: ColorNumber(240),
+		},
+		{
+			desc: "default color as the first color, closer to it",
+			a:    ColorDefault,
+			b:    ColorBlue,
+			t:    0.3,
+			want: ColorDefault,
+		},
+		{
+			desc: "default color as the first color, closer to the second",
+			a:    ColorDefault,
+			b:    ColorBlue,
+			t:    0.7,
+			want: ColorBlue,
+		},
+		{
+			desc: "default color as the second color, at the midpoint",
+			a:    ColorRed,
+			b:    ColorDefault,
+			t:    0.5,
+			want: ColorDefault,
+		},
+		{
+			desc: "color outside of the palette",
+			a:    ColorRed,
+			b:    Color(300),
+			t:    0.4,
+			want: ColorRed,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := Blend(tc.a, tc.b, tc.t)
+			if got != tc.want {
+				t.Errorf("Blend(%v, %v, %v) => %v, want %v", tc.a, tc.b, tc.t, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDarken(t *testing.T) {
+	tests := []struct {
+		desc   string
+		c      Color
+		factor float64
+		want   Color
+	}{
+		{
+			desc:   "zero factor returns the color",
+			c:      ColorRed,
+			factor: 0,
+			want:   ColorRed,
+		},
+		{
+			desc:   "factor of one returns black",
+			c:      ColorRed,
+			factor: 1,
+			want:   ColorBlack,
+		},
+		{
+			desc:   "halves the brightness of white",
+			c:      ColorWhite,
+			factor: 0.5,
+			want:   ColorNumber(244),
+		},
+		{
+			desc:   "darkens a color from the cube",
+			c:      ColorRGB6(5, 5, 0),
+			factor: 0.5,
+			want:   ColorRGB6(2, 2, 0),
+		},
+		{
+			desc:   "default color is unchanged",
+			c:      ColorDefault,
+			factor: 0.5,
+			want:   ColorDefault,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := Darken(tc.c, tc.factor)
+			if got != tc.want {
+				t.Errorf("Darken(%v, %v) => %v, want %v", tc.c, tc.factor, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContrast(t *testing.T) {
+	tests := []struct {
+		desc string
+		bg   Color
+		want Color
+	}{
+		{
+			desc: "default color",
+			bg:   ColorDefault,
+			want: ColorDefault,
+		},
+		{
+			desc: "white on black",
+			bg:   ColorBlack,
+			want: ColorWhite,
+		},
+		{
+			desc: "black on white",
+			bg:   ColorWhite,
+			want: ColorBlack,
+		},
+		{
+			desc: "white on red",
+			bg:   ColorRed,
+			want: ColorWhite,
+		},
+		{
+			desc: "black on yellow",
+			bg:   ColorYellow,
+			want: ColorBlack,
+		},
+		{
+			desc: "black on light gray",
+			bg:   ColorNumber(250),
+			want: ColorBlack,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := Contrast(tc.bg)
+			if got != tc.want {
+				t.Errorf("Contrast(%v) => %v, want %v", tc.bg, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cell
+
+// named_color.go defines colors that can be referred to by their web names.
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Named web colors that aren't part of the 16 Xterm colors.
+// The colors are approximated by the closest color of the 256 color palette,
+// so make sure your terminal is set to the terminalapi.ColorMode256 mode.
+// See ColorByName for more colors.
+//
+// The numbers are off-by-one due to ColorDefault being zero.
+const (
+	ColorOrange    Color = 214 + 1
+	ColorPink      Color = 218 + 1
+	ColorBrown     Color = 124 + 1
+	ColorGold      Color = 220 + 1
+	ColorIndigo    Color = 54 + 1
+	ColorViolet    Color = 213 + 1
+	ColorCoral     Color = 209 + 1
+	ColorTurquoise Color = 80 + 1
+	ColorKhaki     Color = 222 + 1
+	ColorCrimson   Color = 161 + 1
+	ColorSkyBlue   Color = 116 + 1
+)
+
+// systemColorNames maps the web names of the 16 Xterm colors to the colors.
+// The web colors with these names have exactly the same RGB values.
+var systemColorNames = map[string]Color{
+	"black":   ColorBlack,
+	"maroon":  ColorMaroon,
+	"green":   ColorGreen,
+	"olive":   ColorOlive,
+	"navy":    ColorNavy,
+	"purple":  ColorPurple,
+	"teal":    ColorTeal,
+	"silver":  ColorSilver,
+	"gray":    ColorGray,
+	"grey":    ColorGray,
+	"red":     ColorRed,
+	"lime":    ColorLime,
+	"yellow":  ColorYellow,
+	"blue":    ColorBlue,
+	"fuchsia": ColorFuchsia,
+	"magenta": ColorFuchsia,
+	"aqua":    ColorAqua,
+	"cyan":    ColorAqua,
+	"white":   ColorWhite,
+}
+
+// webColors maps the names of other web colors to their RGB values.
+var webColors = map[string]rgb{
+	"beige":       {0xf5, 0xf5, 0xdc},
+	"brown":       {0xa5, 0x2a, 0x2a},
+	"chocolate":   {0xd2, 0x69, 0x1e},
+	"coral":       {0xff, 0x7f, 0x50},
+	"crimson":     {0xdc, 0x14, 0x3c},
+	"darkblue":    {0x00, 0x00, 0x8b},
+	"darkgray":    {0xa9, 0xa9, 0xa9},
+	"darkgreen":   {0x00, 0x64, 0x00},
+	"darkorange":  {0xff, 0x8c, 0x00},
+	"darkred":     {0x8b, 0x00, 0x00},
+	"deeppink":    {0xff, 0x14, 0x93},
+	"deepskyblue": {0x00, 0xbf, 0xff},
+	"dodgerblue":  {0x1e, 0x90, 0xff},
+	"firebrick":   {0xb2, 0x22, 0x22},
+	"forestgreen": {0x22, 0x8b, 0x22},
+	"gold":        {0xff, 0xd7, 0x00},
+	"goldenrod":   {0xda, 0xa5, 0x20},
+	"hotpink":     {0xff, 0x69, 0xb4},
+	"indigo":      {0x4b, 0x00, 0x82},
+	"ivory":       {0xff, 0xff, 0xf0},
+	"khaki":       {0xf0, 0xe6, 0x8c},
+	"lavender":    {0xe6, 0xe6, 0xfa},
+	"lightblue":   {0xad, 0xd8, 0xe6},
+	"lightgray":   {0xd3, 0xd3, 0xd3},
+	"lightgreen":  {0x90, 0xee, 0x90},
+	"lightyellow": {0xff, 0xff, 0xe0},
+	"limegreen":   {0x32, 0xcd, 0x32},
+	"orange":      {0xff, 0xa5, 0x00},
+	"orangered":   {0xff, 0x45, 0x00},
+	"orchid":      {0xda, 0x70, 0xd6},
+	"pink":        {0xff, 0xc0, 0xcb},
+	"plum":        {0xdd, 0xa0, 0xdd},
+	"royalblue":   {0x41, 0x69, 0xe1},
+	"salmon":      {0xfa, 0x80, 0x72},
+	"seagreen":    {0x2e, 0x8b, 0x57},
+	"sienna":      {0xa0, 0x52, 0x2d},
+	"skyblue":     {0x87, 0xce, 0xeb},
+	"slategray":   {0x70, 0x80, 0x90},
+	"steelblue":   {0x46, 0x82, 0xb4},
+	"tan":         {0xd2, 0xb4, 0x8c},
+	"tomato":      {0xff, 0x63, 0x47},
+	"turquoise":   {0x40, 0xe0, 0xd0},
+	"violet":      {0xee, 0x82, 0xee},
+	"wheat":       {0xf5, 0xde, 0xb3},
+}
+
+// ColorByName returns the color with the specified web (CSS) name, e.g.
+// "orange" or "SteelBlue". The name is case insensitive.
+//
+// The basic web colors like "red" or "teal" map to the 16 Xterm colors with
+// the same RGB values. All other colors are approximated by the closest color
+// of the 256 color palette, so make sure your terminal is set to the
+// terminalapi.ColorMode256 mode.
+// Returns an error if the name isn't known.
+func ColorByName(name string) (Color, error) {
+	n := strings.ToLower(name)
+	if c, ok := systemColorNames[n]; ok {
+		return c, nil
+	}
+	if v, ok := webColors[n]; ok {
+		return fromRGB(v), nil
+	}
+	return ColorDefault, fmt.Errorf("unknown color name %q", name)
+}
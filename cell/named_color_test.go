@@ -0,0 +1,124 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cell
+
+import "testing"
+
+func TestColorByName(t *testing.T) {
+	tests := []struct {
+		desc    string
+		name    string
+		want    Color
+		wantErr bool
+	}{
+		{
+			desc: "basic web color maps to the Xterm color",
+			name: "teal",
+			want: ColorTeal,
+		},
+		{
+			desc: "alias of a basic web color",
+			name: "cyan",
+			want: ColorAqua,
+		},
+		{
+			desc: "web color approximated in the color cube",
+			name: "orange",
+			want: ColorRGB6(5, 3, 0),
+		},
+		{
+			desc: "web color approximated in the grayscale ramp",
+			name: "lightgray",
+			want: ColorNumber(252),
+		},
+		{
+			desc: "name is case insensitive",
+			name: "SkyBlue",
+			want: ColorSkyBlue,
+		},
+		{
+			desc:    "fails on unknown name",
+			name:    "notacolor",
+			want:    ColorDefault,
+			wantErr: true,
+		},
+		{
+			desc:    "fails on empty name",
+			want:    ColorDefault,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := ColorByName(tc.name)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ColorByName => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("ColorByName(%q) => %v, want %v", tc.name, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNamedColorConstants(t *testing.T) {
+	tests := []struct {
+		name string
+		want Color
+	}{
+		{"orange", ColorOrange},
+		{"pink", ColorPink},
+		{"brown", ColorBrown},
+		{"gold", ColorGold},
+		{"indigo", ColorIndigo},
+		{"violet", ColorViolet},
+		{"coral", ColorCoral},
+		{"turquoise", ColorTurquoise},
+		{"khaki", ColorKhaki},
+		{"crimson", ColorCrimson},
+		{"skyblue", ColorSkyBlue},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ColorByName(tc.name)
+			if err != nil {
+				t.Fatalf("ColorByName => unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("ColorByName(%q) => %v, want %v", tc.name, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestColorByNameKnowsRGB(t *testing.T) {
+	for name, v := range webColors {
+		got, err := ColorByName(name)
+		if err != nil {
+			t.Fatalf("ColorByName(%q) => unexpected error: %v", name, err)
+		}
+		gotRGB, ok := toRGB(got)
+		if !ok {
+			t.Fatalf("toRGB(%v) => false, want true", got)
+		}
+		// The closest color in the 256 color palette is at most half of the
+		// largest step of the color cube away in each of the components.
+		if d := distance(v, gotRGB); d > 3*48*48 {
+			t.Errorf("ColorByName(%q) => %v with RGB %v, too far from %v, squared distance %d", name, got, gotRGB, v, d)
+		}
+	}
+}
@@ -25,9 +25,11 @@ import (
 	"errors"
 	"fmt"
 	"image"
+	"reflect"
 	"sync"
 
 	"github.com/mum4k/termdash/linestyle"
+	"github.com/mum4k/termdash/mouse"
 	"github.com/mum4k/termdash/private/alignfor"
 	"github.com/mum4k/termdash/private/area"
 	"github.com/mum4k/termdash/private/event"
@@ -56,6 +58,9 @@ type Container struct {
 	// area is the area of the terminal this container has access to.
 	// Initialized the first time Draw is called.
 	area image.Rectangle
+	// outer is the area allocated to this container before its margin was
+	// applied. Updated each time Draw is called.
+	outer image.Rectangle
 
 	// opts are the options provided to the container.
 	opts *options
@@ -66,6 +71,37 @@ type Container struct {
 	// have changed.
 	clearNeeded bool
 
+	// frame is the hash of the content drawn during the last call to Draw
+	// and prevFrame the hash of the content drawn during the call before it.
+	// Only maintained on the root container.
+	frame     frameHash
+	prevFrame frameHash
+
+	// cursor is the position of the terminal cursor requested by the focused
+	// widget during the last call to Draw or nil if it didn't request one.
+	// Only maintained on the root container.
+	cursor *image.Point
+
+	// drawn is what the widget drew during the last call to Draw, used to
+	// skip drawing widgets that implement widgetapi.Dirtier. Nil if there is
+	// nothing that can be reused.
+	drawn *drawnWidget
+
+	// epoch is the epoch of the widget's canvas passed to the widget in
+	// widgetapi.Meta, zero if a new epoch must start on the next call to
+	// Draw. The epochArea is the area of the canvas during the epoch.
+	epoch     uint64
+	epochArea image.Rectangle
+	// lastEpoch is the last epoch assigned to any of the containers in the
+	// tree, so that epochs are never reused.
+	// Only maintained on the root container.
+	lastEpoch uint64
+
+	// buttonHeld indicates if a mouse button is currently pressed. Used to
+	// distinguish mouse motion events from releases of mouse buttons.
+	// Only maintained on the root container.
+	buttonHeld bool
+
 	// mu protects the container tree.
 	// All containers in the tree share the same lock.
 	mu *sync.Mutex
@@ -113,8 +149,47 @@ func newChild(parent *Container, opts []Option) (*Container, error) {
 }
 
 // hasBorder determines if this container has a border.
+// A border hidden via the KeyToggleChrome key doesn't count.
 func (c *Container) hasBorder() bool {
-	return c.opts.border != linestyle.None
+	if c.chromeHidden() {
+		return false
+	}
+	return c.opts.border != linestyle.None || c.opts.focusedBorder != linestyle.None
+}
+
+// borderStyle returns the line style of the border currently drawn around the
+// container, which depends on whether the container is focused. Returns
+// linestyle.None if no border is drawn.
+func (c *Container) borderStyle() linestyle.LineStyle {
+	if !c.hasBorder() {
+		return linestyle.None
+	}
+	if c.borderFocused() && c.opts.focusedBorder != linestyle.None {
+		return c.opts.focusedBorder
+	}
+	return c.opts.border
+}
+
+// borderFocused determines if the border of the container should indicate
+// that the container is focused. This is false for unfocused containers and
+// for containers whose widget set the SuppressContainerFocusBorder option.
+func (c *Container) borderFocused() bool {
+	if !c.focusTracker.isActive(c) {
+		return false
+	}
+	return !c.hasWidget() || !c.opts.widget.Options().SuppressContainerFocusBorder
+}
+
+// chromeHidden determines if borders and titles of this container are
+// hidden, i.e. if the KeyToggleChrome key was pressed on this container or on
+// any of its parents.
+func (c *Container) chromeHidden() bool {
+	for cur := c; cur != nil; cur = cur.parent {
+		if cur.opts.chromeHidden {
+			return true
+		}
+	}
+	return false
 }
 
 // hasWidget determines if this container has a widget.
@@ -172,12 +247,120 @@ func (c *Container) widgetArea() (image.Rectangle, error) {
 }
 
 // split splits the container's usable area into child areas.
+// When the RightToLeft option was provided, the areas of a vertical split are
+// mirrored so that the first (left) child is on the right.
 // Panics if the container isn't configured for a split.
 func (c *Container) split() (image.Rectangle, image.Rectangle, error) {
+	first, second, _, err := c.splitSeparated()
+	return first, second, err
+}
+
+// splitSeparated is like split, but also returns the area of the line drawn
+// between the child areas due to the SplitSeparator option. The area of the
+// line is zero if the option wasn't provided, if one of the children is
+// hidden or if there isn't any space for the line.
+func (c *Container) splitSeparated() (image.Rectangle, image.Rectangle, image.Rectangle, error) {
 	ar, err := c.opts.padding.apply(c.usable())
 	if err != nil {
-		return image.ZR, image.ZR, err
+		return image.ZR, image.ZR, image.ZR, err
+	}
+	first, second, err := c.splitArea(ar)
+	if err != nil {
+		return image.ZR, image.ZR, image.ZR, err
+	}
+	var sep image.Rectangle
+	if c.opts.splitSeparator != nil {
+		first, second, sep = separate(c.opts.split, c.opts.splitReversed, first, second)
+	}
+	if c.opts.split == splitTypeVertical && c.opts.inherited.rightToLeft {
+		first, second = mirrorX(ar, first), mirrorX(ar, second)
+		if !sep.Empty() {
+			sep = mirrorX(ar, sep)
+		}
+	}
+
+	// Hidden sub containers give their space to their sibling.
+	firstHidden := c.first != nil && c.first.hidden()
+	secondHidden := c.second != nil && c.second.hidden()
+	switch {
+	case firstHidden && secondHidden:
+		return image.ZR, image.ZR, image.ZR, nil
+	case firstHidden:
+		return image.ZR, ar, image.ZR, nil
+	case secondHidden:
+		return ar, image.ZR, image.ZR, nil
 	}
+	return first, second, sep, nil
+}
+
+// separate takes one cell for the separator line from the child area that
+// doesn't get the requested size of the split, i.e. from the second one
+// unless the size applies from the end. Returns the adjusted child areas and
+// the area of the line, which is zero if the child area has no space left.
+func separate(split splitType, reversed bool, first, second image.Rectangle) (image.Rectangle, image.Rectangle, image.Rectangle) {
+	var sep image.Rectangle
+	switch {
+	case split == splitTypeVertical && reversed:
+		if first.Dx() < 1 {
+			return first, second, image.ZR
+		}
+		sep = image.Rect(first.Max.X-1, first.Min.Y, first.Max.X, first.Max.Y)
+		first.Max.X--
+
+	case split == splitTypeVertical:
+		if second.Dx() < 1 {
+			return first, second, image.ZR
+		}
+		sep = image.Rect(second.Min.X, second.Min.Y, second.Min.X+1, second.Max.Y)
+		second.Min.X++
+
+	case reversed:
+		if first.Dy() < 1 {
+			return first, second, image.ZR
+		}
+		sep = image.Rect(first.Min.X, first.Max.Y-1, first.Max.X, first.Max.Y)
+		first.Max.Y--
+
+	default:
+		if second.Dy() < 1 {
+			return first, second, image.ZR
+		}
+		sep = image.Rect(second.Min.X, second.Min.Y, second.Max.X, second.Min.Y+1)
+		second.Min.Y++
+	}
+	return first, second, sep
+}
+
+// hidden determines if this container is hidden, because the terminal is
+// smaller than the size provided via the HideBelowSize option.
+// Doesn't account for hidden parent containers, see isHidden.
+func (c *Container) hidden() bool {
+	hb := c.opts.hideBelow
+	if hb.X <= 0 && hb.Y <= 0 {
+		return false
+	}
+	size := c.term.Size()
+	return size.X < hb.X || size.Y < hb.Y
+}
+
+// isHidden determines if this container or any of its parents is hidden.
+func (c *Container) isHidden() bool {
+	for cur := c; cur != nil; cur = cur.parent {
+		if cur.hidden() {
+			return true
+		}
+	}
+	return false
+}
+
+// mirrorX mirrors the rectangle horizontally within the area.
+func mirrorX(ar, r image.Rectangle) image.Rectangle {
+	return image.Rect(ar.Min.X+ar.Max.X-r.Max.X, r.Min.Y, ar.Min.X+ar.Max.X-r.Min.X, r.Max.Y)
+}
+
+// splitArea splits the area into child areas from left to right or from top
+// to bottom.
+func (c *Container) splitArea(ar image.Rectangle) (image.Rectangle, image.Rectangle, error) {
 	if c.opts.splitFixed > DefaultSplitFixed {
 		if c.opts.split == splitTypeVertical {
 			if c.opts.splitReversed {
@@ -225,24 +408,77 @@ func (c *Container) createSecond(opts []Option) error {
 
 // Draw draws this container and all of its sub containers.
 func (c *Container) Draw() error {
+	notifyFocus, err := c.draw()
+	// Mutex must be released when notifying the widgets, they might call
+	// container methods.
+	notifyFocus()
+	return err
+}
+
+// draw implements Draw. Returns a function that notifies widgets about focus
+// changes, see focusHooks.
+func (c *Container) draw() (func(), error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	// The terminal might have been resized, so that the focused container is
+	// now hidden.
+	focusedBefore := c.focusTracker.focused()
+	c.focusTracker.leaveHidden()
+	notifyFocus := focusHooks(focusedBefore, c.focusTracker.focused())
+
 	if c.clearNeeded {
 		if err := c.term.Clear(); err != nil {
-			return fmt.Errorf("term.Clear => error: %v", err)
+			return notifyFocus, fmt.Errorf("term.Clear => error: %v", err)
 		}
 		c.clearNeeded = false
+
+		// The layout or the widgets might have changed, draw all widgets
+		// again.
+		var errStr string
+		preOrder(c, &errStr, visitFunc(func(cur *Container) error {
+			cur.drawn = nil
+			cur.epoch = 0
+			return nil
+		}))
 	}
 
 	// Update the area we are tracking for focus in case the terminal size
 	// changed.
 	ar, err := area.FromSize(c.term.Size())
 	if err != nil {
-		return err
+		return notifyFocus, err
 	}
 	c.focusTracker.updateArea(ar)
-	return drawTree(c)
+
+	root := rootCont(c)
+	root.prevFrame = root.frame
+	return notifyFocus, drawTree(c)
+}
+
+// Cursor returns the position on the terminal where the focused widget
+// requested the terminal cursor to be placed during the last call to Draw and
+// true if it requested one. See widgetapi.Meta.SetCursor.
+func (c *Container) Cursor() (image.Point, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	root := rootCont(c)
+	if root.cursor == nil {
+		return image.ZP, false
+	}
+	return *root.cursor, true
+}
+
+// Changed reports whether the content drawn onto the terminal by the last call
+// to Draw differs from the content drawn by the call before it. Always
+// returns true before Draw is called at least twice.
+func (c *Container) Changed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	root := rootCont(c)
+	return root.prevFrame == 0 || root.frame != root.prevFrame
 }
 
 // Update updates container with the specified id by setting the provided
@@ -251,40 +487,338 @@ func (c *Container) Draw() error {
 // layout and splits.
 // The argument id must match exactly one container with that was created with
 // matching ID() option. The argument id must not be an empty string.
+//
+// Widgets implementing widgetapi.Closer that are no longer placed in any
+// container after the update are closed, see widgetapi.Closer.
+// Widgets implementing widgetapi.Focuser are notified if the update moved the
+// focus, see widgetapi.Focuser.
 func (c *Container) Update(id string, opts ...Option) error {
+	return afterUpdate(c.update(func() ([]*Container, error) {
+		target, err := findID(c, id)
+		if err != nil {
+			return nil, err
+		}
+		return []*Container{target}, nil
+	}, opts...))
+}
+
+// UpdateAll updates all the containers whose ID matches the provided function
+// by setting the provided options on each of them. This can be used to
+// perform bulk changes, e.g. to switch the colors of a theme at runtime.
+// The function is called with the ID of every container in the tree, the ID
+// is an empty string for containers created without the ID() option. At least
+// one container must match. The options are applied to the matching containers
+// in the order in which they appear in the tree, top to bottom and left to
+// right, and the updated tree is validated as with Update. No changes take
+// effect on the terminal until the next call to Draw.
+//
+// Widgets implementing widgetapi.Closer that are no longer placed in any
+// container after the update are closed, see widgetapi.Closer.
+// Widgets implementing widgetapi.Focuser are notified if the update moved the
+// focus, see widgetapi.Focuser.
+func (c *Container) UpdateAll(match func(id string) bool, opts ...Option) error {
+	return afterUpdate(c.update(func() ([]*Container, error) {
+		return findMatching(c, match)
+	}, opts...))
+}
+
+// afterUpdate notifies and closes the widgets affected by an update once the
+// container lock was released, see update. Returns the error of the update or
+// the first error returned from closing the widgets.
+func afterUpdate(detached []widgetapi.Closer, notifyFocus func(), err error) error {
+	if err != nil {
+		return err
+	}
+
+	// Mutex must be released when notifying and closing the widgets.
+	// The widgets might call container methods from these methods.
+	notifyFocus()
+	var firstErr error
+	for _, w := range detached {
+		if err := w.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// update implements Update and UpdateAll, the find function returns the
+// containers to update. Returns the widgets implementing widgetapi.Closer
+// that were detached from the container tree by the update and a function
+// that notifies widgets about focus changes, see focusHooks.
+func (c *Container) update(find func() ([]*Container, error), opts ...Option) ([]widgetapi.Closer, func(), error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	target, err := findID(c, id)
+	targets, err := find()
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	c.clearNeeded = true
 
-	if err := applyOptions(target, opts...); err != nil {
-		return err
+	before := closers(c)
+	focusedBefore := c.focusTracker.focused()
+	for _, target := range targets {
+		if err := applyOptions(target, opts...); err != nil {
+			return nil, nil, err
+		}
 	}
 	if err := validateOptions(c); err != nil {
-		return err
+		return nil, nil, err
+	}
+
+	// Release the focus if the container it was trapped in was removed.
+	if trap := c.focusTracker.trap; trap != nil && !isReachable(c, trap) {
+		c.focusTracker.trap = nil
 	}
 
 	// The currently focused container might not be reachable anymore, because
-	// it was under the target. If that is so, move the focus up to the target.
+	// it was under a target. If that is so, move the focus up to the first
+	// target that remains in the tree.
 	if !c.focusTracker.reachableFrom(c) {
-		c.focusTracker.setActive(target)
+		c.focusTracker.setActive(c)
+		for _, target := range targets {
+			if isReachable(c, target) {
+				c.focusTracker.setActive(target)
+				break
+			}
+		}
+	}
+
+	after := closers(c)
+	var detached []widgetapi.Closer
+	for _, pc := range before {
+		if !containsCloser(after, pc) {
+			detached = append(detached, pc.closer)
+		}
+	}
+	return detached, focusHooks(focusedBefore, c.focusTracker.focused()), nil
+}
+
+// Swap exchanges the content of the two containers with the specified ids.
+// The content is either the placed widget or the sub containers created by a
+// split, including the split itself. Everything else stays with the
+// container, i.e. its area, border, margin and padding don't change. This can
+// be used to rearrange the panes of a dashboard.
+// Both arguments must match exactly one container that was created with the
+// matching ID() option and neither of the containers can be placed under the
+// other one. Swapping a container with itself has no effect.
+//
+// If one of the two containers is focused, the focus follows its content.
+// Widgets implementing widgetapi.Focuser are notified if the swap moved the
+// focus, see widgetapi.Focuser.
+func (c *Container) Swap(idA, idB string) error {
+	notifyFocus, err := c.swap(idA, idB)
+	if err != nil {
+		return err
+	}
+
+	// Mutex must be released when notifying the widgets.
+	notifyFocus()
+	return nil
+}
+
+// swap implements Swap. Returns a function that notifies widgets about focus
+// changes, see focusHooks.
+func (c *Container) swap(idA, idB string) (func(), error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	a, err := findID(c, idA)
+	if err != nil {
+		return nil, err
+	}
+	b, err := findID(c, idB)
+	if err != nil {
+		return nil, err
+	}
+	if a == b {
+		return func() {}, nil
+	}
+	if isAncestor(a, b) || isAncestor(b, a) {
+		return nil, fmt.Errorf("cannot swap containers %q and %q, one is placed under the other", idA, idB)
+	}
+	c.clearNeeded = true
+
+	focusedBefore := c.focusTracker.focused()
+	a.opts.widget, b.opts.widget = b.opts.widget, a.opts.widget
+	a.opts.placement, b.opts.placement = b.opts.placement, a.opts.placement
+	a.opts.split, b.opts.split = b.opts.split, a.opts.split
+	a.opts.splitReversed, b.opts.splitReversed = b.opts.splitReversed, a.opts.splitReversed
+	a.opts.splitPercent, b.opts.splitPercent = b.opts.splitPercent, a.opts.splitPercent
+	a.opts.splitFixed, b.opts.splitFixed = b.opts.splitFixed, a.opts.splitFixed
+	a.opts.splitSeparator, b.opts.splitSeparator = b.opts.splitSeparator, a.opts.splitSeparator
+	a.first, b.first = b.first, a.first
+	a.second, b.second = b.second, a.second
+	for _, cont := range []*Container{a, b} {
+		for _, child := range []*Container{cont.first, cont.second} {
+			if child != nil {
+				child.parent = cont
+			}
+		}
+	}
+
+	switch {
+	case c.focusTracker.isActive(a):
+		c.focusTracker.setActive(b)
+	case c.focusTracker.isActive(b):
+		c.focusTracker.setActive(a)
+	}
+	return focusHooks(focusedBefore, c.focusTracker.focused()), nil
+}
+
+// TrapFocus confines the keyboard focus to the container with the specified
+// id and the containers placed under it, e.g. while a modal dialog or a step
+// of a wizard is displayed. While trapped, the keys configured via
+// KeyFocusNext, KeyFocusPrevious and the focus group options only cycle the
+// focus among the containers in this subtree and mouse clicks outside of it
+// don't move the focus. Events are still delivered to the widgets outside of
+// the subtree according to their widgetapi.Options.
+// If the focused container is outside of the subtree, the focus moves to the
+// first container in it that can receive the keyboard focus.
+// The argument id must match exactly one container that was created with the
+// matching ID() option. Trapping the focus again replaces the previous trap.
+// The focus remains trapped until ReleaseFocusTrap is called or the container
+// is removed from the tree by an update.
+//
+// Widgets implementing widgetapi.Focuser are notified if the focus moved, see
+// widgetapi.Focuser.
+func (c *Container) TrapFocus(id string) error {
+	notifyFocus, err := c.trapFocus(id)
+	if err != nil {
+		return err
 	}
+
+	// Mutex must be released when notifying the widgets.
+	notifyFocus()
 	return nil
 }
 
+// trapFocus implements TrapFocus. Returns a function that notifies widgets
+// about focus changes, see focusHooks.
+func (c *Container) trapFocus(id string) (func(), error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	target, err := findID(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	focusedBefore := c.focusTracker.focused()
+	c.focusTracker.trapIn(target)
+	return focusHooks(focusedBefore, c.focusTracker.focused()), nil
+}
+
+// ReleaseFocusTrap releases the keyboard focus trapped by TrapFocus, so that
+// it can move to any container again. The focused container doesn't change.
+// Has no effect if the focus isn't trapped.
+func (c *Container) ReleaseFocusTrap() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.focusTracker.trap = nil
+}
+
+// isAncestor determines if the container a is an ancestor of the container b
+// or the same container.
+func isAncestor(a, b *Container) bool {
+	for cont := b; cont != nil; cont = cont.parent {
+		if cont == a {
+			return true
+		}
+	}
+	return false
+}
+
+// Tick calls Tick on all the widgets in the container tree that implement
+// widgetapi.Ticker, see widgetapi.Ticker. Returns the first error returned by
+// any of the widgets, the remaining widgets are still ticked.
+// This is called by termdash on each periodic redraw.
+func (c *Container) Tick() error {
+	c.mu.Lock()
+	var (
+		tickers []widgetapi.Ticker
+		ids     []string
+	)
+	var errStr string
+	preOrder(c, &errStr, func(cur *Container) error {
+		if t, ok := cur.opts.widget.(widgetapi.Ticker); ok {
+			tickers = append(tickers, t)
+			ids = append(ids, cur.opts.id)
+		}
+		return nil
+	})
+	// Mutex must be released when ticking the widgets.
+	// The widgets might call container methods from Tick.
+	c.mu.Unlock()
+
+	var firstErr error
+	for i, t := range tickers {
+		if err := t.Tick(); err != nil && firstErr == nil {
+			firstErr = &widgetapi.Error{ContainerID: ids[i], Err: err}
+		}
+	}
+	return firstErr
+}
+
+// placedCloser is a widget that implements widgetapi.Closer along with its
+// placement, see options.placement.
+type placedCloser struct {
+	placement uint64
+	closer    widgetapi.Closer
+}
+
+// closers returns all the widgets in the container tree that implement
+// widgetapi.Closer.
+// Caller must hold c.mu.
+func closers(c *Container) []placedCloser {
+	var (
+		errStr string
+		res    []placedCloser
+	)
+	preOrder(c, &errStr, func(cur *Container) error {
+		if cl, ok := cur.opts.widget.(widgetapi.Closer); ok {
+			res = append(res, placedCloser{
+				placement: cur.opts.placement,
+				closer:    cl,
+			})
+		}
+		return nil
+	})
+	return res
+}
+
+// containsCloser determines if the widget is among the widgets, either in the
+// same placement or placed again into any container. Widgets that aren't
+// comparable are only found in the same placement.
+func containsCloser(widgets []placedCloser, pc placedCloser) bool {
+	canCompare := reflect.TypeOf(pc.closer).Comparable()
+	for _, cur := range widgets {
+		if cur.placement == pc.placement {
+			return true
+		}
+		if canCompare && cur.closer == pc.closer {
+			return true
+		}
+	}
+	return false
+}
+
 // updateFocusFromMouse processes the mouse event and determines if it changes
-// the focused container.
+// the focused container. The motion argument indicates if the event is a mouse
+// motion event, these move the focus if the FocusFollowsMouse option is set.
 // Caller must hold c.mu.
-func (c *Container) updateFocusFromMouse(m *terminalapi.Mouse) {
+func (c *Container) updateFocusFromMouse(m *terminalapi.Mouse, motion bool) {
 	target := pointCont(c, m.Position)
 	if target == nil { // Ignore mouse clicks where no containers are.
 		return
 	}
-	c.focusTracker.mouse(target, m)
+	if motion && c.opts.global.focusFollowsMouse {
+		c.focusTracker.moveTo(target)
+		return
+	}
+	c.focusTracker.mouse(target, m, c.opts.global.focusButtons)
 }
 
 // inFocusGroup returns true if this container is in the specified focus group.
@@ -320,6 +854,21 @@ func (c *Container) updateFocusFromKeyboard(k *terminalapi.Keyboard) {
 	}
 }
 
+// toggleChrome toggles the visibility of borders and titles on all the
+// containers that configured the key via the KeyToggleChrome option.
+// Caller must hold c.mu.
+func (c *Container) toggleChrome(k *terminalapi.Keyboard) {
+	var errStr string
+	preOrder(c, &errStr, visitFunc(func(cur *Container) error {
+		if key := cur.opts.keyToggleChrome; key != nil && *key == k.Key {
+			cur.opts.chromeHidden = !cur.opts.chromeHidden
+			// The layout changes, the freed cells must be cleared.
+			c.clearNeeded = true
+		}
+		return nil
+	}))
+}
+
 // processEvent processes events delivered to the container.
 func (c *Container) processEvent(ev terminalapi.Event) error {
 	// This is done in two stages.
@@ -340,21 +889,26 @@ func (c *Container) processEvent(ev terminalapi.Event) error {
 
 // prepareEvTargets returns a closure, that when called delivers the event to
 // widgets that registered for it.
-// Also processes the event on behalf of the container (tracks keyboard focus).
+// Also processes the event on behalf of the container (tracks keyboard focus)
+// and the closure notifies widgets about any resulting focus changes.
 // Caller must hold c.mu.
 func (c *Container) prepareEvTargets(ev terminalapi.Event) (func() error, error) {
+	focusedBefore := c.focusTracker.focused()
 	switch e := ev.(type) {
 	case *terminalapi.Mouse:
-		c.updateFocusFromMouse(ev.(*terminalapi.Mouse))
+		motion := c.isMotion(e)
+		c.updateFocusFromMouse(e, motion)
+		notifyFocus := focusHooks(focusedBefore, c.focusTracker.focused())
 
-		targets, err := c.mouseEvTargets(e)
+		targets, err := c.mouseEvTargets(e, motion)
 		if err != nil {
 			return nil, err
 		}
 		return func() error {
+			notifyFocus()
 			for _, mt := range targets {
 				if err := mt.widget.Mouse(mt.ev, mt.meta); err != nil {
-					return err
+					return &widgetapi.Error{ContainerID: mt.id, Err: err}
 				}
 			}
 			return nil
@@ -362,12 +916,15 @@ func (c *Container) prepareEvTargets(ev terminalapi.Event) (func() error, error)
 
 	case *terminalapi.Keyboard:
 		c.updateFocusFromKeyboard(ev.(*terminalapi.Keyboard))
+		c.toggleChrome(e)
+		notifyFocus := focusHooks(focusedBefore, c.focusTracker.focused())
 
 		targets := c.keyEvTargets()
 		return func() error {
+			notifyFocus()
 			for _, kt := range targets {
 				if err := kt.widget.Keyboard(e, kt.meta); err != nil {
-					return err
+					return &widgetapi.Error{ContainerID: kt.id, Err: err}
 				}
 			}
 			return nil
@@ -381,6 +938,8 @@ func (c *Container) prepareEvTargets(ev terminalapi.Event) (func() error, error)
 // keyEvTarget contains a widget that should receive an event and the metadata
 // for the event.
 type keyEvTarget struct {
+	// id is the ID of the container that contains the widget.
+	id string
 	// widget is the widget that should receive the keyboard event.
 	widget widgetapi.Widget
 	// meta is the metadata about the event.
@@ -388,8 +947,9 @@ type keyEvTarget struct {
 }
 
 // newKeyEvTarget returns a new keyEvTarget.
-func newKeyEvTarget(w widgetapi.Widget, meta *widgetapi.EventMeta) *keyEvTarget {
+func newKeyEvTarget(id string, w widgetapi.Widget, meta *widgetapi.EventMeta) *keyEvTarget {
 	return &keyEvTarget{
+		id:     id,
 		widget: w,
 		meta:   meta,
 	}
@@ -405,12 +965,15 @@ func (c *Container) keyEvTargets() []*keyEvTarget {
 		// If the currently focused widget set the ExclusiveKeyboardOnFocus
 		// option, this pointer is set to that widget.
 		exclusiveWidget widgetapi.Widget
+		// exclusiveID is the ID of the container of the exclusiveWidget.
+		exclusiveID string
 	)
 
 	// All the targets that should receive this event.
 	// For now stable ordering (preOrder).
 	preOrder(c, &errStr, visitFunc(func(cur *Container) error {
-		if !cur.hasWidget() {
+		// Widgets in hidden containers don't receive any keyboard events.
+		if !cur.hasWidget() || cur.isHidden() {
 			return nil
 		}
 
@@ -421,6 +984,7 @@ func (c *Container) keyEvTargets() []*keyEvTarget {
 		wOpt := cur.opts.widget.Options()
 		if focused && wOpt.ExclusiveKeyboardOnFocus {
 			exclusiveWidget = cur.opts.widget
+			exclusiveID = cur.opts.id
 		}
 
 		switch wOpt.WantKeyboard {
@@ -430,18 +994,18 @@ func (c *Container) keyEvTargets() []*keyEvTarget {
 
 		case widgetapi.KeyScopeFocused:
 			if focused {
-				targets = append(targets, newKeyEvTarget(cur.opts.widget, meta))
+				targets = append(targets, newKeyEvTarget(cur.opts.id, cur.opts.widget, meta))
 			}
 
 		case widgetapi.KeyScopeGlobal:
-			targets = append(targets, newKeyEvTarget(cur.opts.widget, meta))
+			targets = append(targets, newKeyEvTarget(cur.opts.id, cur.opts.widget, meta))
 		}
 		return nil
 	}))
 
 	if exclusiveWidget != nil {
 		targets = []*keyEvTarget{
-			newKeyEvTarget(exclusiveWidget, &widgetapi.EventMeta{Focused: true}),
+			newKeyEvTarget(exclusiveID, exclusiveWidget, &widgetapi.EventMeta{Focused: true}),
 		}
 	}
 	return targets
@@ -450,6 +1014,8 @@ func (c *Container) keyEvTargets() []*keyEvTarget {
 // mouseEvTarget contains a mouse event adjusted relative to the widget's area,
 // the widget that should receive it and metadata about the event.
 type mouseEvTarget struct {
+	// id is the ID of the container that contains the widget.
+	id string
 	// widget is the widget that should receive the mouse event.
 	widget widgetapi.Widget
 	// ev is the adjusted mouse event.
@@ -459,18 +1025,41 @@ type mouseEvTarget struct {
 }
 
 // newMouseEvTarget returns a new mouseEvTarget.
-func newMouseEvTarget(w widgetapi.Widget, wArea image.Rectangle, ev *terminalapi.Mouse, meta *widgetapi.EventMeta) *mouseEvTarget {
+func newMouseEvTarget(id string, w widgetapi.Widget, wArea image.Rectangle, ev *terminalapi.Mouse, meta *widgetapi.EventMeta) *mouseEvTarget {
 	return &mouseEvTarget{
+		id:     id,
 		widget: w,
 		ev:     adjustMouseEv(ev, wArea),
 		meta:   meta,
 	}
 }
 
+// isMotion determines if the mouse event is a motion event, i.e. an event
+// without any mouse button pressed that doesn't release a previously pressed
+// button.
+// Caller must hold c.mu.
+func (c *Container) isMotion(m *terminalapi.Mouse) bool {
+	root := rootCont(c)
+	switch m.Button {
+	case mouse.ButtonLeft, mouse.ButtonRight, mouse.ButtonMiddle:
+		root.buttonHeld = true
+		return false
+
+	case mouse.ButtonRelease:
+		held := root.buttonHeld
+		root.buttonHeld = false
+		return !held
+
+	default:
+		return false
+	}
+}
+
 // mouseEvTargets returns those widgets found in the container that should
-// receive this mouse event.
+// receive this mouse event. The motion argument indicates if the event is a
+// mouse motion event, these are only delivered to widgets that requested them.
 // Caller must hold c.mu.
-func (c *Container) mouseEvTargets(m *terminalapi.Mouse) ([]*mouseEvTarget, error) {
+func (c *Container) mouseEvTargets(m *terminalapi.Mouse, motion bool) ([]*mouseEvTarget, error) {
 	var (
 		errStr  string
 		widgets []*mouseEvTarget
@@ -479,7 +1068,8 @@ func (c *Container) mouseEvTargets(m *terminalapi.Mouse) ([]*mouseEvTarget, erro
 	// All the widgets that should receive this event.
 	// For now stable ordering (preOrder).
 	preOrder(c, &errStr, visitFunc(func(cur *Container) error {
-		if !cur.hasWidget() {
+		// Widgets in hidden containers don't receive any mouse events.
+		if !cur.hasWidget() || cur.isHidden() {
 			return nil
 		}
 
@@ -489,6 +1079,10 @@ func (c *Container) mouseEvTargets(m *terminalapi.Mouse) ([]*mouseEvTarget, erro
 			return err
 		}
 
+		if motion && !wOpts.WantMouseMove {
+			return nil
+		}
+
 		meta := &widgetapi.EventMeta{
 			Focused: cur.focusTracker.isActive(cur),
 		}
@@ -500,18 +1094,18 @@ func (c *Container) mouseEvTargets(m *terminalapi.Mouse) ([]*mouseEvTarget, erro
 		case widgetapi.MouseScopeWidget:
 			// Only if the event falls inside of the widget's canvas.
 			if m.Position.In(wa) {
-				widgets = append(widgets, newMouseEvTarget(cur.opts.widget, wa, m, meta))
+				widgets = append(widgets, newMouseEvTarget(cur.opts.id, cur.opts.widget, wa, m, meta))
 			}
 
 		case widgetapi.MouseScopeContainer:
 			// Only if the event falls inside the widget's parent container.
 			if m.Position.In(cur.area) {
-				widgets = append(widgets, newMouseEvTarget(cur.opts.widget, wa, m, meta))
+				widgets = append(widgets, newMouseEvTarget(cur.opts.id, cur.opts.widget, wa, m, meta))
 			}
 
 		case widgetapi.MouseScopeGlobal:
 			// Widget wants all mouse events.
-			widgets = append(widgets, newMouseEvTarget(cur.opts.widget, wa, m, meta))
+			widgets = append(widgets, newMouseEvTarget(cur.opts.id, cur.opts.widget, wa, m, meta))
 		}
 		return nil
 	}))
@@ -541,7 +1135,7 @@ func (c *Container) Subscribe(eds *event.DistributionSystem) {
 	}
 	eds.Subscribe(want, func(ev terminalapi.Event) {
 		if err := c.processEvent(ev); err != nil {
-			eds.Event(terminalapi.NewErrorf("failed to process event %v: %v", ev, err))
+			eds.Event(terminalapi.NewErrorf("failed to process event %v: %w", ev, err))
 		}
 	}, event.MaxRepetitive(maxReps))
 }
@@ -15,17 +15,21 @@
 package container
 
 import (
+	"errors"
 	"fmt"
 	"image"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/kylelemons/godebug/pretty"
 	"github.com/mum4k/termdash/align"
 	"github.com/mum4k/termdash/cell"
 	"github.com/mum4k/termdash/keyboard"
 	"github.com/mum4k/termdash/linestyle"
 	"github.com/mum4k/termdash/mouse"
+	"github.com/mum4k/termdash/private/canvas"
 	"github.com/mum4k/termdash/private/canvas/testcanvas"
 	"github.com/mum4k/termdash/private/draw"
 	"github.com/mum4k/termdash/private/draw/testdraw"
@@ -91,6 +95,14 @@ func TestNew(t *testing.T) {
 			},
 			wantContainerErr: true,
 		},
+		{
+			desc:     "fails on FocusButtons with an unsupported button",
+			termSize: image.Point{10, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(ft, FocusButtons(mouse.ButtonWheelUp))
+			},
+			wantContainerErr: true,
+		},
 		{
 			desc:     "fails on invalid option on the first vertical child container",
 			termSize: image.Point{10, 10},
@@ -155,6 +167,14 @@ func TestNew(t *testing.T) {
 			},
 			wantContainerErr: true,
 		},
+		{
+			desc:     "fails on negative HideBelowSize",
+			termSize: image.Point{10, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(ft, HideBelowSize(-1, 0))
+			},
+			wantContainerErr: true,
+		},
 		{
 			desc:     "fails on MarginTopPercent too low",
 			termSize: image.Point{10, 10},
@@ -1025,6 +1045,232 @@ func TestNew(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:     "fails on a split separator without a line style",
+			termSize: image.Point{10, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(),
+						Right(),
+						SplitSeparator(linestyle.None),
+					),
+				)
+			},
+			wantContainerErr: true,
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+		},
+		{
+			desc:     "vertical split with a separator",
+			termSize: image.Point{10, 4},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(),
+						Right(
+							Border(linestyle.Light),
+						),
+						SplitSeparator(linestyle.Light),
+					),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustHVLines(cvs, []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 3}},
+				})
+				testdraw.MustBorder(cvs, image.Rect(6, 0, 10, 4))
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "horizontal split with a separator and cell options",
+			termSize: image.Point{10, 6},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitHorizontal(
+						Top(
+							Border(linestyle.Light),
+						),
+						Bottom(),
+						SplitSeparator(linestyle.Double, cell.FgColor(cell.ColorRed)),
+					),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustBorder(cvs, image.Rect(0, 0, 10, 3))
+				testdraw.MustHVLines(cvs, []draw.HVLine{
+					{Start: image.Point{0, 3}, End: image.Point{9, 3}},
+				},
+					draw.HVLineStyle(linestyle.Double),
+					draw.HVLineCellOpts(cell.FgColor(cell.ColorRed)),
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "separator takes the cell from the first container when split from the end",
+			termSize: image.Point{10, 7},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitHorizontal(
+						Top(
+							Border(linestyle.Light),
+						),
+						Bottom(
+							Border(linestyle.Light),
+						),
+						SplitFixedFromEnd(3),
+						SplitSeparator(linestyle.Light),
+					),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustBorder(cvs, image.Rect(0, 0, 10, 3))
+				testdraw.MustHVLines(cvs, []draw.HVLine{
+					{Start: image.Point{0, 3}, End: image.Point{9, 3}},
+				})
+				testdraw.MustBorder(cvs, image.Rect(0, 4, 10, 7))
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "vertical separator is joined to the parent border",
+			termSize: image.Point{10, 6},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Border(linestyle.Light),
+					SplitVertical(
+						Left(),
+						Right(),
+						SplitSeparator(linestyle.Light),
+					),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustBorder(
+					cvs,
+					image.Rect(0, 0, 10, 6),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+				testdraw.MustHVLines(cvs, []draw.HVLine{
+					{Start: image.Point{5, 1}, End: image.Point{5, 4}},
+				})
+				testcanvas.MustSetCell(cvs, image.Point{5, 0}, '┬', cell.FgColor(cell.ColorDefault))
+				testcanvas.MustSetCell(cvs, image.Point{5, 5}, '┴', cell.FgColor(cell.ColorDefault))
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "horizontal separator is joined to the parent border",
+			termSize: image.Point{10, 6},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Border(linestyle.Light),
+					SplitHorizontal(
+						Top(),
+						Bottom(),
+						SplitSeparator(linestyle.Light),
+					),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustBorder(
+					cvs,
+					image.Rect(0, 0, 10, 6),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+				testdraw.MustHVLines(cvs, []draw.HVLine{
+					{Start: image.Point{1, 3}, End: image.Point{8, 3}},
+				})
+				testcanvas.MustSetCell(cvs, image.Point{0, 3}, '├', cell.FgColor(cell.ColorDefault))
+				testcanvas.MustSetCell(cvs, image.Point{9, 3}, '┤', cell.FgColor(cell.ColorDefault))
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "separator isn't joined to a border of a different line style",
+			termSize: image.Point{10, 6},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Border(linestyle.Double),
+					SplitVertical(
+						Left(),
+						Right(),
+						SplitSeparator(linestyle.Light),
+					),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustBorder(
+					cvs,
+					image.Rect(0, 0, 10, 6),
+					draw.BorderLineStyle(linestyle.Double),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+				testdraw.MustHVLines(cvs, []draw.HVLine{
+					{Start: image.Point{5, 1}, End: image.Point{5, 4}},
+				})
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "separator is mirrored with right to left",
+			termSize: image.Point{10, 4},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					RightToLeft(),
+					SplitVertical(
+						Left(
+							Border(linestyle.Light),
+						),
+						Right(
+							Border(linestyle.Light),
+						),
+						SplitFixed(3),
+						SplitSeparator(linestyle.Light),
+					),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustBorder(cvs, image.Rect(7, 0, 10, 4))
+				testdraw.MustHVLines(cvs, []draw.HVLine{
+					{Start: image.Point{6, 0}, End: image.Point{6, 3}},
+				})
+				testdraw.MustBorder(cvs, image.Rect(0, 0, 6, 4))
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
 		{
 			desc:     "multi level split",
 			termSize: image.Point{10, 16},
@@ -1387,73 +1633,76 @@ func TestKeyboard(t *testing.T) {
 		wantErr       bool
 	}{
 		{
-			desc:     "event not forwarded if container has no widget",
-			termSize: image.Point{10, 10},
-			container: func(ft *faketerm.Terminal) (*Container, error) {
-				return New(ft)
-			},
-			events: []terminalapi.Event{
-				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
-			},
-			want: func(size image.Point) *faketerm.Terminal {
-				return faketerm.MustNew(size)
-			},
-		},
-		{
-			desc:     "event forwarded to focused container only",
-			termSize: image.Point{40, 20},
+			desc:     "mouse motion forwarded only to widgets that requested it",
+			termSize: image.Point{50, 20},
 			container: func(ft *faketerm.Terminal) (*Container, error) {
 				return New(
 					ft,
 					SplitVertical(
 						Left(
-							PlaceWidget(fakewidget.New(widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused})),
+							PlaceWidget(fakewidget.New(widgetapi.Options{
+								WantMouse:     widgetapi.MouseScopeWidget,
+								WantMouseMove: true,
+							})),
 						),
 						Right(
-							SplitHorizontal(
-								Top(
-									PlaceWidget(fakewidget.New(widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused})),
-								),
-								Bottom(
-									PlaceWidget(fakewidget.New(widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused})),
-								),
-							),
+							PlaceWidget(fakewidget.New(widgetapi.Options{WantMouse: widgetapi.MouseScopeGlobal})),
 						),
 					),
 				)
 			},
 			events: []terminalapi.Event{
-				// Move focus to the target container.
-				&terminalapi.Mouse{Position: image.Point{39, 19}, Button: mouse.ButtonLeft},
-				&terminalapi.Mouse{Position: image.Point{39, 19}, Button: mouse.ButtonRelease},
-				// Send the keyboard event.
-				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+				&terminalapi.Mouse{Position: image.Point{10, 5}, Button: mouse.ButtonRelease},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 
-				// Widgets that aren't focused don't get the key.
 				fakewidget.MustDraw(
 					ft,
-					testcanvas.MustNew(image.Rect(0, 0, 20, 20)),
+					testcanvas.MustNew(image.Rect(0, 0, 25, 20)),
 					&widgetapi.Meta{},
-					widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused},
+					widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget},
+					&fakewidget.Event{
+						Ev:   &terminalapi.Mouse{Position: image.Point{10, 5}, Button: mouse.ButtonRelease},
+						Meta: &widgetapi.EventMeta{},
+					},
 				)
+
+				// Didn't request mouse motion events.
 				fakewidget.MustDraw(
 					ft,
-					testcanvas.MustNew(image.Rect(20, 0, 40, 10)),
+					testcanvas.MustNew(image.Rect(25, 0, 50, 20)),
 					&widgetapi.Meta{},
-					widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused},
+					widgetapi.Options{},
+				)
+				return ft
+			},
+		},
+		{
+			desc:     "mouse motion after a button release isn't forwarded to widgets that didn't request it",
+			termSize: image.Point{30, 20},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					PlaceWidget(fakewidget.New(widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget})),
 				)
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonRelease},
+				&terminalapi.Mouse{Position: image.Point{2, 2}, Button: mouse.ButtonRelease},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
 
-				// The focused widget receives the key.
+				// The last event the widget received is the button release.
 				fakewidget.MustDraw(
 					ft,
-					testcanvas.MustNew(image.Rect(20, 10, 40, 20)),
+					testcanvas.MustNew(ft.Area()),
 					&widgetapi.Meta{Focused: true},
-					widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused},
+					widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget},
 					&fakewidget.Event{
-						Ev:   &terminalapi.Keyboard{Key: keyboard.KeyEnter},
+						Ev:   &terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonRelease},
 						Meta: &widgetapi.EventMeta{Focused: true},
 					},
 				)
@@ -1461,31 +1710,284 @@ func TestKeyboard(t *testing.T) {
 			},
 		},
 		{
-			desc:     "event forwarded to all widgets that requested global key scope",
-			termSize: image.Point{40, 20},
+			desc:     "mouse motion after a button release is forwarded to widgets that requested it",
+			termSize: image.Point{30, 20},
 			container: func(ft *faketerm.Terminal) (*Container, error) {
 				return New(
 					ft,
-					SplitVertical(
-						Left(
-							PlaceWidget(fakewidget.New(widgetapi.Options{WantKeyboard: widgetapi.KeyScopeGlobal})),
-						),
-						Right(
-							SplitHorizontal(
-								Top(
-									PlaceWidget(fakewidget.New(widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused})),
-								),
-								Bottom(
-									PlaceWidget(fakewidget.New(widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused})),
-								),
-							),
-						),
-					),
+					PlaceWidget(fakewidget.New(widgetapi.Options{
+						WantMouse:     widgetapi.MouseScopeWidget,
+						WantMouseMove: true,
+					})),
 				)
 			},
 			events: []terminalapi.Event{
-				// Move focus to the target container.
-				&terminalapi.Mouse{Position: image.Point{39, 19}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonRelease},
+				&terminalapi.Mouse{Position: image.Point{2, 2}, Button: mouse.ButtonRelease},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(ft.Area()),
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget},
+					&fakewidget.Event{
+						Ev:   &terminalapi.Mouse{Position: image.Point{2, 2}, Button: mouse.ButtonRelease},
+						Meta: &widgetapi.EventMeta{Focused: true},
+					},
+				)
+				return ft
+			},
+		},
+		{
+			desc:     "toggle chrome key hides borders and titles of the sub tree",
+			termSize: image.Point{20, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					KeyToggleChrome('h'),
+					Border(linestyle.Light),
+					BorderTitle("ab"),
+					SplitVertical(
+						Left(
+							Border(linestyle.Light),
+							PlaceWidget(fakewidget.New(widgetapi.Options{})),
+						),
+						Right(
+							PlaceWidget(fakewidget.New(widgetapi.Options{})),
+						),
+					),
+				)
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'h'},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				// The widgets get the cells of the hidden borders.
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(0, 0, 10, 10)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(10, 0, 20, 10)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				return ft
+			},
+		},
+		{
+			desc:     "pressing the toggle chrome key again restores the borders",
+			termSize: image.Point{20, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					KeyToggleChrome('h'),
+					Border(linestyle.Light),
+					BorderTitle("ab"),
+					SplitVertical(
+						Left(
+							Border(linestyle.Light),
+							PlaceWidget(fakewidget.New(widgetapi.Options{})),
+						),
+						Right(
+							PlaceWidget(fakewidget.New(widgetapi.Options{})),
+						),
+					),
+				)
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'h'},
+				&terminalapi.Keyboard{Key: 'h'},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustBorder(
+					cvs,
+					cvs.Area(),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+					draw.BorderTitle(
+						"ab",
+						draw.OverrunModeThreeDot,
+						cell.FgColor(cell.ColorYellow),
+					),
+				)
+				testdraw.MustBorder(cvs, image.Rect(1, 1, 10, 9))
+				testcanvas.MustApply(cvs, ft)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(2, 2, 9, 8)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(10, 1, 19, 9)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				return ft
+			},
+		},
+		{
+			desc:     "toggle chrome key set on a sub container only hides its sub tree",
+			termSize: image.Point{20, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Border(linestyle.Light),
+					SplitVertical(
+						Left(
+							KeyToggleChrome('h'),
+							Border(linestyle.Light),
+							PlaceWidget(fakewidget.New(widgetapi.Options{})),
+						),
+						Right(
+							Border(linestyle.Light),
+							PlaceWidget(fakewidget.New(widgetapi.Options{})),
+						),
+					),
+				)
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'h'},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustBorder(
+					cvs,
+					cvs.Area(),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+				testdraw.MustBorder(cvs, image.Rect(10, 1, 19, 9))
+				testcanvas.MustApply(cvs, ft)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(1, 1, 10, 9)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(11, 2, 18, 8)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				return ft
+			},
+		},
+		{
+			desc:     "event not forwarded if container has no widget",
+			termSize: image.Point{10, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(ft)
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+		},
+		{
+			desc:     "event forwarded to focused container only",
+			termSize: image.Point{40, 20},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(
+							PlaceWidget(fakewidget.New(widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused})),
+						),
+						Right(
+							SplitHorizontal(
+								Top(
+									PlaceWidget(fakewidget.New(widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused})),
+								),
+								Bottom(
+									PlaceWidget(fakewidget.New(widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused})),
+								),
+							),
+						),
+					),
+				)
+			},
+			events: []terminalapi.Event{
+				// Move focus to the target container.
+				&terminalapi.Mouse{Position: image.Point{39, 19}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{39, 19}, Button: mouse.ButtonRelease},
+				// Send the keyboard event.
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				// Widgets that aren't focused don't get the key.
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(0, 0, 20, 20)),
+					&widgetapi.Meta{},
+					widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused},
+				)
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(20, 0, 40, 10)),
+					&widgetapi.Meta{},
+					widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused},
+				)
+
+				// The focused widget receives the key.
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(20, 10, 40, 20)),
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused},
+					&fakewidget.Event{
+						Ev:   &terminalapi.Keyboard{Key: keyboard.KeyEnter},
+						Meta: &widgetapi.EventMeta{Focused: true},
+					},
+				)
+				return ft
+			},
+		},
+		{
+			desc:     "event forwarded to all widgets that requested global key scope",
+			termSize: image.Point{40, 20},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(
+							PlaceWidget(fakewidget.New(widgetapi.Options{WantKeyboard: widgetapi.KeyScopeGlobal})),
+						),
+						Right(
+							SplitHorizontal(
+								Top(
+									PlaceWidget(fakewidget.New(widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused})),
+								),
+								Bottom(
+									PlaceWidget(fakewidget.New(widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused})),
+								),
+							),
+						),
+					),
+				)
+			},
+			events: []terminalapi.Event{
+				// Move focus to the target container.
+				&terminalapi.Mouse{Position: image.Point{39, 19}, Button: mouse.ButtonLeft},
 				&terminalapi.Mouse{Position: image.Point{39, 19}, Button: mouse.ButtonRelease},
 				// Send the keyboard event.
 				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
@@ -1897,40 +2399,276 @@ func TestMouse(t *testing.T) {
 			},
 		},
 		{
-			desc:     "event focuses the target container after terminal resize (falls onto the new area), regression for #169",
-			termSize: image.Point{50, 20},
+			desc:     "borderless container gains a border when focused by a mouse click",
+			termSize: image.Point{20, 10},
 			container: func(ft *faketerm.Terminal) (*Container, error) {
-				// Decrease the terminal size, so when container is created, it
-				// only sees width of 30.
-				if err := ft.Resize(image.Point{30, 20}); err != nil {
-					return nil, err
-				}
-				c, err := New(
+				return New(
 					ft,
 					SplitVertical(
 						Left(
-							PlaceWidget(fakewidget.New(widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget})),
+							PlaceWidget(fakewidget.New(widgetapi.Options{})),
+							BorderOnFocus(linestyle.Light),
 						),
 						Right(
-							SplitHorizontal(
-								Top(
-									Border(linestyle.Light),
-									PlaceWidget(fakewidget.New(widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget})),
-								),
-								Bottom(
-									PlaceWidget(fakewidget.New(widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget})),
-								),
-							),
+							PlaceWidget(fakewidget.New(widgetapi.Options{})),
+							BorderOnFocus(linestyle.Light),
 						),
 					),
 				)
-				if err != nil {
-					return nil, err
-				}
-				// Increase the width back to 50 so the mouse clicks land on the "new" area.
-				if err := ft.Resize(image.Point{50, 20}); err != nil {
-					return nil, err
-				}
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{15, 5}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{15, 5}, Button: mouse.ButtonRelease},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				// The space for the border is reserved even when not focused.
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(1, 1, 9, 9)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+
+				cvs := testcanvas.MustNew(image.Rect(10, 0, 20, 10))
+				testdraw.MustBorder(
+					cvs,
+					image.Rect(0, 0, 10, 10),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+				testcanvas.MustApply(cvs, ft)
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(11, 1, 19, 9)),
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{},
+				)
+				return ft
+			},
+		},
+		{
+			desc:     "focused border replaces the border style",
+			termSize: image.Point{20, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(
+							Border(linestyle.Light),
+							BorderOnFocus(linestyle.Double),
+						),
+						Right(
+							Border(linestyle.Light),
+							BorderOnFocus(linestyle.Double),
+						),
+					),
+				)
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{15, 5}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{15, 5}, Button: mouse.ButtonRelease},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustBorder(
+					cvs,
+					image.Rect(0, 0, 10, 10),
+				)
+				testdraw.MustBorder(
+					cvs,
+					image.Rect(10, 0, 20, 10),
+					draw.BorderLineStyle(linestyle.Double),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "focused border isn't drawn for a widget with SuppressContainerFocusBorder",
+			termSize: image.Point{20, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(
+							PlaceWidget(fakewidget.New(widgetapi.Options{})),
+							Border(linestyle.Light),
+							BorderOnFocus(linestyle.Double),
+						),
+						Right(
+							PlaceWidget(fakewidget.New(widgetapi.Options{SuppressContainerFocusBorder: true})),
+							Border(linestyle.Light),
+							BorderOnFocus(linestyle.Double),
+						),
+					),
+				)
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{15, 5}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{15, 5}, Button: mouse.ButtonRelease},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustBorder(cvs, image.Rect(0, 0, 10, 10))
+				testdraw.MustBorder(cvs, image.Rect(10, 0, 20, 10))
+				testcanvas.MustApply(cvs, ft)
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(1, 1, 9, 9)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(11, 1, 19, 9)),
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{},
+				)
+				return ft
+			},
+		},
+		{
+			desc:     "focused border is drawn for a widget without SuppressContainerFocusBorder",
+			termSize: image.Point{20, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(
+							PlaceWidget(fakewidget.New(widgetapi.Options{})),
+							Border(linestyle.Light),
+							BorderOnFocus(linestyle.Double),
+						),
+						Right(
+							PlaceWidget(fakewidget.New(widgetapi.Options{SuppressContainerFocusBorder: true})),
+							Border(linestyle.Light),
+							BorderOnFocus(linestyle.Double),
+						),
+					),
+				)
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{5, 5}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{5, 5}, Button: mouse.ButtonRelease},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustBorder(
+					cvs,
+					image.Rect(0, 0, 10, 10),
+					draw.BorderLineStyle(linestyle.Double),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+				testdraw.MustBorder(cvs, image.Rect(10, 0, 20, 10))
+				testcanvas.MustApply(cvs, ft)
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(1, 1, 9, 9)),
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{},
+				)
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(11, 1, 19, 9)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				return ft
+			},
+		},
+		{
+			desc:     "borderless container gains a background when focused by a mouse click",
+			termSize: image.Point{20, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					FocusedBackground(cell.ColorBlue),
+					SplitVertical(
+						Left(
+							PlaceWidget(fakewidget.New(widgetapi.Options{})),
+						),
+						Right(
+							PlaceWidget(fakewidget.New(widgetapi.Options{})),
+							PaddingLeft(1),
+						),
+					),
+				)
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{15, 5}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{15, 5}, Button: mouse.ButtonRelease},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(0, 0, 10, 10)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(11, 0, 20, 10)),
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{},
+				)
+
+				// The background applies to the padding and all the cells
+				// the widget didn't set a background color on.
+				buf := ft.BackBuffer()
+				for x := 10; x < 20; x++ {
+					for y := 0; y < 10; y++ {
+						c := buf[x][y]
+						if c.Opts.BgColor != cell.ColorDefault {
+							continue
+						}
+						if err := ft.SetCell(image.Point{x, y}, c.Rune, cell.BgColor(cell.ColorBlue)); err != nil {
+							panic(err)
+						}
+					}
+				}
+				return ft
+			},
+		},
+		{
+			desc:     "event focuses the target container after terminal resize (falls onto the new area), regression for #169",
+			termSize: image.Point{50, 20},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				// Decrease the terminal size, so when container is created, it
+				// only sees width of 30.
+				if err := ft.Resize(image.Point{30, 20}); err != nil {
+					return nil, err
+				}
+				c, err := New(
+					ft,
+					SplitVertical(
+						Left(
+							PlaceWidget(fakewidget.New(widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget})),
+						),
+						Right(
+							SplitHorizontal(
+								Top(
+									Border(linestyle.Light),
+									PlaceWidget(fakewidget.New(widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget})),
+								),
+								Bottom(
+									PlaceWidget(fakewidget.New(widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget})),
+								),
+							),
+						),
+					),
+				)
+				if err != nil {
+					return nil, err
+				}
+				// Increase the width back to 50 so the mouse clicks land on the "new" area.
+				if err := ft.Resize(image.Point{50, 20}); err != nil {
+					return nil, err
+				}
 				// Draw once so the container has a chance to update the tracked area.
 				if err := c.Draw(); err != nil {
 					return nil, err
@@ -2320,65 +3058,69 @@ func TestMouse(t *testing.T) {
 			},
 		},
 		{
-			desc:     "MouseScopeWidget event not forwarded if it falls outside of widget's canvas",
-			termSize: image.Point{20, 20},
+			desc:     "event position adjusted for a widget with maximum size aligned top left",
+			termSize: image.Point{30, 20},
 			container: func(ft *faketerm.Terminal) (*Container, error) {
 				return New(
 					ft,
 					PlaceWidget(
 						fakewidget.New(widgetapi.Options{
-							WantMouse: widgetapi.MouseScopeWidget,
-							Ratio:     image.Point{2, 1},
+							WantMouse:   widgetapi.MouseScopeWidget,
+							MaximumSize: image.Point{24, 10},
 						}),
 					),
-					AlignVertical(align.VerticalMiddle),
-					AlignHorizontal(align.HorizontalCenter),
+					AlignHorizontal(align.HorizontalLeft),
+					AlignVertical(align.VerticalTop),
 				)
 			},
 			events: []terminalapi.Event{
-				&terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{5, 3}, Button: mouse.ButtonLeft},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 
 				fakewidget.MustDraw(
 					ft,
-					testcanvas.MustNew(image.Rect(0, 5, 20, 15)),
+					testcanvas.MustNew(image.Rect(0, 0, 24, 10)),
 					&widgetapi.Meta{Focused: true},
-					widgetapi.Options{},
+					widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget},
+					&fakewidget.Event{
+						Ev:   &terminalapi.Mouse{Position: image.Point{5, 3}, Button: mouse.ButtonLeft},
+						Meta: &widgetapi.EventMeta{Focused: true},
+					},
 				)
 				return ft
 			},
 		},
 		{
-			desc:     "MouseScopeContainer event forwarded if it falls outside of widget's canvas",
-			termSize: image.Point{22, 20},
+			desc:     "event position adjusted for a widget with maximum size aligned in the center",
+			termSize: image.Point{30, 20},
 			container: func(ft *faketerm.Terminal) (*Container, error) {
 				return New(
 					ft,
 					PlaceWidget(
 						fakewidget.New(widgetapi.Options{
-							WantMouse: widgetapi.MouseScopeContainer,
-							Ratio:     image.Point{2, 1},
+							WantMouse:   widgetapi.MouseScopeWidget,
+							MaximumSize: image.Point{24, 10},
 						}),
 					),
-					AlignVertical(align.VerticalMiddle),
 					AlignHorizontal(align.HorizontalCenter),
+					AlignVertical(align.VerticalMiddle),
 				)
 			},
 			events: []terminalapi.Event{
-				&terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{8, 8}, Button: mouse.ButtonLeft},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 
 				fakewidget.MustDraw(
 					ft,
-					testcanvas.MustNew(image.Rect(0, 4, 22, 15)),
+					testcanvas.MustNew(image.Rect(3, 5, 27, 15)),
 					&widgetapi.Meta{Focused: true},
 					widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget},
 					&fakewidget.Event{
-						Ev:   &terminalapi.Mouse{Position: image.Point{-1, -1}, Button: mouse.ButtonLeft},
+						Ev:   &terminalapi.Mouse{Position: image.Point{5, 3}, Button: mouse.ButtonLeft},
 						Meta: &widgetapi.EventMeta{Focused: true},
 					},
 				)
@@ -2386,34 +3128,34 @@ func TestMouse(t *testing.T) {
 			},
 		},
 		{
-			desc:     "MouseScopeGlobal event forwarded if it falls outside of widget's canvas",
-			termSize: image.Point{22, 20},
+			desc:     "event position adjusted for a widget with maximum size aligned bottom right",
+			termSize: image.Point{30, 20},
 			container: func(ft *faketerm.Terminal) (*Container, error) {
 				return New(
 					ft,
 					PlaceWidget(
 						fakewidget.New(widgetapi.Options{
-							WantMouse: widgetapi.MouseScopeGlobal,
-							Ratio:     image.Point{2, 1},
+							WantMouse:   widgetapi.MouseScopeWidget,
+							MaximumSize: image.Point{24, 10},
 						}),
 					),
-					AlignVertical(align.VerticalMiddle),
-					AlignHorizontal(align.HorizontalCenter),
+					AlignHorizontal(align.HorizontalRight),
+					AlignVertical(align.VerticalBottom),
 				)
 			},
 			events: []terminalapi.Event{
-				&terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{11, 13}, Button: mouse.ButtonLeft},
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 
 				fakewidget.MustDraw(
 					ft,
-					testcanvas.MustNew(image.Rect(0, 4, 22, 15)),
+					testcanvas.MustNew(image.Rect(6, 10, 30, 20)),
 					&widgetapi.Meta{Focused: true},
 					widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget},
 					&fakewidget.Event{
-						Ev:   &terminalapi.Mouse{Position: image.Point{-1, -1}, Button: mouse.ButtonLeft},
+						Ev:   &terminalapi.Mouse{Position: image.Point{5, 3}, Button: mouse.ButtonLeft},
 						Meta: &widgetapi.EventMeta{Focused: true},
 					},
 				)
@@ -2421,24 +3163,19 @@ func TestMouse(t *testing.T) {
 			},
 		},
 		{
-			desc:     "MouseScopeWidget event not forwarded if it falls to another container",
+			desc:     "MouseScopeWidget event not forwarded if it falls outside of widget's canvas",
 			termSize: image.Point{20, 20},
 			container: func(ft *faketerm.Terminal) (*Container, error) {
 				return New(
 					ft,
-					SplitHorizontal(
-						Top(),
-						Bottom(
-							PlaceWidget(
-								fakewidget.New(widgetapi.Options{
-									WantMouse: widgetapi.MouseScopeWidget,
-									Ratio:     image.Point{2, 1},
-								}),
-							),
-							AlignVertical(align.VerticalMiddle),
-							AlignHorizontal(align.HorizontalCenter),
-						),
+					PlaceWidget(
+						fakewidget.New(widgetapi.Options{
+							WantMouse: widgetapi.MouseScopeWidget,
+							Ratio:     image.Point{2, 1},
+						}),
 					),
+					AlignVertical(align.VerticalMiddle),
+					AlignHorizontal(align.HorizontalCenter),
 				)
 			},
 			events: []terminalapi.Event{
@@ -2449,17 +3186,169 @@ func TestMouse(t *testing.T) {
 
 				fakewidget.MustDraw(
 					ft,
-					testcanvas.MustNew(image.Rect(0, 10, 20, 20)),
-					&widgetapi.Meta{},
+					testcanvas.MustNew(image.Rect(0, 5, 20, 15)),
+					&widgetapi.Meta{Focused: true},
 					widgetapi.Options{},
 				)
 				return ft
 			},
 		},
 		{
-			desc:     "MouseScopeContainer event not forwarded if it falls to another container",
-			termSize: image.Point{20, 20},
-			container: func(ft *faketerm.Terminal) (*Container, error) {
+			desc:     "MouseScopeContainer event forwarded if it falls outside of widget's canvas",
+			termSize: image.Point{22, 20},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					PlaceWidget(
+						fakewidget.New(widgetapi.Options{
+							WantMouse: widgetapi.MouseScopeContainer,
+							Ratio:     image.Point{2, 1},
+						}),
+					),
+					AlignVertical(align.VerticalMiddle),
+					AlignHorizontal(align.HorizontalCenter),
+				)
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(0, 4, 22, 15)),
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget},
+					&fakewidget.Event{
+						Ev:   &terminalapi.Mouse{Position: image.Point{-1, -1}, Button: mouse.ButtonLeft},
+						Meta: &widgetapi.EventMeta{Focused: true},
+					},
+				)
+				return ft
+			},
+		},
+		{
+			desc:     "MouseScopeGlobal event forwarded if it falls outside of widget's canvas",
+			termSize: image.Point{22, 20},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					PlaceWidget(
+						fakewidget.New(widgetapi.Options{
+							WantMouse: widgetapi.MouseScopeGlobal,
+							Ratio:     image.Point{2, 1},
+						}),
+					),
+					AlignVertical(align.VerticalMiddle),
+					AlignHorizontal(align.HorizontalCenter),
+				)
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(0, 4, 22, 15)),
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget},
+					&fakewidget.Event{
+						Ev:   &terminalapi.Mouse{Position: image.Point{-1, -1}, Button: mouse.ButtonLeft},
+						Meta: &widgetapi.EventMeta{Focused: true},
+					},
+				)
+				return ft
+			},
+		},
+		{
+			desc:     "MouseScopeWidget event not forwarded if it falls to another container",
+			termSize: image.Point{20, 20},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitHorizontal(
+						Top(),
+						Bottom(
+							PlaceWidget(
+								fakewidget.New(widgetapi.Options{
+									WantMouse: widgetapi.MouseScopeWidget,
+									Ratio:     image.Point{2, 1},
+								}),
+							),
+							AlignVertical(align.VerticalMiddle),
+							AlignHorizontal(align.HorizontalCenter),
+						),
+					),
+				)
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(0, 10, 20, 20)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				return ft
+			},
+		},
+		{
+			desc:     "horizontal scroll wheel events forwarded to the targeted widget",
+			termSize: image.Point{60, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(
+							PlaceWidget(fakewidget.New(widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget})),
+						),
+						Right(
+							PlaceWidget(fakewidget.New(widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget})),
+						),
+					),
+				)
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{45, 5}, Button: mouse.ButtonWheelLeft},
+				&terminalapi.Mouse{Position: image.Point{45, 6}, Button: mouse.ButtonWheelRight},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(0, 0, 30, 10)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(30, 0, 60, 10)),
+					&widgetapi.Meta{},
+					widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget},
+					&fakewidget.Event{
+						Ev:   &terminalapi.Mouse{Position: image.Point{15, 5}, Button: mouse.ButtonWheelLeft},
+						Meta: &widgetapi.EventMeta{},
+					},
+					&fakewidget.Event{
+						Ev:   &terminalapi.Mouse{Position: image.Point{15, 6}, Button: mouse.ButtonWheelRight},
+						Meta: &widgetapi.EventMeta{},
+					},
+				)
+				return ft
+			},
+		},
+		{
+			desc:     "MouseScopeContainer event not forwarded if it falls to another container",
+			termSize: image.Point{20, 20},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
 				return New(
 					ft,
 					SplitHorizontal(
@@ -3157,3 +4046,1721 @@ func TestUpdate(t *testing.T) {
 	}
 
 }
+
+func TestUpdateAll(t *testing.T) {
+	isPane := func(id string) bool {
+		return strings.HasPrefix(id, "pane")
+	}
+
+	tests := []struct {
+		desc          string
+		match         func(id string) bool
+		updateOpts    []Option
+		wantUpdateErr bool
+		want          func(size image.Point) *faketerm.Terminal
+	}{
+		{
+			desc:          "fails on nil match function",
+			wantUpdateErr: true,
+		},
+		{
+			desc: "fails when no container matches",
+			match: func(id string) bool {
+				return id == "myID"
+			},
+			wantUpdateErr: true,
+		},
+		{
+			desc:  "fails on invalid options",
+			match: isPane,
+			updateOpts: []Option{
+				MarginTop(-1),
+			},
+			wantUpdateErr: true,
+		},
+		{
+			desc:  "applies the border color to all matching containers",
+			match: isPane,
+			updateOpts: []Option{
+				BorderColor(cell.ColorRed),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustBorder(cvs, image.Rect(0, 0, 10, 10), draw.BorderCellOpts(cell.FgColor(cell.ColorRed)))
+				testdraw.MustBorder(cvs, image.Rect(10, 0, 20, 5), draw.BorderCellOpts(cell.FgColor(cell.ColorRed)))
+				testdraw.MustBorder(cvs, image.Rect(10, 5, 20, 10))
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "matches all containers including those without an ID",
+			match: func(id string) bool {
+				return true
+			},
+			updateOpts: []Option{
+				BorderColor(cell.ColorRed),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustBorder(cvs, image.Rect(0, 0, 10, 10), draw.BorderCellOpts(cell.FgColor(cell.ColorRed)))
+				testdraw.MustBorder(cvs, image.Rect(10, 0, 20, 5), draw.BorderCellOpts(cell.FgColor(cell.ColorRed)))
+				testdraw.MustBorder(cvs, image.Rect(10, 5, 20, 10), draw.BorderCellOpts(cell.FgColor(cell.ColorRed)))
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := faketerm.MustNew(image.Point{20, 10})
+			cont, err := New(
+				got,
+				SplitVertical(
+					Left(
+						ID("pane1"),
+						Border(linestyle.Light),
+					),
+					Right(
+						SplitHorizontal(
+							Top(
+								ID("pane2"),
+								Border(linestyle.Light),
+							),
+							Bottom(
+								ID("other"),
+								Border(linestyle.Light),
+							),
+						),
+					),
+				),
+			)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+
+			{
+				err := cont.UpdateAll(tc.match, tc.updateOpts...)
+				if (err != nil) != tc.wantUpdateErr {
+					t.Errorf("UpdateAll => unexpected error:%v, wantErr:%v", err, tc.wantUpdateErr)
+				}
+				if err != nil {
+					return
+				}
+			}
+
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+			if diff := faketerm.Diff(tc.want(got.Size()), got); diff != "" {
+				t.Errorf("Draw => %v", diff)
+			}
+		})
+	}
+}
+
+// closingWidget is a fake widget that implements widgetapi.Closer.
+type closingWidget struct {
+	*fakewidget.Mirror
+
+	// closed counts the calls to Close.
+	closed int
+	// onClose if not nil is called from Close and its result is returned.
+	onClose func() error
+}
+
+// newClosingWidget returns a new closingWidget.
+func newClosingWidget() *closingWidget {
+	return &closingWidget{
+		Mirror: fakewidget.New(widgetapi.Options{}),
+	}
+}
+
+// Close implements widgetapi.Closer.Close.
+func (cw *closingWidget) Close() error {
+	cw.closed++
+	if cw.onClose != nil {
+		return cw.onClose()
+	}
+	return nil
+}
+
+func TestUpdateClosesWidgets(t *testing.T) {
+	tests := []struct {
+		desc string
+		// update performs the update on the container and returns its error.
+		// The widgets are placed in containers with IDs "first" and "second"
+		// under the root container with ID "root".
+		update        func(c *Container, first, second *closingWidget) error
+		wantFirst     int
+		wantSecond    int
+		wantUpdateErr bool
+	}{
+		{
+			desc: "replacing a widget closes it",
+			update: func(c *Container, first, second *closingWidget) error {
+				return c.Update("first", PlaceWidget(fakewidget.New(widgetapi.Options{})))
+			},
+			wantFirst: 1,
+		},
+		{
+			desc: "placing the same widget again doesn't close it",
+			update: func(c *Container, first, second *closingWidget) error {
+				return c.Update("first", PlaceWidget(first))
+			},
+		},
+		{
+			desc: "moving a widget into another container doesn't close it",
+			update: func(c *Container, first, second *closingWidget) error {
+				return c.Update("second", PlaceWidget(first))
+			},
+			wantSecond: 1,
+		},
+		{
+			desc: "clearing a container closes its widget",
+			update: func(c *Container, first, second *closingWidget) error {
+				return c.Update("second", Clear())
+			},
+			wantSecond: 1,
+		},
+		{
+			desc: "splitting a container closes its widget",
+			update: func(c *Container, first, second *closingWidget) error {
+				return c.Update("first", SplitVertical(Left(), Right()))
+			},
+			wantFirst: 1,
+		},
+		{
+			desc: "removing sub containers closes all their widgets",
+			update: func(c *Container, first, second *closingWidget) error {
+				return c.Update("root", PlaceWidget(fakewidget.New(widgetapi.Options{})))
+			},
+			wantFirst:  1,
+			wantSecond: 1,
+		},
+		{
+			desc: "widgets aren't closed when the update fails",
+			update: func(c *Container, first, second *closingWidget) error {
+				return c.Update("root", Clear(), MarginTop(-1))
+			},
+			wantUpdateErr: true,
+		},
+		{
+			desc: "returns the error from Close after closing all the widgets",
+			update: func(c *Container, first, second *closingWidget) error {
+				first.onClose = func() error {
+					return fmt.Errorf("close failed")
+				}
+				return c.Update("root", Clear())
+			},
+			wantFirst:     1,
+			wantSecond:    1,
+			wantUpdateErr: true,
+		},
+		{
+			desc: "widgets can call container methods from Close",
+			update: func(c *Container, first, second *closingWidget) error {
+				first.onClose = func() error {
+					return c.Update("second", BorderTitle("closed"))
+				}
+				return c.Update("first", Clear())
+			},
+			wantFirst: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			first := newClosingWidget()
+			second := newClosingWidget()
+			cont, err := New(
+				faketerm.MustNew(image.Point{10, 10}),
+				ID("root"),
+				SplitVertical(
+					Left(
+						ID("first"),
+						PlaceWidget(first),
+					),
+					Right(
+						ID("second"),
+						PlaceWidget(second),
+					),
+				),
+			)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+
+			err = tc.update(cont, first, second)
+			if (err != nil) != tc.wantUpdateErr {
+				t.Errorf("Update => unexpected error: %v, wantUpdateErr: %v", err, tc.wantUpdateErr)
+			}
+
+			if first.closed != tc.wantFirst {
+				t.Errorf("first widget closed %d times, want %d", first.closed, tc.wantFirst)
+			}
+			if second.closed != tc.wantSecond {
+				t.Errorf("second widget closed %d times, want %d", second.closed, tc.wantSecond)
+			}
+		})
+	}
+}
+
+// valueClosingWidget is a closingWidget implemented by a value type that
+// isn't comparable, since it holds a slice.
+type valueClosingWidget struct {
+	*closingWidget
+
+	tags []string
+}
+
+func TestUpdateClosesNonComparableWidgets(t *testing.T) {
+	tests := []struct {
+		desc       string
+		update     func(c *Container) error
+		wantFirst  int
+		wantSecond int
+	}{
+		{
+			desc: "replacing a widget closes it",
+			update: func(c *Container) error {
+				return c.Update("first", PlaceWidget(fakewidget.New(widgetapi.Options{})))
+			},
+			wantFirst: 1,
+		},
+		{
+			desc: "updating the container options doesn't close its widget",
+			update: func(c *Container) error {
+				return c.Update("first", BorderTitle("title"))
+			},
+		},
+		{
+			desc: "clearing a container closes only its widget",
+			update: func(c *Container) error {
+				return c.Update("second", Clear())
+			},
+			wantSecond: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			first := newClosingWidget()
+			second := newClosingWidget()
+			cont, err := New(
+				faketerm.MustNew(image.Point{10, 10}),
+				SplitVertical(
+					Left(
+						ID("first"),
+						PlaceWidget(valueClosingWidget{closingWidget: first}),
+					),
+					Right(
+						ID("second"),
+						PlaceWidget(valueClosingWidget{closingWidget: second}),
+					),
+				),
+			)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+
+			if err := tc.update(cont); err != nil {
+				t.Fatalf("Update => unexpected error: %v", err)
+			}
+
+			if first.closed != tc.wantFirst {
+				t.Errorf("first widget closed %d times, want %d", first.closed, tc.wantFirst)
+			}
+			if second.closed != tc.wantSecond {
+				t.Errorf("second widget closed %d times, want %d", second.closed, tc.wantSecond)
+			}
+		})
+	}
+}
+
+// focusLog records the calls to the methods of focusingWidgets.
+type focusLog struct {
+	mu      sync.Mutex
+	entries []string
+}
+
+// add adds an entry to the log.
+func (fl *focusLog) add(entry string) {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+	fl.entries = append(fl.entries, entry)
+}
+
+// get returns all the entries in the log.
+func (fl *focusLog) get() []string {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+	return append([]string(nil), fl.entries...)
+}
+
+// focusingWidget is a fake widget that implements widgetapi.Focuser and logs
+// the calls to its methods and the keyboard events it receives.
+type focusingWidget struct {
+	*fakewidget.Mirror
+
+	name string
+	log  *focusLog
+}
+
+// newFocusingWidget returns a new focusingWidget.
+func newFocusingWidget(name string, log *focusLog) *focusingWidget {
+	return &focusingWidget{
+		Mirror: fakewidget.New(widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused}),
+		name:   name,
+		log:    log,
+	}
+}
+
+// OnFocus implements widgetapi.Focuser.OnFocus.
+func (fw *focusingWidget) OnFocus() {
+	fw.log.add(fw.name + " focus")
+}
+
+// OnBlur implements widgetapi.Focuser.OnBlur.
+func (fw *focusingWidget) OnBlur() {
+	fw.log.add(fw.name + " blur")
+}
+
+// Keyboard implements widgetapi.Widget.Keyboard.
+func (fw *focusingWidget) Keyboard(k *terminalapi.Keyboard, meta *widgetapi.EventMeta) error {
+	fw.log.add(fw.name + " key")
+	return fw.Mirror.Keyboard(k, meta)
+}
+
+func TestFocuser(t *testing.T) {
+	tests := []struct {
+		desc string
+		// focusLeft indicates if the container with ID "left" starts focused,
+		// otherwise the root container starts focused.
+		focusLeft bool
+		// events are delivered to the container.
+		events []terminalapi.Event
+		// update if not nil updates the container after the events.
+		update func(c *Container, log *focusLog) error
+		want   []string
+	}{
+		{
+			desc:      "keyboard event that moves the focus",
+			focusLeft: true,
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyTab},
+			},
+			want: []string{"left blur", "right focus", "right key"},
+		},
+		{
+			desc:      "keyboard event that doesn't move the focus",
+			focusLeft: true,
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+			},
+			want: []string{"left key"},
+		},
+		{
+			desc: "focus moves from a container without a widget",
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyTab},
+			},
+			want: []string{"left focus", "left key"},
+		},
+		{
+			desc:      "focus moves back and forth",
+			focusLeft: true,
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyTab},
+				&terminalapi.Keyboard{Key: keyboard.KeyTab},
+			},
+			want: []string{"left blur", "right focus", "right key", "right blur", "left focus", "left key"},
+		},
+		{
+			desc:      "mouse click moves the focus",
+			focusLeft: true,
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{15, 1}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{15, 1}, Button: mouse.ButtonRelease},
+			},
+			want: []string{"left blur", "right focus"},
+		},
+		{
+			desc:      "mouse click into the focused container",
+			focusLeft: true,
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonRelease},
+			},
+		},
+		{
+			desc:      "update moves the focus",
+			focusLeft: true,
+			update: func(c *Container, log *focusLog) error {
+				return c.Update("right", Focused())
+			},
+			want: []string{"left blur", "right focus"},
+		},
+		{
+			desc:      "update replaces the focused widget",
+			focusLeft: true,
+			update: func(c *Container, log *focusLog) error {
+				return c.Update("left", PlaceWidget(newFocusingWidget("new", log)))
+			},
+			want: []string{"left blur", "new focus"},
+		},
+		{
+			desc:      "update that doesn't change the focus",
+			focusLeft: true,
+			update: func(c *Container, log *focusLog) error {
+				return c.Update("right", BorderTitle("title"))
+			},
+		},
+		{
+			desc:      "focus moves to a newly placed widget",
+			focusLeft: true,
+			update: func(c *Container, log *focusLog) error {
+				w := newFocusingWidget("new", log)
+				if err := c.Update("right", PlaceWidget(w)); err != nil {
+					return err
+				}
+				log.add("moving")
+				return c.Update("right", Focused())
+			},
+			want: []string{"moving", "left blur", "new focus"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			log := &focusLog{}
+			leftOpts := []Option{
+				ID("left"),
+				PlaceWidget(newFocusingWidget("left", log)),
+			}
+			if tc.focusLeft {
+				leftOpts = append(leftOpts, Focused())
+			}
+			cont, err := New(
+				faketerm.MustNew(image.Point{20, 10}),
+				SplitVertical(
+					Left(leftOpts...),
+					Right(
+						ID("right"),
+						PlaceWidget(newFocusingWidget("right", log)),
+					),
+				),
+				KeyFocusNext(keyboard.KeyTab),
+			)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			for _, ev := range tc.events {
+				if err := cont.processEvent(ev); err != nil {
+					t.Fatalf("processEvent => unexpected error: %v", err)
+				}
+			}
+			if tc.update != nil {
+				if err := tc.update(cont, log); err != nil {
+					t.Fatalf("update => unexpected error: %v", err)
+				}
+			}
+
+			if diff := pretty.Compare(tc.want, log.get()); diff != "" {
+				t.Errorf("focus hooks => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// valueWidget is a fake widget implemented by a value type that isn't
+// comparable, since it holds a slice.
+type valueWidget struct {
+	*fakewidget.Mirror
+
+	names []string
+	log   *focusLog
+}
+
+// newValueWidget returns a new valueWidget.
+func newValueWidget(name string, log *focusLog) valueWidget {
+	return valueWidget{
+		Mirror: fakewidget.New(widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused}),
+		names:  []string{name},
+		log:    log,
+	}
+}
+
+// OnFocus implements widgetapi.Focuser.OnFocus.
+func (vw valueWidget) OnFocus() {
+	vw.log.add(vw.names[0] + " focus")
+}
+
+// OnBlur implements widgetapi.Focuser.OnBlur.
+func (vw valueWidget) OnBlur() {
+	vw.log.add(vw.names[0] + " blur")
+}
+
+func TestFocuserNonComparableWidgets(t *testing.T) {
+	tests := []struct {
+		desc string
+		// events are delivered to the container.
+		events []terminalapi.Event
+		// update if not nil updates the container after the events.
+		update func(c *Container, log *focusLog) error
+		want   []string
+	}{
+		{
+			desc: "keyboard event that moves the focus",
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyTab},
+			},
+			want: []string{"left blur", "right focus"},
+		},
+		{
+			desc: "update replaces the focused widget",
+			update: func(c *Container, log *focusLog) error {
+				return c.Update("left", PlaceWidget(newValueWidget("new", log)))
+			},
+			want: []string{"left blur", "new focus"},
+		},
+		{
+			desc: "update that doesn't change the focus",
+			update: func(c *Container, log *focusLog) error {
+				return c.Update("right", PlaceWidget(newValueWidget("new", log)))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			log := &focusLog{}
+			cont, err := New(
+				faketerm.MustNew(image.Point{20, 10}),
+				SplitVertical(
+					Left(
+						ID("left"),
+						PlaceWidget(newValueWidget("left", log)),
+						Focused(),
+					),
+					Right(
+						ID("right"),
+						PlaceWidget(newValueWidget("right", log)),
+					),
+				),
+				KeyFocusNext(keyboard.KeyTab),
+			)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			for _, ev := range tc.events {
+				if err := cont.processEvent(ev); err != nil {
+					t.Fatalf("processEvent => unexpected error: %v", err)
+				}
+			}
+			if tc.update != nil {
+				if err := tc.update(cont, log); err != nil {
+					t.Fatalf("update => unexpected error: %v", err)
+				}
+			}
+
+			if diff := pretty.Compare(tc.want, log.get()); diff != "" {
+				t.Errorf("focus hooks => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// loggingWidget is a fake widget that logs the keyboard and mouse events it
+// receives.
+type loggingWidget struct {
+	*fakewidget.Mirror
+
+	name string
+	log  *focusLog
+}
+
+// Keyboard implements widgetapi.Widget.Keyboard.
+func (lw *loggingWidget) Keyboard(k *terminalapi.Keyboard, meta *widgetapi.EventMeta) error {
+	lw.log.add(lw.name + " key")
+	return lw.Mirror.Keyboard(k, meta)
+}
+
+// Mouse implements widgetapi.Widget.Mouse.
+func (lw *loggingWidget) Mouse(m *terminalapi.Mouse, meta *widgetapi.EventMeta) error {
+	lw.log.add(lw.name + " mouse")
+	return lw.Mirror.Mouse(m, meta)
+}
+
+func TestHiddenWidgetsGetNoEvents(t *testing.T) {
+	tests := []struct {
+		desc string
+		// draw indicates if the container is drawn before the events are
+		// delivered, which moves the focus out of the hidden container.
+		draw bool
+		want []string
+	}{
+		{
+			desc: "focused hidden widget doesn't get the events",
+			want: []string{"visible key", "visible mouse"},
+		},
+		{
+			desc: "drawn hidden widget doesn't get the events",
+			draw: true,
+			want: []string{"visible key", "visible mouse"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			log := &focusLog{}
+			cont, err := New(
+				faketerm.MustNew(image.Point{20, 10}),
+				SplitVertical(
+					Left(
+						PlaceWidget(&loggingWidget{
+							Mirror: fakewidget.New(widgetapi.Options{
+								WantKeyboard: widgetapi.KeyScopeGlobal,
+								WantMouse:    widgetapi.MouseScopeGlobal,
+							}),
+							name: "visible",
+							log:  log,
+						}),
+					),
+					Right(
+						PlaceWidget(&loggingWidget{
+							Mirror: fakewidget.New(widgetapi.Options{
+								WantKeyboard:             widgetapi.KeyScopeGlobal,
+								WantMouse:                widgetapi.MouseScopeGlobal,
+								ExclusiveKeyboardOnFocus: true,
+							}),
+							name: "hidden",
+							log:  log,
+						}),
+						HideBelowSize(100, 0),
+						Focused(),
+					),
+				),
+			)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if tc.draw {
+				if err := cont.Draw(); err != nil {
+					t.Fatalf("Draw => unexpected error: %v", err)
+				}
+			}
+
+			for _, ev := range []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+				&terminalapi.Mouse{Position: image.Point{15, 1}, Button: mouse.ButtonLeft},
+			} {
+				if err := cont.processEvent(ev); err != nil {
+					t.Fatalf("processEvent => unexpected error: %v", err)
+				}
+			}
+
+			if diff := pretty.Compare(tc.want, log.get()); diff != "" {
+				t.Errorf("events => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// guardingWidget is a focusingWidget that implements widgetapi.FocusGuard.
+type guardingWidget struct {
+	*focusingWidget
+
+	canBlur bool
+}
+
+// CanBlur implements widgetapi.FocusGuard.CanBlur.
+func (gw *guardingWidget) CanBlur() bool {
+	return gw.canBlur
+}
+
+func TestFocusGuard(t *testing.T) {
+	tests := []struct {
+		desc string
+		// canBlur is returned by the guarding widget in the focused container
+		// with ID "left".
+		canBlur bool
+		// events are delivered to the container.
+		events []terminalapi.Event
+		// update if not nil updates the container after the events.
+		update func(c *Container) error
+		want   []string
+	}{
+		{
+			desc: "guard prevents moving the focus to the next container",
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyTab},
+			},
+			want: []string{"left key"},
+		},
+		{
+			desc: "guard prevents moving the focus to the previous container",
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyBacktab},
+			},
+			want: []string{"left key"},
+		},
+		{
+			desc: "guard prevents moving the focus by a mouse click",
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{15, 1}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{15, 1}, Button: mouse.ButtonRelease},
+			},
+		},
+		{
+			desc:    "guard allows moving the focus",
+			canBlur: true,
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyTab},
+			},
+			want: []string{"left blur", "right focus", "right key"},
+		},
+		{
+			desc: "guard doesn't prevent moving the focus by an update",
+			update: func(c *Container) error {
+				return c.Update("right", Focused())
+			},
+			want: []string{"left blur", "right focus"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			log := &focusLog{}
+			cont, err := New(
+				faketerm.MustNew(image.Point{20, 10}),
+				SplitVertical(
+					Left(
+						ID("left"),
+						PlaceWidget(&guardingWidget{
+							focusingWidget: newFocusingWidget("left", log),
+							canBlur:        tc.canBlur,
+						}),
+						Focused(),
+					),
+					Right(
+						ID("right"),
+						PlaceWidget(newFocusingWidget("right", log)),
+					),
+				),
+				KeyFocusNext(keyboard.KeyTab),
+				KeyFocusPrevious(keyboard.KeyBacktab),
+			)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			for _, ev := range tc.events {
+				if err := cont.processEvent(ev); err != nil {
+					t.Fatalf("processEvent => unexpected error: %v", err)
+				}
+			}
+			if tc.update != nil {
+				if err := tc.update(cont); err != nil {
+					t.Fatalf("update => unexpected error: %v", err)
+				}
+			}
+
+			if diff := pretty.Compare(tc.want, log.get()); diff != "" {
+				t.Errorf("focus log => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// cursorWidget is a fake widget that requests the position of the terminal
+// cursor when drawn.
+type cursorWidget struct {
+	*fakewidget.Mirror
+
+	// cursor is the requested position or nil to not request one.
+	cursor *image.Point
+}
+
+// Draw implements widgetapi.Widget.Draw.
+func (cw *cursorWidget) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
+	if err := cw.Mirror.Draw(cvs, meta); err != nil {
+		return err
+	}
+	if cw.cursor != nil {
+		meta.SetCursor(*cw.cursor)
+	}
+	return nil
+}
+
+func TestCursor(t *testing.T) {
+	tests := []struct {
+		desc string
+		// cursor is the position requested by the widget in the container
+		// with ID "widget" or nil if it doesn't request one.
+		cursor *image.Point
+		// opts are the options of the root container.
+		opts []Option
+		// focus is the ID of the container to focus before drawing.
+		focus      string
+		wantCursor image.Point
+		wantOk     bool
+	}{
+		{
+			desc: "widget doesn't request the cursor",
+			opts: []Option{
+				ID("widget"),
+			},
+			focus: "widget",
+		},
+		{
+			desc:   "focused widget requests the cursor",
+			cursor: &image.Point{2, 3},
+			opts: []Option{
+				ID("widget"),
+			},
+			focus:      "widget",
+			wantCursor: image.Point{2, 3},
+			wantOk:     true,
+		},
+		{
+			desc:   "position is relative to the widget's canvas",
+			cursor: &image.Point{2, 3},
+			opts: []Option{
+				SplitVertical(
+					Left(),
+					Right(
+						ID("widget"),
+						Border(linestyle.Light),
+						PaddingTop(1),
+					),
+				),
+			},
+			focus:      "widget",
+			wantCursor: image.Point{13, 5},
+			wantOk:     true,
+		},
+		{
+			desc:   "ignores request from a widget that isn't focused",
+			cursor: &image.Point{2, 3},
+			opts: []Option{
+				ID("root"),
+				SplitVertical(
+					Left(),
+					Right(
+						ID("widget"),
+					),
+				),
+			},
+			focus: "root",
+		},
+		{
+			desc:   "ignores request outside of the widget's canvas",
+			cursor: &image.Point{20, 3},
+			opts: []Option{
+				SplitVertical(
+					Left(),
+					Right(
+						ID("widget"),
+					),
+				),
+			},
+			focus: "widget",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ft := faketerm.MustNew(image.Point{20, 10})
+			c, err := New(ft, tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			w := &cursorWidget{
+				Mirror: fakewidget.New(widgetapi.Options{}),
+				cursor: tc.cursor,
+			}
+			if err := c.Update("widget", PlaceWidget(w)); err != nil {
+				t.Fatalf("Update => unexpected error: %v", err)
+			}
+
+			focus, err := findID(c, tc.focus)
+			if err != nil {
+				t.Fatalf("findID => unexpected error: %v", err)
+			}
+			c.focusTracker.setActive(focus)
+			if err := c.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			gotCursor, gotOk := c.Cursor()
+			if gotCursor != tc.wantCursor || gotOk != tc.wantOk {
+				t.Errorf("Cursor => %v, %v, want %v, %v", gotCursor, gotOk, tc.wantCursor, tc.wantOk)
+			}
+		})
+	}
+}
+
+func TestSwap(t *testing.T) {
+	tests := []struct {
+		desc      string
+		termSize  image.Point
+		container func(ft *faketerm.Terminal) (*Container, error)
+		idA       string
+		idB       string
+		// events are events delivered before the swap.
+		beforeEvents []terminalapi.Event
+		// events are events delivered after the swap.
+		afterEvents []terminalapi.Event
+		wantSwapErr bool
+		want        func(size image.Point) *faketerm.Terminal
+	}{
+		{
+			desc:     "fails when the first ID isn't found",
+			termSize: image.Point{10, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(ft, ID("myID"))
+			},
+			idA:         "missing",
+			idB:         "myID",
+			wantSwapErr: true,
+		},
+		{
+			desc:     "fails when the second ID isn't found",
+			termSize: image.Point{10, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(ft, ID("myID"))
+			},
+			idA:         "myID",
+			idB:         "missing",
+			wantSwapErr: true,
+		},
+		{
+			desc:     "fails when one container is placed under the other",
+			termSize: image.Point{10, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					ID("root"),
+					SplitVertical(
+						Left(ID("left")),
+						Right(ID("right")),
+					),
+				)
+			},
+			idA:         "left",
+			idB:         "root",
+			wantSwapErr: true,
+		},
+		{
+			desc:     "swapping a container with itself has no effect",
+			termSize: image.Point{10, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					ID("myID"),
+					Border(linestyle.Light),
+				)
+			},
+			idA: "myID",
+			idB: "myID",
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustBorder(
+					cvs,
+					image.Rect(0, 0, 10, 10),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "swaps two leaf widgets and the focus follows the widget",
+			termSize: image.Point{60, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(
+							ID("left"),
+							PlaceWidget(fakewidget.New(widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused})),
+						),
+						Right(
+							ID("right"),
+							PlaceWidget(fakewidget.New(widgetapi.Options{})),
+						),
+					),
+				)
+			},
+			beforeEvents: []terminalapi.Event{
+				// Move focus to the left container.
+				&terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonRelease},
+			},
+			idA: "left",
+			idB: "right",
+			afterEvents: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				lCvs := testcanvas.MustNew(image.Rect(0, 0, 30, 10))
+				fakewidget.MustDraw(
+					ft,
+					lCvs,
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				testcanvas.MustApply(lCvs, ft)
+
+				rCvs := testcanvas.MustNew(image.Rect(30, 0, 60, 10))
+				fakewidget.MustDraw(
+					ft,
+					rCvs,
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{WantKeyboard: widgetapi.KeyScopeFocused},
+					&fakewidget.Event{
+						Ev:   &terminalapi.Keyboard{Key: keyboard.KeyEnter},
+						Meta: &widgetapi.EventMeta{Focused: true},
+					},
+				)
+				testcanvas.MustApply(rCvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "routes mouse events to the swapped widgets",
+			termSize: image.Point{60, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(
+							ID("left"),
+							PlaceWidget(fakewidget.New(widgetapi.Options{})),
+						),
+						Right(
+							ID("right"),
+							PlaceWidget(fakewidget.New(widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget})),
+						),
+					),
+				)
+			},
+			idA: "left",
+			idB: "right",
+			afterEvents: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonRelease},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				lCvs := testcanvas.MustNew(image.Rect(0, 0, 30, 10))
+				fakewidget.MustDraw(
+					ft,
+					lCvs,
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{WantMouse: widgetapi.MouseScopeWidget},
+					&fakewidget.Event{
+						Ev:   &terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonRelease},
+						Meta: &widgetapi.EventMeta{Focused: true},
+					},
+				)
+				testcanvas.MustApply(lCvs, ft)
+
+				rCvs := testcanvas.MustNew(image.Rect(30, 0, 60, 10))
+				fakewidget.MustDraw(
+					ft,
+					rCvs,
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				testcanvas.MustApply(rCvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "swaps a subtree with a widget",
+			termSize: image.Point{60, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(
+							ID("left"),
+							SplitHorizontal(
+								Top(Border(linestyle.Light)),
+								Bottom(Border(linestyle.Light)),
+							),
+						),
+						Right(
+							ID("right"),
+							PlaceWidget(fakewidget.New(widgetapi.Options{})),
+						),
+					),
+				)
+			},
+			idA: "left",
+			idB: "right",
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				lCvs := testcanvas.MustNew(image.Rect(0, 0, 30, 10))
+				fakewidget.MustDraw(
+					ft,
+					lCvs,
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				testcanvas.MustApply(lCvs, ft)
+
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustBorder(cvs, image.Rect(30, 0, 60, 5))
+				testdraw.MustBorder(cvs, image.Rect(30, 5, 60, 10))
+				testcanvas.MustCopyTo(lCvs, cvs)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := faketerm.New(tc.termSize)
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+
+			cont, err := tc.container(got)
+			if err != nil {
+				t.Fatalf("tc.container => unexpected error: %v", err)
+			}
+
+			eds := event.NewDistributionSystem()
+			eh := &errorHandler{}
+			// Subscribe to receive errors.
+			eds.Subscribe([]terminalapi.Event{terminalapi.NewError("")}, func(ev terminalapi.Event) {
+				eh.handle(ev.(*terminalapi.Error).Error())
+			})
+			cont.Subscribe(eds)
+			// Initial draw to determine sizes of containers.
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			// Deliver the before events.
+			for _, ev := range tc.beforeEvents {
+				eds.Event(ev)
+			}
+			if err := testevent.WaitFor(5*time.Second, func() error {
+				if got, want := eds.Processed(), len(tc.beforeEvents); got != want {
+					return fmt.Errorf("the event distribution system processed %d events, want %d", got, want)
+				}
+				return nil
+			}); err != nil {
+				t.Fatalf("testevent.WaitFor => %v", err)
+			}
+
+			{
+				err := cont.Swap(tc.idA, tc.idB)
+				if (err != nil) != tc.wantSwapErr {
+					t.Errorf("Swap => unexpected error:%v, wantErr:%v", err, tc.wantSwapErr)
+				}
+				if err != nil {
+					return
+				}
+			}
+
+			// Redraw to determine the new sizes of the containers.
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			// Deliver the after events.
+			for _, ev := range tc.afterEvents {
+				eds.Event(ev)
+			}
+			wantEv := len(tc.beforeEvents) + len(tc.afterEvents)
+			if err := testevent.WaitFor(5*time.Second, func() error {
+				if got, want := eds.Processed(), wantEv; got != want {
+					return fmt.Errorf("the event distribution system processed %d events, want %d", got, want)
+				}
+				return nil
+			}); err != nil {
+				t.Fatalf("testevent.WaitFor => %v", err)
+			}
+
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			var want *faketerm.Terminal
+			if tc.want != nil {
+				want = tc.want(tc.termSize)
+			} else {
+				w, err := faketerm.New(tc.termSize)
+				if err != nil {
+					t.Fatalf("faketerm.New => unexpected error: %v", err)
+				}
+				want = w
+			}
+			if diff := faketerm.Diff(want, got); diff != "" {
+				t.Errorf("Draw => %v", diff)
+			}
+		})
+	}
+}
+
+// tickingWidget is a fake widget that implements widgetapi.Ticker.
+type tickingWidget struct {
+	*fakewidget.Mirror
+
+	// ticks counts the calls to Tick.
+	ticks int
+	// err if not nil is returned from Tick.
+	err error
+}
+
+// Tick implements widgetapi.Ticker.Tick.
+func (tw *tickingWidget) Tick() error {
+	tw.ticks++
+	return tw.err
+}
+
+func TestTick(t *testing.T) {
+	newTicking := func(err error) *tickingWidget {
+		return &tickingWidget{
+			Mirror: fakewidget.New(widgetapi.Options{}),
+			err:    err,
+		}
+	}
+
+	tests := []struct {
+		desc      string
+		widgets   []*tickingWidget
+		wantTicks []int
+		wantErr   bool
+	}{
+		{
+			desc:      "ticks all the widgets implementing Ticker",
+			widgets:   []*tickingWidget{newTicking(nil), newTicking(nil)},
+			wantTicks: []int{1, 1},
+		},
+		{
+			desc:      "returns the error and still ticks the remaining widgets",
+			widgets:   []*tickingWidget{newTicking(errors.New("tick failed")), newTicking(nil)},
+			wantTicks: []int{1, 1},
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ft, err := faketerm.New(image.Point{30, 20})
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			cont, err := New(
+				ft,
+				SplitHorizontal(
+					Top(PlaceWidget(tc.widgets[0])),
+					Bottom(
+						SplitVertical(
+							Left(PlaceWidget(tc.widgets[1])),
+							// Widgets not implementing Ticker are skipped.
+							Right(PlaceWidget(fakewidget.New(widgetapi.Options{}))),
+						),
+					),
+				),
+			)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+
+			err = cont.Tick()
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Tick => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+
+			var gotTicks []int
+			for _, w := range tc.widgets {
+				gotTicks = append(gotTicks, w.ticks)
+			}
+			if diff := pretty.Compare(tc.wantTicks, gotTicks); diff != "" {
+				t.Errorf("Tick => unexpected ticks, diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWidgetErrors(t *testing.T) {
+	tests := []struct {
+		desc string
+		// events are sent through the event distribution system, if empty
+		// the error is expected from Draw or Tick.
+		events          []terminalapi.Event
+		tick            bool
+		termSize        image.Point
+		wantContainerID string
+		wantMsg         string
+	}{
+		{
+			desc:            "keyboard error carries the container ID",
+			events:          []terminalapi.Event{&terminalapi.Keyboard{Key: keyboard.KeyEsc}},
+			termSize:        image.Point{20, 10},
+			wantContainerID: "widget",
+			wantMsg:         `container "widget": fakewidget received keyboard event`,
+		},
+		{
+			desc: "mouse error carries the container ID",
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonRight},
+			},
+			termSize:        image.Point{20, 10},
+			wantContainerID: "widget",
+			wantMsg:         `container "widget": fakewidget received mouse event`,
+		},
+		{
+			desc:            "draw error carries the container ID",
+			termSize:        image.Point{1, 1},
+			wantContainerID: "widget",
+			wantMsg:         `container "widget": `,
+		},
+		{
+			desc:            "tick error carries the container ID",
+			tick:            true,
+			termSize:        image.Point{20, 10},
+			wantContainerID: "widget",
+			wantMsg:         `container "widget": tick failed`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ft, err := faketerm.New(tc.termSize)
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			w := &tickingWidget{
+				Mirror: fakewidget.New(widgetapi.Options{
+					WantKeyboard: widgetapi.KeyScopeGlobal,
+					WantMouse:    widgetapi.MouseScopeGlobal,
+				}),
+				err: errors.New("tick failed"),
+			}
+			c, err := New(ft, ID("widget"), PlaceWidget(w))
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+
+			var got error
+			switch {
+			case tc.tick:
+				got = c.Tick()
+
+			case len(tc.events) == 0:
+				got = c.Draw()
+
+			default:
+				eds := event.NewDistributionSystem()
+				eh := &errorHandler{}
+				eds.Subscribe([]terminalapi.Event{terminalapi.NewError("")}, func(ev terminalapi.Event) {
+					eh.handle(ev.(*terminalapi.Error).Error())
+				})
+				c.Subscribe(eds)
+				if err := c.Draw(); err != nil {
+					t.Fatalf("Draw => unexpected error: %v", err)
+				}
+				for _, ev := range tc.events {
+					eds.Event(ev)
+				}
+				if err := testevent.WaitFor(5*time.Second, func() error {
+					// The error is also an event.
+					if got, want := eds.Processed(), len(tc.events)+1; got != want {
+						return fmt.Errorf("the event distribution system processed %d events, want %d", got, want)
+					}
+					return nil
+				}); err != nil {
+					t.Fatalf("testevent.WaitFor => %v", err)
+				}
+				got = eh.get()
+			}
+
+			if got == nil {
+				t.Fatalf("got no error, want one from container %q", tc.wantContainerID)
+			}
+			if !strings.Contains(got.Error(), tc.wantMsg) {
+				t.Errorf("got error %q, want it to contain %q", got, tc.wantMsg)
+			}
+			var wErr *widgetapi.Error
+			if !errors.As(got, &wErr) {
+				t.Fatalf("got error %v of type %T, want a *widgetapi.Error", got, got)
+			}
+			if wErr.ContainerID != tc.wantContainerID {
+				t.Errorf("widgetapi.Error.ContainerID => %q, want %q", wErr.ContainerID, tc.wantContainerID)
+			}
+		})
+	}
+}
+
+// dirtyWidget is a fake widget that implements widgetapi.Dirtier.
+type dirtyWidget struct {
+	*fakewidget.Mirror
+
+	// dirty is returned from Dirty.
+	dirty bool
+	// draws counts the calls to Draw.
+	draws int
+}
+
+// Draw implements widgetapi.Widget.Draw.
+func (dw *dirtyWidget) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
+	dw.draws++
+	return dw.Mirror.Draw(cvs, meta)
+}
+
+// Dirty implements widgetapi.Dirtier.Dirty.
+func (dw *dirtyWidget) Dirty() bool {
+	return dw.dirty
+}
+
+func TestDirtier(t *testing.T) {
+	tests := []struct {
+		desc string
+		// dirty is the value returned from Dirty by the two widgets.
+		dirty [2]bool
+		// between is called between the two calls to Draw.
+		between   func(cont *Container, ft *faketerm.Terminal) error
+		wantDraws []int
+	}{
+		{
+			desc:      "draws only the dirty widget again",
+			dirty:     [2]bool{false, true},
+			wantDraws: []int{1, 2},
+		},
+		{
+			desc:  "puts the content of a clean widget back onto the terminal",
+			dirty: [2]bool{false, false},
+			between: func(cont *Container, ft *faketerm.Terminal) error {
+				return ft.Clear()
+			},
+			wantDraws: []int{1, 1},
+		},
+		{
+			desc:  "draws clean widgets again when their area changes",
+			dirty: [2]bool{false, false},
+			between: func(cont *Container, ft *faketerm.Terminal) error {
+				return ft.Resize(image.Point{40, 20})
+			},
+			wantDraws: []int{2, 2},
+		},
+		{
+			desc:  "draws clean widgets again when the focus changes",
+			dirty: [2]bool{false, false},
+			between: func(cont *Container, ft *faketerm.Terminal) error {
+				cont.focusTracker.setActive(cont.first)
+				return nil
+			},
+			wantDraws: []int{2, 1},
+		},
+		{
+			desc:  "draws clean widgets again after an update",
+			dirty: [2]bool{false, false},
+			between: func(cont *Container, ft *faketerm.Terminal) error {
+				return cont.Update("right", BorderTitle("right"))
+			},
+			wantDraws: []int{2, 2},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			var widgets []*dirtyWidget
+			for _, d := range tc.dirty {
+				widgets = append(widgets, &dirtyWidget{
+					Mirror: fakewidget.New(widgetapi.Options{}),
+					dirty:  d,
+				})
+			}
+
+			ft := faketerm.MustNew(image.Point{30, 20})
+			cont, err := New(
+				ft,
+				SplitVertical(
+					Left(PlaceWidget(widgets[0])),
+					Right(
+						ID("right"),
+						Border(linestyle.Light),
+						PlaceWidget(widgets[1]),
+					),
+				),
+			)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+			if tc.between != nil {
+				if err := tc.between(cont, ft); err != nil {
+					t.Fatalf("between => unexpected error: %v", err)
+				}
+			}
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			var gotDraws []int
+			for _, w := range widgets {
+				gotDraws = append(gotDraws, w.draws)
+			}
+			if diff := pretty.Compare(tc.wantDraws, gotDraws); diff != "" {
+				t.Errorf("Draw => unexpected draws, diff (-want, +got):\n%s", diff)
+			}
+
+			// The terminal has the same content as when all the widgets
+			// are drawn again.
+			got := faketerm.MustNew(ft.Size())
+			for col := 0; col < ft.Size().X; col++ {
+				for row := 0; row < ft.Size().Y; row++ {
+					c := ft.BackBuffer()[col][row]
+					if err := got.SetCell(image.Point{col, row}, c.Rune, c.Opts); err != nil {
+						t.Fatalf("SetCell => unexpected error: %v", err)
+					}
+				}
+			}
+			cont.clearNeeded = true
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+			if diff := faketerm.Diff(ft, got); diff != "" {
+				t.Errorf("Draw => %v", diff)
+			}
+		})
+	}
+}
+
+// epochWidget is a fake widget that records the epoch it was drawn in.
+type epochWidget struct {
+	*fakewidget.Mirror
+
+	// epoch is the epoch provided on the last call to Draw.
+	epoch uint64
+}
+
+// Draw implements widgetapi.Widget.Draw.
+func (ew *epochWidget) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
+	ew.epoch = meta.Epoch
+	return ew.Mirror.Draw(cvs, meta)
+}
+
+func TestEpoch(t *testing.T) {
+	tests := []struct {
+		desc string
+		// between is called between the two calls to Draw.
+		between func(cont *Container, ft *faketerm.Terminal) error
+		// wantNew indicates if each of the two widgets is expected to be in a
+		// new epoch after the second call to Draw.
+		wantNew [2]bool
+	}{
+		{
+			desc:    "keeps the epoch when nothing changes",
+			wantNew: [2]bool{false, false},
+		},
+		{
+			desc: "keeps the epoch when the focus changes",
+			between: func(cont *Container, ft *faketerm.Terminal) error {
+				cont.focusTracker.setActive(cont.first)
+				return nil
+			},
+			wantNew: [2]bool{false, false},
+		},
+		{
+			desc: "starts a new epoch when the area changes",
+			between: func(cont *Container, ft *faketerm.Terminal) error {
+				return ft.Resize(image.Point{40, 20})
+			},
+			wantNew: [2]bool{true, true},
+		},
+		{
+			desc: "starts a new epoch after an update",
+			between: func(cont *Container, ft *faketerm.Terminal) error {
+				return cont.Update("right", BorderTitle("right"))
+			},
+			wantNew: [2]bool{true, true},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			widgets := []*epochWidget{
+				{Mirror: fakewidget.New(widgetapi.Options{})},
+				{Mirror: fakewidget.New(widgetapi.Options{})},
+			}
+
+			ft := faketerm.MustNew(image.Point{30, 20})
+			cont, err := New(
+				ft,
+				SplitVertical(
+					Left(PlaceWidget(widgets[0])),
+					Right(
+						ID("right"),
+						Border(linestyle.Light),
+						PlaceWidget(widgets[1]),
+					),
+				),
+			)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			var before []uint64
+			for _, w := range widgets {
+				if w.epoch == 0 {
+					t.Fatalf("Draw => widget drawn in the zero epoch, want a known epoch")
+				}
+				before = append(before, w.epoch)
+			}
+			if before[0] == before[1] {
+				t.Errorf("Draw => both widgets drawn in epoch %d, want distinct epochs", before[0])
+			}
+
+			if tc.between != nil {
+				if err := tc.between(cont, ft); err != nil {
+					t.Fatalf("between => unexpected error: %v", err)
+				}
+			}
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			var gotNew [2]bool
+			for i, w := range widgets {
+				gotNew[i] = w.epoch != before[i]
+			}
+			if diff := pretty.Compare(tc.wantNew, gotNew); diff != "" {
+				t.Errorf("Draw => unexpected new epochs, diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
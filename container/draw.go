@@ -17,14 +17,16 @@ package container
 // draw.go contains logic to draw containers and the contained widgets.
 
 import (
-	"errors"
 	"fmt"
 	"image"
 
 	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/linestyle"
+	"github.com/mum4k/termdash/private/alignfor"
 	"github.com/mum4k/termdash/private/area"
 	"github.com/mum4k/termdash/private/canvas"
 	"github.com/mum4k/termdash/private/draw"
+	"github.com/mum4k/termdash/terminal/terminalapi"
 	"github.com/mum4k/termdash/widgetapi"
 )
 
@@ -34,38 +36,60 @@ func drawTree(c *Container) error {
 
 	root := rootCont(c)
 	size := root.term.Size()
-	ar, err := root.opts.margin.apply(image.Rect(0, 0, size.X, size.Y))
+	root.frame = newFrameHash(size)
+	root.cursor = nil
+	root.outer = image.Rect(0, 0, size.X, size.Y)
+	ar, err := root.opts.margin.apply(root.outer)
 	if err != nil {
 		return err
 	}
 	root.area = ar
 
+	// The traversal only retains the message of the error, the error itself
+	// is kept, so that the callers can inspect it, e.g. for a
+	// widgetapi.Error.
+	var drawErr error
 	preOrder(root, &errStr, visitFunc(func(c *Container) error {
-		first, second, err := c.split()
-		if err != nil {
+		if err := drawNode(c); err != nil {
+			drawErr = err
 			return err
 		}
-		if c.first != nil {
-			ar, err := c.first.opts.margin.apply(first)
-			if err != nil {
-				return err
-			}
-			c.first.area = ar
+		return nil
+	}))
+	return drawErr
+}
+
+// drawNode determines the areas of the sub containers of the container and
+// draws it, unless it is hidden.
+func drawNode(c *Container) error {
+	if c.isHidden() {
+		// Hidden containers aren't drawn and don't receive mouse events.
+		c.area = image.ZR
+		return nil
+	}
+
+	first, second, err := c.split()
+	if err != nil {
+		return err
+	}
+	if c.first != nil {
+		ar, err := c.first.opts.margin.apply(first)
+		if err != nil {
+			return err
 		}
+		c.first.area = ar
+		c.first.outer = first
+	}
 
-		if c.second != nil {
-			ar, err := c.second.opts.margin.apply(second)
-			if err != nil {
-				return err
-			}
-			c.second.area = ar
+	if c.second != nil {
+		ar, err := c.second.opts.margin.apply(second)
+		if err != nil {
+			return err
 		}
-		return drawCont(c)
-	}))
-	if errStr != "" {
-		return errors.New(errStr)
+		c.second.area = ar
+		c.second.outer = second
 	}
-	return nil
+	return drawCont(c)
 }
 
 // drawBorder draws the border around the container if requested.
@@ -84,8 +108,15 @@ func drawBorder(c *Container) error {
 		return err
 	}
 
+	focused := c.borderFocused()
+	ls := c.borderStyle()
+	if ls == linestyle.None {
+		// The container only has a border when focused.
+		return nil
+	}
+
 	var cOpts, titleCOpts []cell.Option
-	if c.focusTracker.isActive(c) {
+	if focused {
 		cOpts = append(cOpts, cell.FgColor(c.opts.inherited.focusedColor))
 		if c.opts.inherited.titleFocusedColor != nil {
 			titleCOpts = append(titleCOpts, cell.FgColor(*c.opts.inherited.titleFocusedColor))
@@ -102,14 +133,263 @@ func drawBorder(c *Container) error {
 	}
 
 	if err := draw.Border(cvs, ar,
-		draw.BorderLineStyle(c.opts.border),
+		draw.BorderLineStyle(ls),
 		draw.BorderTitle(c.opts.borderTitle, draw.OverrunModeThreeDot, titleCOpts...),
 		draw.BorderTitleAlign(c.opts.borderTitleHAlign),
 		draw.BorderCellOpts(cOpts...),
 	); err != nil {
 		return err
 	}
-	return cvs.Apply(c.term)
+	if err := applyBackground(c, cvs); err != nil {
+		return err
+	}
+	return applyCanvas(c, cvs)
+}
+
+// drawSeparator draws the line between the sub containers if the container
+// is split and the SplitSeparator option was provided.
+func drawSeparator(c *Container) error {
+	s := c.opts.splitSeparator
+	if s == nil || c.first == nil || c.second == nil {
+		return nil
+	}
+	_, _, sep, err := c.splitSeparated()
+	if err != nil {
+		return err
+	}
+	if sep.Empty() {
+		return nil
+	}
+
+	// The line is joined to the border with T-junctions if the border uses
+	// the same line style and directly touches the end of the line.
+	vertical := c.opts.split == splitTypeVertical
+	joinStart, joinEnd := false, false
+	if c.borderStyle() == s.lineStyle {
+		usable := c.usable()
+		if vertical {
+			joinStart = sep.Min.Y == usable.Min.Y
+			joinEnd = sep.Max.Y == usable.Max.Y
+		} else {
+			joinStart = sep.Min.X == usable.Min.X
+			joinEnd = sep.Max.X == usable.Max.X
+		}
+	}
+	lineAr := sep
+	if vertical {
+		if joinStart {
+			lineAr.Min.Y--
+		}
+		if joinEnd {
+			lineAr.Max.Y++
+		}
+	} else {
+		if joinStart {
+			lineAr.Min.X--
+		}
+		if joinEnd {
+			lineAr.Max.X++
+		}
+	}
+
+	runes, err := separatorRunes(s.lineStyle, vertical, lineAr, joinStart, joinEnd)
+	if err != nil {
+		return err
+	}
+	cvs, err := canvas.New(lineAr)
+	if err != nil {
+		return err
+	}
+	for i, r := range runes {
+		p := image.Point{0, i}
+		if !vertical {
+			p = image.Point{i, 0}
+		}
+		if _, err := cvs.SetCell(p, r, s.cellOpts...); err != nil {
+			return err
+		}
+	}
+	if err := applyBackground(c, cvs); err != nil {
+		return err
+	}
+	return applyCanvas(c, cvs)
+}
+
+// separatorRunes returns the runes of the cells of the separator line that
+// occupies the provided area, starting at its top or left end. The ends are
+// T-junctions if they should be joined to the border.
+func separatorRunes(ls linestyle.LineStyle, vertical bool, lineAr image.Rectangle, joinStart, joinEnd bool) ([]rune, error) {
+	length := lineAr.Dx()
+	if vertical {
+		length = lineAr.Dy()
+	}
+	// point converts the position along and across the line to a point on a
+	// scratch canvas that has one extra cell around the line on every side.
+	point := func(along, across int) image.Point {
+		if vertical {
+			return image.Point{across, along}
+		}
+		return image.Point{along, across}
+	}
+
+	// The line extends past its ends so that they are drawn as plain line
+	// runes, unless the end is joined to the border. Joined ends are crossed
+	// by a short line representing the border.
+	start, end := 0, length+1
+	var lines []draw.HVLine
+	if joinStart {
+		start = 1
+		lines = append(lines, draw.HVLine{Start: point(1, 0), End: point(1, 2)})
+	}
+	if joinEnd {
+		end = length
+		lines = append(lines, draw.HVLine{Start: point(length, 0), End: point(length, 2)})
+	}
+	lines = append(lines, draw.HVLine{Start: point(start, 1), End: point(end, 1)})
+
+	scratch, err := canvas.New(image.Rectangle{Max: point(length+2, 3)})
+	if err != nil {
+		return nil, err
+	}
+	if err := draw.HVLines(scratch, lines, draw.HVLineStyle(ls)); err != nil {
+		return nil, err
+	}
+
+	var runes []rune
+	for i := 1; i <= length; i++ {
+		c, err := scratch.Cell(point(i, 1))
+		if err != nil {
+			return nil, err
+		}
+		runes = append(runes, c.Rune)
+	}
+	return runes, nil
+}
+
+// drawBackground fills the container with the background color if the
+// container is focused and the FocusedBackground option was provided.
+func drawBackground(c *Container) error {
+	if _, ok := focusedBackground(c); !ok {
+		return nil
+	}
+
+	cvs, err := canvas.New(c.area)
+	if err != nil {
+		return err
+	}
+	if err := applyBackground(c, cvs); err != nil {
+		return err
+	}
+	return applyCanvas(c, cvs)
+}
+
+// focusedBackground returns the background color of the container and true if
+// the container should be drawn with it.
+func focusedBackground(c *Container) (cell.Color, bool) {
+	bg := c.opts.inherited.focusedBackground
+	if bg == nil || !c.focusTracker.isActive(c) {
+		return cell.ColorDefault, false
+	}
+	return *bg, true
+}
+
+// applyBackground sets the focused background color of the container on all
+// the cells of the canvas that have the default background color.
+// Does nothing if the container shouldn't be drawn with the background color.
+func applyBackground(c *Container, cvs *canvas.Canvas) error {
+	bg, ok := focusedBackground(c)
+	if !ok {
+		return nil
+	}
+
+	ar := cvs.Area()
+	for col := ar.Min.X; col < ar.Max.X; col++ {
+		for row := ar.Min.Y; row < ar.Max.Y; row++ {
+			p := image.Point{col, row}
+			cur, err := cvs.Cell(p)
+			if err != nil {
+				return err
+			}
+			// The background is a layer under the content of the cell.
+			opts := &cell.Options{BgColor: bg}
+			opts.Merge(cur.Opts)
+			if err := cvs.SetCellOpts(p, opts); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// drawPadding fills the padding region of the container with the color
+// provided via the PaddingColor option.
+func drawPadding(c *Container) error {
+	if c.opts.paddingColor == nil {
+		return nil
+	}
+
+	usable := c.usable()
+	padded, err := c.opts.padding.apply(usable)
+	if err != nil {
+		return err
+	}
+	if padded == usable {
+		return nil
+	}
+
+	cvs, err := canvas.New(usable)
+	if err != nil {
+		return err
+	}
+	ar := cvs.Area()
+	for col := ar.Min.X; col < ar.Max.X; col++ {
+		for row := ar.Min.Y; row < ar.Max.Y; row++ {
+			if (image.Point{col, row}).Add(usable.Min).In(padded) {
+				continue
+			}
+			if _, err := cvs.SetCell(image.Point{col, row}, ' ', cell.BgColor(*c.opts.paddingColor)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := applyBackground(c, cvs); err != nil {
+		return err
+	}
+	return applyCanvas(c, cvs)
+}
+
+// drawShadow fills the region behind the container displaced by the offset
+// provided via the Shadow option with the shadow color. The shadow is clipped
+// to the area allocated to the container before its margin was applied, so it
+// never extends into the sibling containers or outside of the terminal.
+func drawShadow(c *Container) error {
+	if c.opts.shadowColor == nil {
+		return nil
+	}
+
+	shadowAr := c.area.Add(c.opts.shadowOffset).Intersect(c.outer)
+	if shadowAr.Empty() {
+		return nil
+	}
+
+	cvs, err := canvas.New(shadowAr)
+	if err != nil {
+		return err
+	}
+	ar := cvs.Area()
+	for col := ar.Min.X; col < ar.Max.X; col++ {
+		for row := ar.Min.Y; row < ar.Max.Y; row++ {
+			p := image.Point{col, row}
+			if p.Add(shadowAr.Min).In(c.area) {
+				// The container itself is drawn on top of these cells.
+				continue
+			}
+			if _, err := cvs.SetCell(p, ' ', cell.BgColor(*c.opts.shadowColor)); err != nil {
+				return err
+			}
+		}
+	}
+	return applyCanvas(c, cvs)
 }
 
 // drawWidget requests the widget to draw on the canvas.
@@ -126,15 +406,22 @@ func drawWidget(c *Container) error {
 		return nil
 	}
 
-	needSize := image.Point{1, 1}
-	wOpts := c.opts.widget.Options()
-	if wOpts.MinimumSize.X > 0 && wOpts.MinimumSize.Y > 0 {
-		needSize = wOpts.MinimumSize
+	needSize := minimumSize(c.opts.widget.Options())
+	if widgetArea.Dx() < needSize.X || widgetArea.Dy() < needSize.Y {
+		return drawUnderMinimum(c, needSize)
+	}
+	if err := drawRatioFill(c, widgetArea); err != nil {
+		return err
 	}
 
-	if widgetArea.Dx() < needSize.X || widgetArea.Dy() < needSize.Y {
-		return drawResize(c, c.usable())
+	focused := c.focusTracker.isActive(c)
+	if c.drawn.reusable(c, widgetArea, focused) {
+		if c.drawn.cursor != nil {
+			setCursor(c, *c.drawn.cursor)
+		}
+		return applyCanvas(c, c.drawn.cvs)
 	}
+	c.drawn = nil
 
 	cvs, err := canvas.New(widgetArea)
 	if err != nil {
@@ -142,13 +429,170 @@ func drawWidget(c *Container) error {
 	}
 
 	meta := &widgetapi.Meta{
-		Focused: c.focusTracker.isActive(c),
+		Focused: focused,
+		Epoch:   widgetEpoch(c, widgetArea),
 	}
 
 	if err := c.opts.widget.Draw(cvs, meta); err != nil {
+		return &widgetapi.Error{ContainerID: c.opts.id, Err: err}
+	}
+	var cursor *image.Point
+	if p, ok := meta.Cursor(); ok && meta.Focused && p.In(cvs.Area()) {
+		cp := widgetArea.Min.Add(p)
+		cursor = &cp
+		setCursor(c, cp)
+	}
+	if err := applyBackground(c, cvs); err != nil {
 		return err
 	}
-	return cvs.Apply(c.term)
+	if _, ok := c.opts.widget.(widgetapi.Dirtier); ok {
+		c.drawn = &drawnWidget{
+			area:    widgetArea,
+			focused: focused,
+			cvs:     cvs,
+			cursor:  cursor,
+		}
+	}
+	return applyCanvas(c, cvs)
+}
+
+// widgetEpoch returns the epoch of the widget's canvas, see
+// widgetapi.Meta.Epoch. A new epoch starts when the area of the canvas
+// changes and after the container tree was updated, see Container.Draw.
+func widgetEpoch(c *Container, widgetArea image.Rectangle) uint64 {
+	if c.epoch == 0 || c.epochArea != widgetArea {
+		root := rootCont(c)
+		root.lastEpoch++
+		c.epoch = root.lastEpoch
+		c.epochArea = widgetArea
+	}
+	return c.epoch
+}
+
+// drawnWidget is what a widget that implements widgetapi.Dirtier drew during
+// the last call to Draw.
+type drawnWidget struct {
+	// area is the area of the widget's canvas.
+	area image.Rectangle
+	// focused indicates if the widget's container was focused.
+	focused bool
+	// cvs is the widget's canvas with the container background applied.
+	cvs *canvas.Canvas
+	// cursor is the position of the terminal cursor requested by the
+	// widget or nil if it didn't request one.
+	cursor *image.Point
+}
+
+// reusable determines if the widget in the container can skip drawing and
+// the drawn content can be put onto the terminal instead. This is the case
+// when the widget implements widgetapi.Dirtier, isn't dirty and neither its
+// area nor the focus changed.
+func (dw *drawnWidget) reusable(c *Container, widgetArea image.Rectangle, focused bool) bool {
+	if dw == nil || dw.area != widgetArea || dw.focused != focused {
+		return false
+	}
+	d, ok := c.opts.widget.(widgetapi.Dirtier)
+	return ok && !d.Dirty()
+}
+
+// drawRatioFill fills the part of the container's padded area that isn't
+// covered by the widget's canvas with the color the widget requested via
+// the RatioFill widget option.
+func drawRatioFill(c *Container, widgetArea image.Rectangle) error {
+	wOpts := c.opts.widget.Options()
+	if wOpts.Ratio.X <= 0 || wOpts.Ratio.Y <= 0 || wOpts.RatioFill == cell.ColorDefault {
+		return nil
+	}
+
+	padded, err := c.opts.padding.apply(c.usable())
+	if err != nil {
+		return err
+	}
+	if padded == widgetArea {
+		return nil
+	}
+
+	cvs, err := canvas.New(padded)
+	if err != nil {
+		return err
+	}
+	ar := cvs.Area()
+	for col := ar.Min.X; col < ar.Max.X; col++ {
+		for row := ar.Min.Y; row < ar.Max.Y; row++ {
+			if (image.Point{col, row}).Add(padded.Min).In(widgetArea) {
+				continue
+			}
+			if _, err := cvs.SetCell(image.Point{col, row}, ' ', cell.BgColor(wOpts.RatioFill)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := applyBackground(c, cvs); err != nil {
+		return err
+	}
+	return applyCanvas(c, cvs)
+}
+
+// minimumSize returns the smallest canvas the widget can be drawn on.
+// Each axis of the widget's MinimumSize is honored independently, an axis
+// set to zero only requires a single cell.
+func minimumSize(wOpts widgetapi.Options) image.Point {
+	need := image.Point{1, 1}
+	if wOpts.MinimumSize.X > need.X {
+		need.X = wOpts.MinimumSize.X
+	}
+	if wOpts.MinimumSize.Y > need.Y {
+		need.Y = wOpts.MinimumSize.Y
+	}
+	return need
+}
+
+// drawUnderMinimum draws the resize indicator in place of a widget that
+// didn't get its minimum size.
+//
+// When the container's padded area is too small on only one of the axes, the
+// container is letterboxed. The indicator is drawn in a pane that spans the
+// whole container on the axis that is too small and has the widget's minimum
+// size on the other axis. The pane is positioned according to the
+// container's alignment options (centered by default), i.e. where the widget
+// appears once the container grows, and the rest of the container is left
+// empty.
+//
+// When both axes are too small, or when the padded area is large enough but
+// the widget's MaximumSize or Ratio shrink its canvas below the minimum, the
+// indicator covers the whole container.
+func drawUnderMinimum(c *Container, need image.Point) error {
+	padded, err := c.opts.padding.apply(c.usable())
+	if err != nil {
+		return err
+	}
+
+	shortX := padded.Dx() < need.X
+	shortY := padded.Dy() < need.Y
+	if shortX == shortY {
+		return drawResize(c, c.usable())
+	}
+
+	pane := padded
+	if shortX {
+		pane.Max.Y = pane.Min.Y + need.Y
+	} else {
+		pane.Max.X = pane.Min.X + need.X
+	}
+	aligned, err := alignfor.Rectangle(padded, pane, c.opts.hAlign, c.opts.vAlign)
+	if err != nil {
+		return err
+	}
+	return drawResize(c, aligned)
+}
+
+// setCursor records the position of the terminal cursor requested by the
+// widget and accounts for it in the hash of the frame being drawn.
+func setCursor(c *Container, p image.Point) {
+	root := rootCont(c)
+	root.cursor = &p
+	root.frame.add(uint64(p.X))
+	root.frame.add(uint64(p.Y))
 }
 
 // drawResize draws an unicode character indicating that the size is too small to draw this container.
@@ -165,21 +609,104 @@ func drawResize(c *Container, area image.Rectangle) error {
 	if err := draw.ResizeNeeded(cvs); err != nil {
 		return err
 	}
-	return cvs.Apply(c.term)
+	return applyCanvas(c, cvs)
 }
 
 // drawCont draws the container and its widget.
 func drawCont(c *Container) error {
+	if err := drawShadow(c); err != nil {
+		return fmt.Errorf("unable to draw container shadow: %v", err)
+	}
+
 	if us := c.usable(); us.Dx() <= 0 || us.Dy() <= 0 {
 		return drawResize(c, c.area)
 	}
 
+	if err := drawBackground(c); err != nil {
+		return fmt.Errorf("unable to draw container background: %v", err)
+	}
+
 	if err := drawBorder(c); err != nil {
 		return fmt.Errorf("unable to draw container border: %v", err)
 	}
 
+	if err := drawPadding(c); err != nil {
+		return fmt.Errorf("unable to draw container padding: %v", err)
+	}
+
+	if err := drawSeparator(c); err != nil {
+		return fmt.Errorf("unable to draw split separator: %v", err)
+	}
+
 	if err := drawWidget(c); err != nil {
-		return fmt.Errorf("unable to draw widget %T: %v", c.opts.widget, err)
+		return fmt.Errorf("unable to draw widget %T: %w", c.opts.widget, err)
 	}
 	return nil
 }
+
+// fnvOffset and fnvPrime are the parameters of the 64-bit FNV-1a hash.
+const (
+	fnvOffset = 14695981039346656037
+	fnvPrime  = 1099511628211
+)
+
+// frameHash is a FNV-1a hash of all the cells applied to the terminal while
+// drawing a single frame.
+type frameHash uint64
+
+// newFrameHash returns a new hash for a frame drawn on a terminal of the
+// provided size.
+func newFrameHash(size image.Point) frameHash {
+	fh := frameHash(fnvOffset)
+	fh.add(uint64(size.X))
+	fh.add(uint64(size.Y))
+	return fh
+}
+
+// add adds the value to the hash.
+func (fh *frameHash) add(v uint64) {
+	for i := 0; i < 8; i++ {
+		*fh ^= frameHash(byte(v >> (8 * i)))
+		*fh *= fnvPrime
+	}
+}
+
+// addBool adds the boolean value to the hash.
+func (fh *frameHash) addBool(b bool) {
+	if b {
+		fh.add(1)
+	} else {
+		fh.add(0)
+	}
+}
+
+// hashingTerm wraps a terminal and adds all the set cells into a frame hash.
+type hashingTerm struct {
+	terminalapi.Terminal
+	frame *frameHash
+}
+
+// SetCell implements terminalapi.Terminal.SetCell.
+func (ht *hashingTerm) SetCell(p image.Point, r rune, opts ...cell.Option) error {
+	ht.frame.add(uint64(p.X))
+	ht.frame.add(uint64(p.Y))
+	ht.frame.add(uint64(r))
+
+	o := cell.NewOptions(opts...)
+	ht.frame.add(uint64(o.FgColor))
+	ht.frame.add(uint64(o.BgColor))
+	for _, b := range []bool{o.Bold, o.Italic, o.Underline, o.Strikethrough, o.Inverse, o.Blink, o.Dim} {
+		ht.frame.addBool(b)
+	}
+	return ht.Terminal.SetCell(p, r, opts...)
+}
+
+// applyCanvas applies the canvas to the terminal and accounts for its content
+// in the hash of the frame being drawn.
+func applyCanvas(c *Container, cvs *canvas.Canvas) error {
+	root := rootCont(c)
+	return cvs.Apply(&hashingTerm{
+		Terminal: c.term,
+		frame:    &root.frame,
+	})
+}
@@ -168,6 +168,76 @@ func TestDrawWidget(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:     "right to left places the left sub-container on the right",
+			termSize: image.Point{20, 4},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					RightToLeft(),
+					SplitVertical(
+						Left(Border(linestyle.Double)),
+						Right(Border(linestyle.Light)),
+						SplitPercent(30),
+					),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustBorder(
+					cvs,
+					image.Rect(14, 0, 20, 4),
+					draw.BorderLineStyle(linestyle.Double),
+				)
+				testdraw.MustBorder(
+					cvs,
+					image.Rect(0, 0, 14, 4),
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "right to left is inherited and keeps horizontal splits",
+			termSize: image.Point{20, 8},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					RightToLeft(),
+					PaddingLeft(2),
+					SplitHorizontal(
+						Top(
+							SplitVertical(
+								Left(Border(linestyle.Double)),
+								Right(Border(linestyle.Light)),
+							),
+						),
+						Bottom(Border(linestyle.Round)),
+					),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustBorder(
+					cvs,
+					image.Rect(11, 0, 20, 4),
+					draw.BorderLineStyle(linestyle.Double),
+				)
+				testdraw.MustBorder(
+					cvs,
+					image.Rect(2, 0, 11, 4),
+				)
+				testdraw.MustBorder(
+					cvs,
+					image.Rect(2, 4, 20, 8),
+					draw.BorderLineStyle(linestyle.Round),
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
 		{
 			desc:     "draws horizontal sub-containers with margin",
 			termSize: image.Point{20, 20},
@@ -363,6 +433,215 @@ func TestDrawWidget(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:     "fills the padding with the padding color, with border",
+			termSize: image.Point{10, 6},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Border(linestyle.Light),
+					PlaceWidget(fakewidget.New(widgetapi.Options{})),
+					PaddingTop(1),
+					PaddingRight(1),
+					PaddingBottom(1),
+					PaddingLeft(2),
+					PaddingColor(cell.ColorBlue),
+					MarginLeft(1),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				// Container border.
+				testdraw.MustBorder(
+					cvs,
+					image.Rect(1, 0, 10, 6),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+
+				// Padding.
+				padAr := image.Rect(2, 1, 9, 5)
+				wAr := image.Rect(4, 2, 8, 4)
+				for col := padAr.Min.X; col < padAr.Max.X; col++ {
+					for row := padAr.Min.Y; row < padAr.Max.Y; row++ {
+						if p := (image.Point{col, row}); !p.In(wAr) {
+							testcanvas.MustSetCell(cvs, p, ' ', cell.BgColor(cell.ColorBlue))
+						}
+					}
+				}
+
+				wCvs := testcanvas.MustNew(wAr)
+				// Fake widget border.
+				fakewidget.MustDraw(ft, wCvs, &widgetapi.Meta{Focused: true}, widgetapi.Options{})
+				testcanvas.MustCopyTo(wCvs, cvs)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "fills the padding with the padding color, without border",
+			termSize: image.Point{9, 4},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					PlaceWidget(fakewidget.New(widgetapi.Options{})),
+					PaddingTop(1),
+					PaddingLeft(2),
+					PaddingColor(cell.ColorBlue),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				wAr := image.Rect(2, 1, 9, 4)
+				for col := 0; col < size.X; col++ {
+					for row := 0; row < size.Y; row++ {
+						if p := (image.Point{col, row}); !p.In(wAr) {
+							testcanvas.MustSetCell(cvs, p, ' ', cell.BgColor(cell.ColorBlue))
+						}
+					}
+				}
+
+				wCvs := testcanvas.MustNew(wAr)
+				// Fake widget border.
+				fakewidget.MustDraw(ft, wCvs, &widgetapi.Meta{Focused: true}, widgetapi.Options{})
+				testcanvas.MustCopyTo(wCvs, cvs)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "padding color has no effect without padding",
+			termSize: image.Point{9, 5},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Border(linestyle.Light),
+					PlaceWidget(fakewidget.New(widgetapi.Options{})),
+					PaddingColor(cell.ColorBlue),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				// Container border.
+				testdraw.MustBorder(
+					cvs,
+					cvs.Area(),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+
+				wCvs := testcanvas.MustNew(image.Rect(1, 1, 8, 4))
+				// Fake widget border.
+				fakewidget.MustDraw(ft, wCvs, &widgetapi.Meta{Focused: true}, widgetapi.Options{})
+				testcanvas.MustCopyTo(wCvs, cvs)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "draws a shadow behind a container with a margin",
+			termSize: image.Point{10, 6},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Border(linestyle.Light),
+					PlaceWidget(fakewidget.New(widgetapi.Options{})),
+					MarginRight(1),
+					MarginBottom(1),
+					Shadow(image.Point{1, 1}, cell.ColorBlue),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				// Shadow.
+				for row := 1; row < 6; row++ {
+					testcanvas.MustSetCell(cvs, image.Point{9, row}, ' ', cell.BgColor(cell.ColorBlue))
+				}
+				for col := 1; col < 10; col++ {
+					testcanvas.MustSetCell(cvs, image.Point{col, 5}, ' ', cell.BgColor(cell.ColorBlue))
+				}
+
+				// Container border.
+				testdraw.MustBorder(
+					cvs,
+					image.Rect(0, 0, 9, 5),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+
+				wCvs := testcanvas.MustNew(image.Rect(1, 1, 8, 4))
+				// Fake widget border.
+				fakewidget.MustDraw(ft, wCvs, &widgetapi.Meta{Focused: true}, widgetapi.Options{})
+				testcanvas.MustCopyTo(wCvs, cvs)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "shadow is clipped when the container has no margin",
+			termSize: image.Point{9, 5},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Border(linestyle.Light),
+					PlaceWidget(fakewidget.New(widgetapi.Options{})),
+					Shadow(image.Point{1, 1}, cell.ColorBlue),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				// Container border.
+				testdraw.MustBorder(
+					cvs,
+					cvs.Area(),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+
+				wCvs := testcanvas.MustNew(image.Rect(1, 1, 8, 4))
+				// Fake widget border.
+				fakewidget.MustDraw(ft, wCvs, &widgetapi.Meta{Focused: true}, widgetapi.Options{})
+				testcanvas.MustCopyTo(wCvs, cvs)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "shadow doesn't extend into the sibling container",
+			termSize: image.Point{20, 5},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					SplitVertical(
+						Left(
+							Border(linestyle.Light),
+							MarginRight(1),
+							Shadow(image.Point{2, 1}, cell.ColorBlue),
+						),
+						Right(
+							Border(linestyle.Light),
+						),
+					),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				// Shadow of the left container.
+				for row := 1; row < 5; row++ {
+					testcanvas.MustSetCell(cvs, image.Point{9, row}, ' ', cell.BgColor(cell.ColorBlue))
+				}
+
+				testdraw.MustBorder(cvs, image.Rect(0, 0, 9, 5))
+				testdraw.MustBorder(cvs, image.Rect(10, 0, 20, 5))
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
 		{
 			desc:     "draws widget with container border and title aligned on the left",
 			termSize: image.Point{9, 5},
@@ -605,14 +884,163 @@ func TestDrawWidget(t *testing.T) {
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
-				cvs := testcanvas.MustNew(ft.Area())
-				testdraw.MustText(cvs, "⇄", image.Point{0, 0})
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(cvs, "⇄", image.Point{0, 0})
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "letterboxes the resize indicator when only the width is under the minimum",
+			termSize: image.Point{10, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					PlaceWidget(fakewidget.New(widgetapi.Options{
+						MinimumSize: image.Point{20, 4},
+					})),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(cvs, "⇄", image.Point{0, 3})
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "letterboxes the resize indicator when only the height is under the minimum",
+			termSize: image.Point{10, 6},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					PlaceWidget(fakewidget.New(widgetapi.Options{
+						MinimumSize: image.Point{4, 8},
+					})),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(cvs, "⇄", image.Point{3, 0})
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "letterboxed resize indicator respects the container alignment",
+			termSize: image.Point{10, 10},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					PlaceWidget(fakewidget.New(widgetapi.Options{
+						MinimumSize: image.Point{20, 4},
+					})),
+					AlignVertical(align.VerticalBottom),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(cvs, "⇄", image.Point{0, 6})
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "honors the minimum size on a single axis",
+			termSize: image.Point{3, 3},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					PlaceWidget(fakewidget.New(widgetapi.Options{
+						MinimumSize: image.Point{0, 5},
+					})),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(cvs, "⇄", image.Point{1, 0})
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "letterboxes the resize indicator when only the width is under the minimum with ratio",
+			termSize: image.Point{15, 30},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					PlaceWidget(fakewidget.New(widgetapi.Options{
+						MinimumSize: image.Point{20, 10},
+						Ratio:       image.Point{2, 1},
+					})),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(cvs, "⇄", image.Point{0, 10})
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "resize indicator covers the container when ratio shrinks the canvas under the minimum",
+			termSize: image.Point{20, 20},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					PlaceWidget(fakewidget.New(widgetapi.Options{
+						MinimumSize: image.Point{20, 12},
+						Ratio:       image.Point{2, 1},
+					})),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(cvs, "⇄", image.Point{0, 0})
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "widget's canvas is limited to the requested maximum size",
+			termSize: image.Point{22, 22},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Border(linestyle.Light),
+					PlaceWidget(fakewidget.New(widgetapi.Options{
+						MaximumSize: image.Point{10, 10},
+					})),
+					AlignHorizontal(align.HorizontalLeft),
+					AlignVertical(align.VerticalTop),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				contCvs := testcanvas.MustNew(ft.Area())
+				// Container border.
+				testdraw.MustBorder(
+					contCvs,
+					contCvs.Area(),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+				testcanvas.MustApply(contCvs, ft)
+
+				// Fake widget.
+				cvs := testcanvas.MustNew(image.Rect(1, 1, 11, 11))
+				fakewidget.MustDraw(ft, cvs, &widgetapi.Meta{Focused: true}, widgetapi.Options{})
 				testcanvas.MustApply(cvs, ft)
 				return ft
 			},
 		},
 		{
-			desc:     "widget's canvas is limited to the requested maximum size",
+			desc:     "widget limited to the requested maximum size is centered by default",
 			termSize: image.Point{22, 22},
 			container: func(ft *faketerm.Terminal) (*Container, error) {
 				return New(
@@ -621,8 +1049,6 @@ func TestDrawWidget(t *testing.T) {
 					PlaceWidget(fakewidget.New(widgetapi.Options{
 						MaximumSize: image.Point{10, 10},
 					})),
-					AlignHorizontal(align.HorizontalLeft),
-					AlignVertical(align.VerticalTop),
 				)
 			},
 			want: func(size image.Point) *faketerm.Terminal {
@@ -637,7 +1063,7 @@ func TestDrawWidget(t *testing.T) {
 				testcanvas.MustApply(contCvs, ft)
 
 				// Fake widget.
-				cvs := testcanvas.MustNew(image.Rect(1, 1, 11, 11))
+				cvs := testcanvas.MustNew(image.Rect(6, 6, 16, 16))
 				fakewidget.MustDraw(ft, cvs, &widgetapi.Meta{Focused: true}, widgetapi.Options{})
 				testcanvas.MustApply(cvs, ft)
 				return ft
@@ -745,6 +1171,81 @@ func TestDrawWidget(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:     "fills the letterbox around a centered widget with the requested ratio",
+			termSize: image.Point{22, 22},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Border(linestyle.Light),
+					PlaceWidget(fakewidget.New(widgetapi.Options{
+						Ratio:     image.Point{1, 2},
+						RatioFill: cell.ColorBlue,
+					})),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				// Container border.
+				testdraw.MustBorder(
+					cvs,
+					cvs.Area(),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+
+				// The letterbox.
+				for _, ar := range []image.Rectangle{
+					image.Rect(1, 1, 6, 21),
+					image.Rect(16, 1, 21, 21),
+				} {
+					testdraw.MustRectangle(cvs, ar, draw.RectCellOpts(cell.BgColor(cell.ColorBlue)))
+				}
+
+				// Fake widget border.
+				wCvs := testcanvas.MustNew(image.Rect(6, 1, 16, 21))
+				fakewidget.MustDraw(
+					ft,
+					wCvs,
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{},
+				)
+
+				testcanvas.MustCopyTo(wCvs, cvs)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc:     "letterbox fill has no effect without a ratio",
+			termSize: image.Point{22, 22},
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New(
+					ft,
+					Border(linestyle.Light),
+					PlaceWidget(fakewidget.New(widgetapi.Options{
+						RatioFill: cell.ColorBlue,
+					})),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				contCvs := testcanvas.MustNew(ft.Area())
+				// Container border.
+				testdraw.MustBorder(
+					contCvs,
+					contCvs.Area(),
+					draw.BorderCellOpts(cell.FgColor(cell.ColorYellow)),
+				)
+				testcanvas.MustApply(contCvs, ft)
+
+				// Fake widget.
+				cvs := testcanvas.MustNew(image.Rect(1, 1, 21, 21))
+				fakewidget.MustDraw(ft, cvs, &widgetapi.Meta{Focused: true}, widgetapi.Options{})
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
 		{
 			desc:     "widget's canvas is limited to the requested maximum size and ratio",
 			termSize: image.Point{22, 22},
@@ -1183,3 +1684,226 @@ func TestDrawHandlesTerminalResize(t *testing.T) {
 		})
 	}
 }
+
+func TestDrawHidesBelowSize(t *testing.T) {
+	termSize := image.Point{60, 10}
+	got, err := faketerm.New(termSize)
+	if err != nil {
+		t.Errorf("faketerm.New => unexpected error: %v", err)
+	}
+
+	cont, err := New(
+		got,
+		SplitVertical(
+			Left(
+				PlaceWidget(fakewidget.New(widgetapi.Options{})),
+			),
+			Right(
+				HideBelowSize(50, 0),
+				SplitHorizontal(
+					Top(
+						PlaceWidget(fakewidget.New(widgetapi.Options{})),
+					),
+					Bottom(
+						HideBelowSize(0, 8),
+						PlaceWidget(fakewidget.New(widgetapi.Options{})),
+					),
+				),
+			),
+		),
+	)
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+
+	// The following tests aren't hermetic, they all access the same container
+	// and fake terminal in order to retain state between resizes.
+	tests := []struct {
+		desc   string
+		resize *image.Point // if not nil, the fake terminal will be resized.
+		want   func(size image.Point) *faketerm.Terminal
+	}{
+		{
+			desc: "draws all containers above the thresholds",
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(0, 0, 30, 10)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(30, 0, 60, 5)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(30, 5, 60, 10)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				return ft
+			},
+		},
+		{
+			desc:   "sibling reclaims the space of a hidden container",
+			resize: &image.Point{60, 6},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(0, 0, 30, 6)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(30, 0, 60, 6)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				return ft
+			},
+		},
+		{
+			desc:   "hides the entire subtree",
+			resize: &image.Point{40, 10},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(0, 0, 40, 10)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				return ft
+			},
+		},
+		{
+			desc:   "shows the containers again when the terminal grows",
+			resize: &image.Point{60, 10},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(0, 0, 30, 10)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(30, 0, 60, 5)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(image.Rect(30, 5, 60, 10)),
+					&widgetapi.Meta{},
+					widgetapi.Options{},
+				)
+				return ft
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.resize != nil {
+				if err := got.Resize(*tc.resize); err != nil {
+					t.Fatalf("Resize => unexpected error: %v", err)
+				}
+			}
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			if diff := faketerm.Diff(tc.want(got.Size()), got); diff != "" {
+				t.Errorf("Draw => %v", diff)
+			}
+		})
+	}
+}
+
+func TestChanged(t *testing.T) {
+	got, err := faketerm.New(image.Point{30, 10})
+	if err != nil {
+		t.Fatalf("faketerm.New => unexpected error: %v", err)
+	}
+
+	mi := fakewidget.New(widgetapi.Options{})
+	cont, err := New(
+		got,
+		Border(linestyle.Light),
+		PlaceWidget(mi),
+	)
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+
+	// The following tests aren't hermetic, they all access the same container
+	// in order to retain state between draws.
+	tests := []struct {
+		desc   string
+		update func() // if not nil, called before Draw.
+		want   bool
+	}{
+		{
+			desc: "first draw is a change",
+			want: true,
+		},
+		{
+			desc: "identical content is not a change",
+			want: false,
+		},
+		{
+			desc: "changed widget content is a change",
+			update: func() {
+				mi.Text("hello")
+			},
+			want: true,
+		},
+		{
+			desc: "identical content after a change",
+			want: false,
+		},
+		{
+			desc: "changed cell options are a change",
+			update: func() {
+				// The root container is focused.
+				cont.opts.inherited.focusedColor = cell.ColorRed
+			},
+			want: true,
+		},
+		{
+			desc: "terminal resize is a change",
+			update: func() {
+				if err := got.Resize(image.Point{31, 10}); err != nil {
+					t.Fatalf("Resize => unexpected error: %v", err)
+				}
+			},
+			want: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.update != nil {
+				tc.update()
+			}
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+			if got := cont.Changed(); got != tc.want {
+				t.Errorf("Changed => %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -18,10 +18,12 @@ package container
 
 import (
 	"image"
+	"sort"
 
 	"github.com/mum4k/termdash/mouse"
 	"github.com/mum4k/termdash/private/button"
 	"github.com/mum4k/termdash/terminal/terminalapi"
+	"github.com/mum4k/termdash/widgetapi"
 )
 
 // pointCont finds the top-most (on the screen) container whose area contains
@@ -52,19 +54,32 @@ type focusTracker struct {
 	// a mouse click and now waiting for a release or a timeout.
 	candidate *Container
 
-	// buttonFSM is a state machine tracking mouse clicks in containers and
-	// moving focus from one container to the next.
-	buttonFSM *button.FSM
+	// buttonFSMs are state machines tracking clicks of the mouse buttons
+	// configured via the FocusButtons option in containers and moving focus
+	// from one container to the next.
+	buttonFSMs map[mouse.Button]*button.FSM
+
+	// area is the area in which the mouse clicks are tracked.
+	area image.Rectangle
+
+	// placements is the number of widgets placed into the containers in the
+	// tree, used to identify each placement, see options.placement.
+	placements uint64
+
+	// trap is the container whose subtree confines the focus moved by the
+	// user, nil if the focus isn't trapped. See Container.TrapFocus.
+	trap *Container
 }
 
 // newFocusTracker returns a new focus tracker with focus set at the provided
 // container.
 func newFocusTracker(c *Container) *focusTracker {
 	return &focusTracker{
-		container: c,
-		// Mouse FSM tracking clicks inside the entire area for the root
+		container:  c,
+		buttonFSMs: map[mouse.Button]*button.FSM{},
+		// Mouse clicks are tracked inside the entire area for the root
 		// container.
-		buttonFSM: button.NewFSM(mouse.ButtonLeft, c.area),
+		area: c.area,
 	}
 }
 
@@ -83,109 +98,274 @@ func (ft *focusTracker) setActive(c *Container) {
 	ft.container = c
 }
 
+// moveTo moves the focus to the provided container in response to user input,
+// unless the widget in the currently focused container vetoes losing the
+// focus, see widgetapi.FocusGuard, or the container is outside of the subtree
+// the focus is trapped in.
+func (ft *focusTracker) moveTo(c *Container) {
+	if ft.container == c {
+		return
+	}
+	if ft.trap != nil && !isAncestor(ft.trap, c) {
+		return
+	}
+	if g, ok := ft.container.opts.widget.(widgetapi.FocusGuard); ok && !g.CanBlur() {
+		return
+	}
+	ft.setActive(c)
+}
+
+// scope returns the container whose subtree the user can move the focus
+// within, i.e. the container the focus is trapped in or the root container.
+func (ft *focusTracker) scope() *Container {
+	if ft.trap != nil {
+		return ft.trap
+	}
+	return rootCont(ft.container)
+}
+
+// trapIn traps the focus in the subtree of the provided container. If the
+// focused container is outside of the subtree, moves the focus to the first
+// container in the subtree that can receive the keyboard focus or to the
+// provided container if there is no such container.
+func (ft *focusTracker) trapIn(c *Container) {
+	ft.trap = c
+	if isAncestor(c, ft.container) {
+		return
+	}
+	for _, cont := range focusOrder(c) {
+		if focusCandidate(cont, nil) {
+			ft.setActive(cont)
+			return
+		}
+	}
+	ft.setActive(c)
+}
+
+// leaveHidden moves the focus out of the focused container if it is hidden,
+// see HideBelowSize. The focus moves to the nearest container that follows the
+// hidden one in the focus order and can receive the keyboard focus. If there
+// is no such container, the focus moves to the closest visible ancestor.
+func (ft *focusTracker) leaveHidden() {
+	if !ft.container.isHidden() {
+		return
+	}
+
+	order := focusOrder(ft.scope())
+	cur := -1
+	for i, c := range order {
+		if c == ft.container {
+			cur = i
+			break
+		}
+	}
+	for i := 1; i <= len(order); i++ {
+		if c := order[(cur+i)%len(order)]; focusCandidate(c, nil) {
+			ft.setActive(c)
+			return
+		}
+	}
+	for c := ft.container.parent; c != nil; c = c.parent {
+		if !c.isHidden() {
+			ft.setActive(c)
+			return
+		}
+	}
+}
+
+// focusCandidate determines if the container can receive the keyboard focus
+// when it is moved using the keys. If group is not nil, only containers in a
+// matching focus group are candidates. Hidden containers are never
+// candidates, see HideBelowSize.
+func focusCandidate(c *Container, group *FocusGroup) bool {
+	if !c.isLeaf() || c.isHidden() {
+		return false
+	}
+	if group == nil {
+		return !c.opts.keyFocusSkip
+	}
+	return c.inFocusGroup(*group)
+}
+
+// focusOrder returns all the containers in the tree in the order the keyboard
+// focus visits them. Containers with the FocusOrder option come first in the
+// ascending order of their values, followed by the remaining containers in the
+// tree order. A container with sub containers is placed right before the first
+// of its sub containers.
+func focusOrder(root *Container) []*Container {
+	var (
+		errStr string
+		conts  []*Container
+		// orders are the effective FocusOrder values of the containers. A
+		// container with sub containers has the smallest value in its
+		// subtree.
+		orders = map[*Container]int{}
+	)
+	postOrder(root, &errStr, visitFunc(func(c *Container) error {
+		if o := c.opts.focusOrder; o != nil {
+			orders[c] = *o
+		}
+		for _, sub := range []*Container{c.first, c.second} {
+			so, ok := orders[sub]
+			if sub == nil || !ok {
+				continue
+			}
+			if o, ok := orders[c]; !ok || so < o {
+				orders[c] = so
+			}
+		}
+		return nil
+	}))
+	preOrder(root, &errStr, visitFunc(func(c *Container) error {
+		conts = append(conts, c)
+		return nil
+	}))
+	sort.SliceStable(conts, func(i, j int) bool {
+		oi, iOK := orders[conts[i]]
+		oj, jOK := orders[conts[j]]
+		switch {
+		case !iOK:
+			return false
+		case !jOK:
+			return true
+		default:
+			return oi < oj
+		}
+	})
+	return conts
+}
+
 // next moves focus to the next container.
 // If group is not nil, focus will only move between containers with a matching
-// focus group number.
+// focus group number. If the focus is trapped, it only moves between the
+// containers in the subtree it is trapped in.
 func (ft *focusTracker) next(group *FocusGroup) {
 	var (
-		errStr    string
 		firstCont *Container
 		nextCont  *Container
 		focusNext bool
 	)
-	preOrder(rootCont(ft.container), &errStr, visitFunc(func(c *Container) error {
-		if nextCont != nil {
-			// Already found the next container, nothing to do.
-			return nil
-		}
-
-		if firstCont == nil && c.isLeaf() {
-			// Remember the first eligible container in case we "wrap" over,
-			// i.e. finish the iteration before finding the next container.
-			switch {
-			case group == nil && !c.opts.keyFocusSkip:
-				fallthrough
-			case group != nil && c.inFocusGroup(*group):
-				firstCont = c
-			}
-		}
-
+	for _, c := range focusOrder(ft.scope()) {
 		if ft.container == c {
 			// Visiting the currently focused container, going to focus the
 			// next one.
 			focusNext = true
-			return nil
+			continue
+		}
+		if !focusCandidate(c, group) {
+			continue
 		}
 
-		if focusNext && c.isLeaf() {
-			switch {
-			case group == nil && !c.opts.keyFocusSkip:
-				fallthrough
-			case group != nil && c.inFocusGroup(*group):
-				nextCont = c
-			}
+		if firstCont == nil {
+			// Remember the first eligible container in case we "wrap" over,
+			// i.e. finish the iteration before finding the next container.
+			firstCont = c
 		}
-		return nil
-	}))
+		if focusNext {
+			nextCont = c
+			break
+		}
+	}
 
 	if nextCont == nil && firstCont != nil {
 		// If the traversal finishes without finding the next container, move
 		// focus back to the first container.
-		ft.setActive(firstCont)
+		ft.moveTo(firstCont)
 	} else if nextCont != nil {
-		ft.setActive(nextCont)
+		ft.moveTo(nextCont)
 	}
 }
 
 // previous moves focus to the previous container.
 // If group is not nil, focus will only move between containers with a matching
-// focus group number.
+// focus group number. If the focus is trapped, it only moves between the
+// containers in the subtree it is trapped in.
 func (ft *focusTracker) previous(group *FocusGroup) {
 	var (
-		errStr      string
 		prevCont    *Container
 		lastCont    *Container
 		visitedCurr bool
 	)
-	preOrder(rootCont(ft.container), &errStr, visitFunc(func(c *Container) error {
+	for _, c := range focusOrder(ft.scope()) {
 		if ft.container == c {
 			visitedCurr = true
 		}
 
-		if c.isLeaf() {
-			switch {
-			case group == nil && !c.opts.keyFocusSkip:
-				fallthrough
-			case group != nil && c.inFocusGroup(*group):
-				if !visitedCurr {
-					// Remember the last eligible container closest to the one
-					// currently focused.
-					prevCont = c
-				}
-				lastCont = c
+		if focusCandidate(c, group) {
+			if !visitedCurr {
+				// Remember the last eligible container closest to the one
+				// currently focused.
+				prevCont = c
 			}
+			lastCont = c
 		}
-		return nil
-	}))
+	}
 
 	if prevCont != nil {
-		ft.setActive(prevCont)
+		ft.moveTo(prevCont)
 	} else if lastCont != nil {
-		ft.setActive(lastCont)
+		ft.moveTo(lastCont)
 	}
 }
 
 // mouse identifies mouse events that change the focused container and track
 // the focused container in the tree.
 // The argument c is the container onto which the mouse event landed.
-func (ft *focusTracker) mouse(target *Container, m *terminalapi.Mouse) {
-	clicked, bs := ft.buttonFSM.Event(m)
-	switch {
-	case bs == button.Down:
-		ft.candidate = target
-	case bs == button.Up && clicked:
-		if target == ft.candidate {
-			ft.container = target
+// Only clicks of the provided buttons change the focused container.
+func (ft *focusTracker) mouse(target *Container, m *terminalapi.Mouse, buttons []mouse.Button) {
+	for _, b := range buttons {
+		fsm, ok := ft.buttonFSMs[b]
+		if !ok {
+			fsm = button.NewFSM(b, ft.area)
+			ft.buttonFSMs[b] = fsm
+		}
+
+		clicked, bs := fsm.Event(m)
+		switch {
+		case bs == button.Down && m.Button == b:
+			ft.candidate = target
+		case bs == button.Up && clicked:
+			if target == ft.candidate {
+				ft.moveTo(target)
+			}
+		}
+	}
+}
+
+// focusedWidget identifies the widget in the focused container.
+type focusedWidget struct {
+	// placement identifies the placement of the widget, zero if the
+	// container has no widget.
+	placement uint64
+	// widget is the widget or nil if the container has no widget.
+	widget widgetapi.Widget
+}
+
+// focused returns the widget in the currently focused container.
+func (ft *focusTracker) focused() focusedWidget {
+	return focusedWidget{
+		placement: ft.container.opts.placement,
+		widget:    ft.container.opts.widget,
+	}
+}
+
+// focusHooks returns a function that notifies the widgets implementing
+// widgetapi.Focuser that the focus moved from the widget before to the widget
+// after. Either of the widgets can be nil if the focused container has no
+// widget. The returned function does nothing if the focused widget didn't
+// change. Must be called without holding the container lock.
+// The widgets are told apart by their placements, since widgets aren't
+// necessarily comparable.
+func focusHooks(before, after focusedWidget) func() {
+	if before.placement == after.placement {
+		return func() {}
+	}
+	return func() {
+		if f, ok := before.widget.(widgetapi.Focuser); ok {
+			f.OnBlur()
+		}
+		if f, ok := after.widget.(widgetapi.Focuser); ok {
+			f.OnFocus()
 		}
 	}
 }
@@ -193,21 +373,14 @@ func (ft *focusTracker) mouse(target *Container, m *terminalapi.Mouse) {
 // updateArea updates the area that the focus tracker considers active for
 // mouse clicks.
 func (ft *focusTracker) updateArea(ar image.Rectangle) {
-	ft.buttonFSM.UpdateArea(ar)
+	ft.area = ar
+	for _, fsm := range ft.buttonFSMs {
+		fsm.UpdateArea(ar)
+	}
 }
 
 // reachableFrom asserts whether the currently focused container is reachable
 // from the provided node in the tree.
 func (ft *focusTracker) reachableFrom(node *Container) bool {
-	var (
-		errStr    string
-		reachable bool
-	)
-	preOrder(node, &errStr, visitFunc(func(c *Container) error {
-		if c == ft.container {
-			reachable = true
-		}
-		return nil
-	}))
-	return reachable
+	return isReachable(node, ft.container)
 }
@@ -333,6 +333,8 @@ func TestFocusTrackerMouse(t *testing.T) {
 
 	tests := []struct {
 		desc string
+		// opts are the options of the root container.
+		opts []Option
 		// Can be either the mouse event or a time.Duration to pause for.
 		events        []*terminalapi.Mouse
 		wantFocused   contLoc
@@ -433,16 +435,147 @@ func TestFocusTrackerMouse(t *testing.T) {
 			wantFocused:   contLocA,
 			wantProcessed: 3,
 		},
+		{
+			desc: "mouse motion doesn't move focus by default",
+			events: []*terminalapi.Mouse{
+				{Position: insideB, Button: mouse.ButtonRelease},
+			},
+			wantFocused:   contLocA,
+			wantProcessed: 1,
+		},
+		{
+			desc: "FocusFollowsMouse moves focus on mouse motion",
+			opts: []Option{
+				FocusFollowsMouse(),
+			},
+			events: []*terminalapi.Mouse{
+				{Position: insideB, Button: mouse.ButtonRelease},
+			},
+			wantFocused:   contLocB,
+			wantProcessed: 1,
+		},
+		{
+			desc: "FocusFollowsMouse moves focus across panes",
+			opts: []Option{
+				FocusFollowsMouse(),
+			},
+			events: []*terminalapi.Mouse{
+				{Position: insideB, Button: mouse.ButtonRelease},
+				{Position: image.Point{4, 4}, Button: mouse.ButtonRelease},
+				{Position: insideC, Button: mouse.ButtonRelease},
+			},
+			wantFocused:   contLocC,
+			wantProcessed: 3,
+		},
+		{
+			desc: "FocusFollowsMouse ignores motion outside of the containers",
+			opts: []Option{
+				FocusFollowsMouse(),
+			},
+			events: []*terminalapi.Mouse{
+				{Position: insideB, Button: mouse.ButtonRelease},
+				{Position: image.Point{20, 20}, Button: mouse.ButtonRelease},
+			},
+			wantFocused:   contLocB,
+			wantProcessed: 2,
+		},
+		{
+			desc: "FocusFollowsMouse, click and release still moves focus",
+			opts: []Option{
+				FocusFollowsMouse(),
+			},
+			events: []*terminalapi.Mouse{
+				{Position: insideB, Button: mouse.ButtonRelease},
+				{Position: insideC, Button: mouse.ButtonLeft},
+				{Position: insideC, Button: mouse.ButtonRelease},
+			},
+			wantFocused:   contLocC,
+			wantProcessed: 3,
+		},
+		{
+			desc: "FocusFollowsMouse, release of a dragged button isn't motion",
+			opts: []Option{
+				FocusFollowsMouse(),
+			},
+			events: []*terminalapi.Mouse{
+				{Position: insideC, Button: mouse.ButtonLeft},
+				{Position: insideB, Button: mouse.ButtonRelease},
+			},
+			wantFocused:   contLocA,
+			wantProcessed: 2,
+		},
+		{
+			desc: "FocusButtons with the left button, right click doesn't move focus",
+			opts: []Option{
+				FocusButtons(mouse.ButtonLeft),
+			},
+			events: []*terminalapi.Mouse{
+				{Position: insideB, Button: mouse.ButtonRight},
+				{Position: insideB, Button: mouse.ButtonRelease},
+			},
+			wantFocused:   contLocA,
+			wantProcessed: 2,
+		},
+		{
+			desc: "FocusButtons with the right button, right click moves focus",
+			opts: []Option{
+				FocusButtons(mouse.ButtonRight),
+			},
+			events: []*terminalapi.Mouse{
+				{Position: insideB, Button: mouse.ButtonRight},
+				{Position: insideB, Button: mouse.ButtonRelease},
+			},
+			wantFocused:   contLocB,
+			wantProcessed: 2,
+		},
+		{
+			desc: "FocusButtons with the right button, left click doesn't move focus",
+			opts: []Option{
+				FocusButtons(mouse.ButtonRight),
+			},
+			events: []*terminalapi.Mouse{
+				{Position: insideB, Button: mouse.ButtonLeft},
+				{Position: insideB, Button: mouse.ButtonRelease},
+			},
+			wantFocused:   contLocA,
+			wantProcessed: 2,
+		},
+		{
+			desc: "FocusButtons with multiple buttons, each moves focus",
+			opts: []Option{
+				FocusButtons(mouse.ButtonLeft, mouse.ButtonMiddle),
+			},
+			events: []*terminalapi.Mouse{
+				{Position: insideB, Button: mouse.ButtonLeft},
+				{Position: insideB, Button: mouse.ButtonRelease},
+				{Position: insideC, Button: mouse.ButtonMiddle},
+				{Position: insideC, Button: mouse.ButtonRelease},
+			},
+			wantFocused:   contLocC,
+			wantProcessed: 4,
+		},
+		{
+			desc: "FocusButtons without buttons, clicks don't move focus",
+			opts: []Option{
+				FocusButtons(),
+			},
+			events: []*terminalapi.Mouse{
+				{Position: insideB, Button: mouse.ButtonLeft},
+				{Position: insideB, Button: mouse.ButtonRelease},
+			},
+			wantFocused:   contLocA,
+			wantProcessed: 2,
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
 			root, err := New(
 				ft,
-				SplitVertical(
+				append(tc.opts, SplitVertical(
 					Left(),
 					Right(),
-				),
+				))...,
 			)
 			if err != nil {
 				t.Fatalf("New => unexpected error: %v", err)
@@ -1446,6 +1579,158 @@ func TestFocusTrackerNextAndPrevious(t *testing.T) {
 			wantFocused:   contLocC,
 			wantProcessed: 2,
 		},
+		{
+			desc:     "keyNext visits containers in the FocusOrder",
+			contSize: contSize5,
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New( // contLocA
+					ft,
+					SplitVertical(
+						Left( // contLocB
+							SplitVertical(
+								Left(), // contLocD
+								Right( // contLocE
+									FocusOrder(2),
+								),
+							),
+						),
+						Right( // contLocC
+							FocusOrder(1),
+						),
+					),
+					KeyFocusNext(keyNext),
+				)
+			},
+			events: []*terminalapi.Keyboard{
+				{Key: keyNext}, // focuses contLocC
+				{Key: keyNext}, // focuses contLocE
+				{Key: keyNext}, // focuses contLocD
+			},
+			wantFocused:   contLocD,
+			wantProcessed: 3,
+		},
+		{
+			desc:     "keyNext wraps to the container first in the FocusOrder",
+			contSize: contSize5,
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New( // contLocA
+					ft,
+					SplitVertical(
+						Left( // contLocB
+							SplitVertical(
+								Left(), // contLocD
+								Right( // contLocE
+									FocusOrder(2),
+								),
+							),
+						),
+						Right( // contLocC
+							FocusOrder(1),
+						),
+					),
+					KeyFocusNext(keyNext),
+				)
+			},
+			events: []*terminalapi.Keyboard{
+				{Key: keyNext}, // focuses contLocC
+				{Key: keyNext}, // focuses contLocE
+				{Key: keyNext}, // focuses contLocD
+				{Key: keyNext}, // focuses contLocC
+			},
+			wantFocused:   contLocC,
+			wantProcessed: 4,
+		},
+		{
+			desc:     "keyPrevious visits containers in the reverse FocusOrder",
+			contSize: contSize5,
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New( // contLocA
+					ft,
+					SplitVertical(
+						Left( // contLocB
+							SplitVertical(
+								Left( // contLocD
+									FocusOrder(3),
+								),
+								Right( // contLocE
+									FocusOrder(1),
+								),
+							),
+						),
+						Right( // contLocC
+							FocusOrder(2),
+						),
+					),
+					KeyFocusPrevious(keyPrevious),
+				)
+			},
+			events: []*terminalapi.Keyboard{
+				{Key: keyPrevious}, // focuses contLocD
+				{Key: keyPrevious}, // focuses contLocC
+			},
+			wantFocused:   contLocC,
+			wantProcessed: 2,
+		},
+		{
+			desc:     "containers with equal FocusOrder are visited in the tree order",
+			contSize: contSize5,
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New( // contLocA
+					ft,
+					SplitVertical(
+						Left( // contLocB
+							SplitVertical(
+								Left( // contLocD
+									FocusOrder(1),
+								),
+								Right(), // contLocE
+							),
+						),
+						Right( // contLocC
+							FocusOrder(1),
+						),
+					),
+					KeyFocusNext(keyNext),
+				)
+			},
+			events: []*terminalapi.Keyboard{
+				{Key: keyNext}, // focuses contLocD
+				{Key: keyNext}, // focuses contLocC
+			},
+			wantFocused:   contLocC,
+			wantProcessed: 2,
+		},
+		{
+			desc:     "KeyFocusGroupsNext visits containers in the FocusOrder",
+			contSize: contSize5,
+			container: func(ft *faketerm.Terminal) (*Container, error) {
+				return New( // contLocA
+					ft,
+					KeyFocusGroups(1),
+					SplitVertical(
+						Left( // contLocB
+							SplitVertical(
+								Left( // contLocD
+									KeyFocusGroups(1),
+									FocusOrder(2),
+								),
+								Right( // contLocE
+									KeyFocusGroups(1),
+									FocusOrder(1),
+								),
+							),
+						),
+						Right(), // contLocC
+					),
+					KeyFocusGroupsNext('n', 1),
+				)
+			},
+			events: []*terminalapi.Keyboard{
+				{Key: 'n'}, // focuses contLocE
+			},
+			wantFocused:   contLocE,
+			wantProcessed: 1,
+		},
 	}
 
 	for _, tc := range tests {
@@ -1516,3 +1801,410 @@ func TestFocusTrackerNextAndPrevious(t *testing.T) {
 		})
 	}
 }
+
+func TestTrapFocus(t *testing.T) {
+	t.Log(contLocIntro5())
+
+	const (
+		keyNext     keyboard.Key = keyboard.KeyTab
+		keyPrevious keyboard.Key = keyboard.KeyBacktab
+	)
+
+	var (
+		insideC = image.Point{6, 6}
+		insideE = image.Point{3, 3}
+	)
+
+	tests := []struct {
+		desc string
+		// focusE when true, the container E is focused initially.
+		focusE bool
+		// trap is called after the container is created and drawn.
+		trap func(root *Container) error
+		// events are either *terminalapi.Keyboard or *terminalapi.Mouse.
+		events        []terminalapi.Event
+		wantFocused   contLoc
+		wantProcessed int
+		wantErr       bool
+	}{
+		{
+			desc: "fails on an unknown container ID",
+			trap: func(root *Container) error {
+				return root.TrapFocus("unknown")
+			},
+			wantErr: true,
+		},
+		{
+			desc: "moves the focus into the subtree",
+			trap: func(root *Container) error {
+				return root.TrapFocus("B")
+			},
+			wantFocused: contLocD,
+		},
+		{
+			desc:   "keeps the focus that is already in the subtree",
+			focusE: true,
+			trap: func(root *Container) error {
+				return root.TrapFocus("B")
+			},
+			wantFocused: contLocE,
+		},
+		{
+			desc: "next key cycles the focus within the subtree",
+			trap: func(root *Container) error {
+				return root.TrapFocus("B")
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyNext}, // focuses contLocE
+				&terminalapi.Keyboard{Key: keyNext}, // focuses contLocD
+				&terminalapi.Keyboard{Key: keyNext}, // focuses contLocE
+			},
+			wantFocused:   contLocE,
+			wantProcessed: 3,
+		},
+		{
+			desc: "previous key cycles the focus within the subtree",
+			trap: func(root *Container) error {
+				return root.TrapFocus("B")
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyPrevious}, // focuses contLocE
+				&terminalapi.Keyboard{Key: keyPrevious}, // focuses contLocD
+			},
+			wantFocused:   contLocD,
+			wantProcessed: 2,
+		},
+		{
+			desc: "clicks outside of the subtree don't move the focus",
+			trap: func(root *Container) error {
+				return root.TrapFocus("B")
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: insideC, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: insideC, Button: mouse.ButtonRelease},
+			},
+			wantFocused:   contLocD,
+			wantProcessed: 2,
+		},
+		{
+			desc: "clicks inside of the subtree move the focus",
+			trap: func(root *Container) error {
+				return root.TrapFocus("B")
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: insideE, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: insideE, Button: mouse.ButtonRelease},
+			},
+			wantFocused:   contLocE,
+			wantProcessed: 2,
+		},
+		{
+			desc: "trapping the focus again replaces the trap",
+			trap: func(root *Container) error {
+				if err := root.TrapFocus("B"); err != nil {
+					return err
+				}
+				return root.TrapFocus("D")
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyNext},
+				&terminalapi.Mouse{Position: insideE, Button: mouse.ButtonLeft},
+				&terminalapi.Mouse{Position: insideE, Button: mouse.ButtonRelease},
+			},
+			wantFocused:   contLocD,
+			wantProcessed: 3,
+		},
+		{
+			desc: "the focus moves anywhere once released",
+			trap: func(root *Container) error {
+				if err := root.TrapFocus("B"); err != nil {
+					return err
+				}
+				root.ReleaseFocusTrap()
+				return nil
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyNext}, // focuses contLocE
+				&terminalapi.Keyboard{Key: keyNext}, // focuses contLocC
+			},
+			wantFocused:   contLocC,
+			wantProcessed: 2,
+		},
+		{
+			desc: "the trap is released when the container is removed by an update",
+			trap: func(root *Container) error {
+				if err := root.TrapFocus("D"); err != nil {
+					return err
+				}
+				// Replaces the containers D and E, moving the focus to B.
+				return root.Update("B", SplitVertical(Left(), Right()))
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyNext}, // focuses contLocD
+				&terminalapi.Keyboard{Key: keyNext}, // focuses contLocE
+				&terminalapi.Keyboard{Key: keyNext}, // focuses contLocC
+			},
+			wantFocused:   contLocC,
+			wantProcessed: 3,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ft, err := faketerm.New(image.Point{10, 10})
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+
+			var eOpts []Option
+			if tc.focusE {
+				eOpts = append(eOpts, Focused())
+			}
+			root, err := New(
+				ft,
+				SplitVertical(
+					Left(
+						ID("B"),
+						SplitVertical(
+							Left(ID("D")),
+							Right(eOpts...),
+						),
+					),
+					Right(),
+				),
+				KeyFocusNext(keyNext),
+				KeyFocusPrevious(keyPrevious),
+			)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+
+			eds := event.NewDistributionSystem()
+			root.Subscribe(eds)
+			// Initial draw to determine sizes of containers.
+			if err := root.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			err = tc.trap(root)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("trap => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			// Draw again in case the update changed the layout.
+			if err := root.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			for _, ev := range tc.events {
+				eds.Event(ev)
+			}
+			if err := testevent.WaitFor(5*time.Second, func() error {
+				if got, want := eds.Processed(), tc.wantProcessed; got != want {
+					return fmt.Errorf("the event distribution system processed %d events, want %d", got, want)
+				}
+				return nil
+			}); err != nil {
+				t.Fatalf("testevent.WaitFor => %v", err)
+			}
+
+			var wantFocused *Container
+			switch wf := tc.wantFocused; wf {
+			case contLocC:
+				wantFocused = root.second
+			case contLocD:
+				wantFocused = root.first.first
+			case contLocE:
+				wantFocused = root.first.second
+			default:
+				t.Fatalf("unsupported wantFocused value => %v", wf)
+			}
+
+			if !root.focusTracker.isActive(wantFocused) {
+				t.Errorf("isActive(%v) => false, want true, status: %v:%v, %v:%v, %v:%v, %v:%v, %v:%v",
+					tc.wantFocused,
+					contLocA, root.focusTracker.isActive(root),
+					contLocB, root.focusTracker.isActive(root.first),
+					contLocC, root.focusTracker.isActive(root.second),
+					contLocD, root.focusTracker.isActive(root.first.first),
+					contLocE, root.focusTracker.isActive(root.first.second),
+				)
+			}
+		})
+	}
+}
+
+func TestFocusHiddenContainers(t *testing.T) {
+	t.Log(contLocIntro5())
+
+	const (
+		keyNext     keyboard.Key = keyboard.KeyTab
+		keyPrevious keyboard.Key = keyboard.KeyBacktab
+	)
+
+	tests := []struct {
+		desc string
+		// focused is the container that is focused initially.
+		focused contLoc
+		// hideB and hideE are the HideBelowSize options of the containers B
+		// and E, hiding them on terminals narrower than 20 cells.
+		hideB bool
+		hideE bool
+		// size is the initial size of the terminal.
+		size image.Point
+		// resize if not nil, the terminal is resized to this size and the
+		// container drawn again before the events are delivered.
+		resize *image.Point
+		// events are delivered to the container.
+		events        []terminalapi.Event
+		wantFocused   contLoc
+		wantProcessed int
+	}{
+		{
+			desc:    "next key skips a hidden container",
+			focused: contLocD,
+			hideE:   true,
+			size:    image.Point{10, 10},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyNext},
+			},
+			wantFocused:   contLocC,
+			wantProcessed: 1,
+		},
+		{
+			desc:    "previous key skips a hidden container",
+			focused: contLocC,
+			hideE:   true,
+			size:    image.Point{10, 10},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyPrevious},
+			},
+			wantFocused:   contLocD,
+			wantProcessed: 1,
+		},
+		{
+			desc:    "next key skips containers under a hidden parent",
+			focused: contLocC,
+			hideB:   true,
+			size:    image.Point{10, 10},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyNext},
+			},
+			wantFocused:   contLocC,
+			wantProcessed: 1,
+		},
+		{
+			desc:        "the focus moves out of a container that is hidden when first drawn",
+			focused:     contLocE,
+			hideE:       true,
+			size:        image.Point{10, 10},
+			wantFocused: contLocC,
+		},
+		{
+			desc:        "resize hiding the focused container moves the focus to the next container",
+			focused:     contLocE,
+			hideE:       true,
+			size:        image.Point{30, 10},
+			resize:      &image.Point{10, 10},
+			wantFocused: contLocC,
+		},
+		{
+			desc:        "resize hiding the parent of the focused container moves the focus out",
+			focused:     contLocD,
+			hideB:       true,
+			size:        image.Point{30, 10},
+			resize:      &image.Point{10, 10},
+			wantFocused: contLocC,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ft, err := faketerm.New(tc.size)
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+
+			optsFor := func(cl contLoc, hide bool) []Option {
+				var opts []Option
+				if tc.focused == cl {
+					opts = append(opts, Focused())
+				}
+				if hide {
+					opts = append(opts, HideBelowSize(20, 0))
+				}
+				return opts
+			}
+			root, err := New(
+				ft,
+				SplitVertical(
+					Left(
+						append(optsFor(contLocB, tc.hideB),
+							SplitVertical(
+								Left(optsFor(contLocD, false)...),
+								Right(optsFor(contLocE, tc.hideE)...),
+							),
+						)...,
+					),
+					Right(optsFor(contLocC, false)...),
+				),
+				KeyFocusNext(keyNext),
+				KeyFocusPrevious(keyPrevious),
+			)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+
+			eds := event.NewDistributionSystem()
+			root.Subscribe(eds)
+			if err := root.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+			if tc.resize != nil {
+				if err := ft.Resize(*tc.resize); err != nil {
+					t.Fatalf("Resize => unexpected error: %v", err)
+				}
+				if err := root.Draw(); err != nil {
+					t.Fatalf("Draw => unexpected error: %v", err)
+				}
+			}
+
+			for _, ev := range tc.events {
+				eds.Event(ev)
+			}
+			if err := testevent.WaitFor(5*time.Second, func() error {
+				if got, want := eds.Processed(), tc.wantProcessed; got != want {
+					return fmt.Errorf("the event distribution system processed %d events, want %d", got, want)
+				}
+				return nil
+			}); err != nil {
+				t.Fatalf("testevent.WaitFor => %v", err)
+			}
+
+			var wantFocused *Container
+			switch wf := tc.wantFocused; wf {
+			case contLocC:
+				wantFocused = root.second
+			case contLocD:
+				wantFocused = root.first.first
+			case contLocE:
+				wantFocused = root.first.second
+			default:
+				t.Fatalf("unsupported wantFocused value => %v", wf)
+			}
+
+			if !root.focusTracker.isActive(wantFocused) {
+				t.Errorf("isActive(%v) => false, want true, status: %v:%v, %v:%v, %v:%v, %v:%v, %v:%v",
+					tc.wantFocused,
+					contLocA, root.focusTracker.isActive(root),
+					contLocB, root.focusTracker.isActive(root.first),
+					contLocC, root.focusTracker.isActive(root.second),
+					contLocD, root.focusTracker.isActive(root.first.first),
+					contLocE, root.focusTracker.isActive(root.first.second),
+				)
+			}
+		})
+	}
+}
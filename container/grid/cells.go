@@ -0,0 +1,240 @@
+// Copyright 2019 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grid
+
+// cells.go contains code that builds layouts from widgets placed into the
+// cells of a grid with a fixed number of rows and columns.
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mum4k/termdash/container"
+	"github.com/mum4k/termdash/widgetapi"
+)
+
+// CellsBuilder builds layouts of widgets placed into the cells of a grid with
+// the specified number of equally sized rows and columns. Widgets can span
+// multiple rows and columns.
+//
+// The layout is compiled down to nested splits, so it must be possible to
+// recursively divide the grid by full horizontal or vertical cuts that don't
+// cross any of the placed widgets.
+type CellsBuilder struct {
+	rows  int
+	cols  int
+	cells []*gridCell
+}
+
+// NewCells returns a new builder of a grid with the specified number of rows
+// and columns.
+func NewCells(rows, cols int) *CellsBuilder {
+	return &CellsBuilder{
+		rows: rows,
+		cols: cols,
+	}
+}
+
+// Place places the widget into the cell at the specified zero-based row and
+// column. The widget occupies one cell unless the RowSpan or ColSpan options
+// are provided. Cells that don't contain any widget remain empty.
+// Can be called repeatedly to place multiple widgets.
+func (b *CellsBuilder) Place(w widgetapi.Widget, row, col int, opts ...CellOption) {
+	c := &gridCell{
+		widget:  w,
+		row:     row,
+		col:     col,
+		rowSpan: 1,
+		colSpan: 1,
+	}
+	for _, opt := range opts {
+		opt.set(c)
+	}
+	b.cells = append(b.cells, c)
+}
+
+// Build builds the grid layout and returns the corresponding container
+// options.
+func (b *CellsBuilder) Build() ([]container.Option, error) {
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+	return buildCells(b.cells, 0, b.rows, 0, b.cols)
+}
+
+// validate validates the grid dimensions and the placed cells.
+// Verifies that each cell fits into the grid and that no two cells overlap.
+func (b *CellsBuilder) validate() error {
+	if b.rows <= 0 || b.cols <= 0 {
+		return fmt.Errorf("invalid grid size %dx%d, the number of rows and columns must be positive", b.rows, b.cols)
+	}
+
+	occupied := map[[2]int]*gridCell{}
+	for _, c := range b.cells {
+		if c.widget == nil {
+			return fmt.Errorf("invalid cell %v, the widget cannot be nil", c)
+		}
+		if c.rowSpan < 1 || c.colSpan < 1 {
+			return fmt.Errorf("invalid cell %v, the row and column spans must be at least one", c)
+		}
+		if c.row < 0 || c.col < 0 || c.row+c.rowSpan > b.rows || c.col+c.colSpan > b.cols {
+			return fmt.Errorf("invalid cell %v, it doesn't fit into the %dx%d grid", c, b.rows, b.cols)
+		}
+
+		for r := c.row; r < c.row+c.rowSpan; r++ {
+			for col := c.col; col < c.col+c.colSpan; col++ {
+				pos := [2]int{r, col}
+				if other, ok := occupied[pos]; ok {
+					return fmt.Errorf("cell %v overlaps with cell %v", c, other)
+				}
+				occupied[pos] = c
+			}
+		}
+	}
+	return nil
+}
+
+// buildCells recursively builds the container options for the region of the
+// grid that starts at row r0 and column c0 (inclusive) and ends at row r1 and
+// column c1 (exclusive). The cells must all be within the region.
+//
+// The region is divided by the first horizontal cut that doesn't cross any
+// cell, or if there is none, by the first such vertical cut.
+func buildCells(cells []*gridCell, r0, r1, c0, c1 int) ([]container.Option, error) {
+	if len(cells) == 0 {
+		return nil, nil
+	}
+	if len(cells) == 1 {
+		if c := cells[0]; c.row == r0 && c.col == c0 && c.row+c.rowSpan == r1 && c.col+c.colSpan == c1 {
+			return append(c.cOpts, container.PlaceWidget(c.widget)), nil
+		}
+	}
+
+	for r := r0 + 1; r < r1; r++ {
+		top, bottom, ok := cut(cells, r, func(c *gridCell) (int, int) { return c.row, c.rowSpan })
+		if !ok {
+			continue
+		}
+		topOpts, err := buildCells(top, r0, r, c0, c1)
+		if err != nil {
+			return nil, err
+		}
+		bottomOpts, err := buildCells(bottom, r, r1, c0, c1)
+		if err != nil {
+			return nil, err
+		}
+		return []container.Option{
+			container.SplitHorizontal(
+				container.Top(topOpts...),
+				container.Bottom(bottomOpts...),
+				container.SplitPercent((r-r0)*100/(r1-r0)),
+			),
+		}, nil
+	}
+
+	for col := c0 + 1; col < c1; col++ {
+		left, right, ok := cut(cells, col, func(c *gridCell) (int, int) { return c.col, c.colSpan })
+		if !ok {
+			continue
+		}
+		leftOpts, err := buildCells(left, r0, r1, c0, col)
+		if err != nil {
+			return nil, err
+		}
+		rightOpts, err := buildCells(right, r0, r1, col, c1)
+		if err != nil {
+			return nil, err
+		}
+		return []container.Option{
+			container.SplitVertical(
+				container.Left(leftOpts...),
+				container.Right(rightOpts...),
+				container.SplitPercent((col-c0)*100/(c1-c0)),
+			),
+		}, nil
+	}
+	return nil, errors.New("the placed cells cannot be represented as nested splits, no horizontal or vertical cut separates them")
+}
+
+// cut divides the cells into those before and after the line at the specified
+// index. The span function returns the start and the span of the cell along
+// the cut dimension. Returns false if any of the cells crosses the line.
+func cut(cells []*gridCell, line int, span func(*gridCell) (int, int)) (before, after []*gridCell, ok bool) {
+	for _, c := range cells {
+		start, n := span(c)
+		switch {
+		case start+n <= line:
+			before = append(before, c)
+		case start >= line:
+			after = append(after, c)
+		default:
+			return nil, nil, false
+		}
+	}
+	return before, after, true
+}
+
+// gridCell is a widget placed into the grid.
+type gridCell struct {
+	widget  widgetapi.Widget
+	row     int
+	col     int
+	rowSpan int
+	colSpan int
+	cOpts   []container.Option
+}
+
+// String implements fmt.Stringer.
+func (c *gridCell) String() string {
+	return fmt.Sprintf("cell{row:%d, col:%d, rowSpan:%d, colSpan:%d}", c.row, c.col, c.rowSpan, c.colSpan)
+}
+
+// CellOption is used to provide options to Place.
+type CellOption interface {
+	// set sets the provided option.
+	set(*gridCell)
+}
+
+// cellOption implements CellOption.
+type cellOption func(*gridCell)
+
+// set implements CellOption.set.
+func (co cellOption) set(c *gridCell) {
+	co(c)
+}
+
+// RowSpan makes the widget span the specified number of rows, starting at
+// the row it was placed into. Must be at least one, which is the default.
+func RowSpan(rows int) CellOption {
+	return cellOption(func(c *gridCell) {
+		c.rowSpan = rows
+	})
+}
+
+// ColSpan makes the widget span the specified number of columns, starting at
+// the column it was placed into. Must be at least one, which is the default.
+func ColSpan(cols int) CellOption {
+	return cellOption(func(c *gridCell) {
+		c.colSpan = cols
+	})
+}
+
+// CellContainerOpts provides options that will be applied to the container
+// that directly holds the widget.
+func CellContainerOpts(cOpts ...container.Option) CellOption {
+	return cellOption(func(c *gridCell) {
+		c.cOpts = append(c.cOpts, cOpts...)
+	})
+}
@@ -0,0 +1,281 @@
+// Copyright 2019 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grid
+
+import (
+	"image"
+	"testing"
+
+	"github.com/mum4k/termdash/container"
+	"github.com/mum4k/termdash/linestyle"
+	"github.com/mum4k/termdash/private/canvas/testcanvas"
+	"github.com/mum4k/termdash/private/draw"
+	"github.com/mum4k/termdash/private/draw/testdraw"
+	"github.com/mum4k/termdash/private/faketerm"
+	"github.com/mum4k/termdash/private/fakewidget"
+	"github.com/mum4k/termdash/widgetapi"
+)
+
+func TestCellsBuilder(t *testing.T) {
+	tests := []struct {
+		desc     string
+		termSize image.Point
+		builder  *CellsBuilder
+		want     func(size image.Point) *faketerm.Terminal
+		wantErr  bool
+	}{
+		{
+			desc:     "fails on zero rows",
+			termSize: image.Point{10, 10},
+			builder:  NewCells(0, 2),
+			wantErr:  true,
+		},
+		{
+			desc:     "fails on negative columns",
+			termSize: image.Point{10, 10},
+			builder:  NewCells(2, -1),
+			wantErr:  true,
+		},
+		{
+			desc:     "fails on nil widget",
+			termSize: image.Point{10, 10},
+			builder: func() *CellsBuilder {
+				b := NewCells(2, 2)
+				b.Place(nil, 0, 0)
+				return b
+			}(),
+			wantErr: true,
+		},
+		{
+			desc:     "fails on zero row span",
+			termSize: image.Point{10, 10},
+			builder: func() *CellsBuilder {
+				b := NewCells(2, 2)
+				b.Place(mirror(), 0, 0, RowSpan(0))
+				return b
+			}(),
+			wantErr: true,
+		},
+		{
+			desc:     "fails when the cell is outside of the grid",
+			termSize: image.Point{10, 10},
+			builder: func() *CellsBuilder {
+				b := NewCells(2, 2)
+				b.Place(mirror(), 2, 0)
+				return b
+			}(),
+			wantErr: true,
+		},
+		{
+			desc:     "fails on negative position",
+			termSize: image.Point{10, 10},
+			builder: func() *CellsBuilder {
+				b := NewCells(2, 2)
+				b.Place(mirror(), 0, -1)
+				return b
+			}(),
+			wantErr: true,
+		},
+		{
+			desc:     "fails when the span doesn't fit into the grid",
+			termSize: image.Point{10, 10},
+			builder: func() *CellsBuilder {
+				b := NewCells(2, 2)
+				b.Place(mirror(), 1, 1, ColSpan(2))
+				return b
+			}(),
+			wantErr: true,
+		},
+		{
+			desc:     "fails when cells overlap",
+			termSize: image.Point{10, 10},
+			builder: func() *CellsBuilder {
+				b := NewCells(2, 2)
+				b.Place(mirror(), 0, 0, RowSpan(2))
+				b.Place(mirror(), 1, 0)
+				return b
+			}(),
+			wantErr: true,
+		},
+		{
+			desc:     "fails when the cells cannot be represented as splits",
+			termSize: image.Point{9, 9},
+			builder: func() *CellsBuilder {
+				b := NewCells(3, 3)
+				b.Place(mirror(), 0, 0, ColSpan(2))
+				b.Place(mirror(), 0, 2, RowSpan(2))
+				b.Place(mirror(), 2, 1, ColSpan(2))
+				b.Place(mirror(), 1, 0, RowSpan(2))
+				b.Place(mirror(), 1, 1)
+				return b
+			}(),
+			wantErr: true,
+		},
+		{
+			desc:     "empty grid",
+			termSize: image.Point{10, 10},
+			builder:  NewCells(2, 2),
+		},
+		{
+			desc:     "single widget spanning the whole grid",
+			termSize: image.Point{10, 10},
+			builder: func() *CellsBuilder {
+				b := NewCells(2, 2)
+				b.Place(mirror(), 0, 0, RowSpan(2), ColSpan(2))
+				return b
+			}(),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+				fakewidget.MustDraw(ft, cvs, &widgetapi.Meta{Focused: true}, widgetapi.Options{})
+				return ft
+			},
+		},
+		{
+			desc:     "2x2 grid with a cell spanning two rows",
+			termSize: image.Point{20, 10},
+			builder: func() *CellsBuilder {
+				b := NewCells(2, 2)
+				b.Place(mirror(), 0, 0, RowSpan(2))
+				b.Place(mirror(), 0, 1)
+				b.Place(mirror(), 1, 1)
+				return b
+			}(),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				left, right := mustVSplit(ft.Area(), 50)
+				top, bot := mustHSplit(right, 50)
+				fakewidget.MustDraw(ft, testcanvas.MustNew(left), &widgetapi.Meta{}, widgetapi.Options{})
+				fakewidget.MustDraw(ft, testcanvas.MustNew(top), &widgetapi.Meta{}, widgetapi.Options{})
+				fakewidget.MustDraw(ft, testcanvas.MustNew(bot), &widgetapi.Meta{}, widgetapi.Options{})
+				return ft
+			},
+		},
+		{
+			desc:     "2x2 grid with a cell spanning two columns",
+			termSize: image.Point{20, 10},
+			builder: func() *CellsBuilder {
+				b := NewCells(2, 2)
+				b.Place(mirror(), 1, 0, ColSpan(2))
+				b.Place(mirror(), 0, 0)
+				b.Place(mirror(), 0, 1)
+				return b
+			}(),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				top, bot := mustHSplit(ft.Area(), 50)
+				left, right := mustVSplit(top, 50)
+				fakewidget.MustDraw(ft, testcanvas.MustNew(left), &widgetapi.Meta{}, widgetapi.Options{})
+				fakewidget.MustDraw(ft, testcanvas.MustNew(right), &widgetapi.Meta{}, widgetapi.Options{})
+				fakewidget.MustDraw(ft, testcanvas.MustNew(bot), &widgetapi.Meta{}, widgetapi.Options{})
+				return ft
+			},
+		},
+		{
+			desc:     "leaves cells without widgets empty",
+			termSize: image.Point{20, 10},
+			builder: func() *CellsBuilder {
+				b := NewCells(2, 2)
+				b.Place(mirror(), 1, 1)
+				return b
+			}(),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				_, bot := mustHSplit(ft.Area(), 50)
+				_, right := mustVSplit(bot, 50)
+				fakewidget.MustDraw(ft, testcanvas.MustNew(right), &widgetapi.Meta{}, widgetapi.Options{})
+				return ft
+			},
+		},
+		{
+			desc:     "three rows split into thirds",
+			termSize: image.Point{10, 30},
+			builder: func() *CellsBuilder {
+				b := NewCells(3, 1)
+				b.Place(mirror(), 0, 0)
+				b.Place(mirror(), 1, 0, RowSpan(2))
+				return b
+			}(),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				top, bot := mustHSplit(ft.Area(), 33)
+				fakewidget.MustDraw(ft, testcanvas.MustNew(top), &widgetapi.Meta{}, widgetapi.Options{})
+				fakewidget.MustDraw(ft, testcanvas.MustNew(bot), &widgetapi.Meta{}, widgetapi.Options{})
+				return ft
+			},
+		},
+		{
+			desc:     "applies container options to the cell",
+			termSize: image.Point{20, 10},
+			builder: func() *CellsBuilder {
+				b := NewCells(1, 2)
+				b.Place(mirror(), 0, 0, CellContainerOpts(container.Border(linestyle.Double)))
+				b.Place(mirror(), 0, 1)
+				return b
+			}(),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				left, right := mustVSplit(ft.Area(), 50)
+				cvs := testcanvas.MustNew(left)
+				testdraw.MustBorder(cvs, cvs.Area(), draw.BorderLineStyle(linestyle.Double))
+				testcanvas.MustApply(cvs, ft)
+				fakewidget.MustDraw(ft, testcanvas.MustNew(right), &widgetapi.Meta{}, widgetapi.Options{})
+
+				inner := testcanvas.MustNew(image.Rect(left.Min.X+1, left.Min.Y+1, left.Max.X-1, left.Max.Y-1))
+				fakewidget.MustDraw(ft, inner, &widgetapi.Meta{}, widgetapi.Options{})
+				return ft
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := faketerm.New(tc.termSize)
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+
+			gridOpts, err := tc.builder.Build()
+			if (err != nil) != tc.wantErr {
+				t.Errorf("tc.builder => unexpected error: %v, wantErr:%v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			cont, err := container.New(got, gridOpts...)
+			if err != nil {
+				t.Fatalf("container.New => unexpected error: %v", err)
+			}
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			var want *faketerm.Terminal
+			if tc.want != nil {
+				want = tc.want(tc.termSize)
+			} else {
+				w, err := faketerm.New(tc.termSize)
+				if err != nil {
+					t.Fatalf("faketerm.New => unexpected error: %v", err)
+				}
+				want = w
+			}
+			if diff := faketerm.Diff(want, got); diff != "" {
+				t.Errorf("Draw => %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,401 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+// layout.go contains code that exports and restores the layout of containers.
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/mum4k/termdash/align"
+	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/linestyle"
+	"github.com/mum4k/termdash/widgetapi"
+)
+
+// LayoutSplit indicates how is the container described by a Layout split.
+type LayoutSplit string
+
+const (
+	// LayoutSplitNone indicates that the container isn't split.
+	LayoutSplitNone LayoutSplit = ""
+
+	// LayoutSplitVertical indicates that the container is split along the
+	// vertical axis, see SplitVertical.
+	LayoutSplitVertical LayoutSplit = "vertical"
+
+	// LayoutSplitHorizontal indicates that the container is split along the
+	// horizontal axis, see SplitHorizontal.
+	LayoutSplitHorizontal LayoutSplit = "horizontal"
+)
+
+// LayoutSpacing describes the margin or padding of a container.
+// For each direction, only one of the cells or the percentage is set.
+type LayoutSpacing struct {
+	TopCells      int `json:"topCells,omitempty"`
+	TopPercent    int `json:"topPercent,omitempty"`
+	RightCells    int `json:"rightCells,omitempty"`
+	RightPercent  int `json:"rightPercent,omitempty"`
+	BottomCells   int `json:"bottomCells,omitempty"`
+	BottomPercent int `json:"bottomPercent,omitempty"`
+	LeftCells     int `json:"leftCells,omitempty"`
+	LeftPercent   int `json:"leftPercent,omitempty"`
+}
+
+// LayoutSeparator describes the line drawn between the sub containers, see the
+// SplitSeparator option.
+type LayoutSeparator struct {
+	// LineStyle is the style of the line.
+	LineStyle linestyle.LineStyle `json:"lineStyle"`
+	// CellOpts are the cell options of the line, i.e. the result of applying
+	// the cell options provided to SplitSeparator.
+	CellOpts cell.Options `json:"cellOpts"`
+}
+
+// Layout is a serializable description of a container and its sub
+// containers. It can be encoded for example as JSON, so that applications can
+// persist a layout customized by their users.
+//
+// Widgets aren't part of the layout, a Layout only records that a widget is
+// placed in a container. When restoring the layout, the caller provides the
+// widgets by the IDs of the containers, see Layout.Options.
+//
+// Options that apply to the entire tree of containers, like KeyFocusNext, and
+// the focused container aren't part of the layout.
+type Layout struct {
+	// ID is the identifier of the container, see the ID option.
+	ID string `json:"id,omitempty"`
+
+	// Split indicates how is the container split. When split, First and
+	// Second describe the two sub containers, i.e. the left and right ones
+	// for a vertical split and the top and bottom ones for a horizontal
+	// split.
+	Split  LayoutSplit `json:"split,omitempty"`
+	First  *Layout     `json:"first,omitempty"`
+	Second *Layout     `json:"second,omitempty"`
+	// SplitPercent is the size of the split in percent, only used if
+	// SplitFixed is nil. Zero means DefaultSplitPercent.
+	SplitPercent int `json:"splitPercent,omitempty"`
+	// SplitFixed is the size of the split in cells or nil if the size is set
+	// in percent.
+	SplitFixed *int `json:"splitFixed,omitempty"`
+	// SplitFromEnd indicates that the size of the split applies to the
+	// second sub container, e.g. SplitPercentFromEnd.
+	SplitFromEnd bool `json:"splitFromEnd,omitempty"`
+	// SplitSeparator is the line drawn between the sub containers or nil if
+	// there is none, see the SplitSeparator option.
+	SplitSeparator *LayoutSeparator `json:"splitSeparator,omitempty"`
+	// RightToLeft indicates that vertical splits are mirrored, see the
+	// RightToLeft option.
+	RightToLeft bool `json:"rightToLeft,omitempty"`
+
+	// HasWidget indicates if a widget is placed in the container.
+	HasWidget bool `json:"hasWidget,omitempty"`
+	// AlignHorizontal and AlignVertical are the alignment of the widget.
+	AlignHorizontal align.Horizontal `json:"alignHorizontal"`
+	AlignVertical   align.Vertical   `json:"alignVertical"`
+
+	// Border is the style of the border around the container.
+	Border linestyle.LineStyle `json:"border,omitempty"`
+	// BorderOnFocus is the style of the border drawn only when the container
+	// is focused.
+	BorderOnFocus linestyle.LineStyle `json:"borderOnFocus,omitempty"`
+	// BorderTitle is the text title within the border.
+	BorderTitle string `json:"borderTitle,omitempty"`
+	// BorderTitleAlign is the alignment of the border title.
+	BorderTitleAlign align.Horizontal `json:"borderTitleAlign,omitempty"`
+	// BorderColor, FocusedColor, TitleColor and TitleFocusedColor are the
+	// colors of the border and its title, see the options with the same
+	// names. TitleColor and TitleFocusedColor are nil if not set.
+	BorderColor       cell.Color  `json:"borderColor,omitempty"`
+	FocusedColor      cell.Color  `json:"focusedColor,omitempty"`
+	TitleColor        *cell.Color `json:"titleColor,omitempty"`
+	TitleFocusedColor *cell.Color `json:"titleFocusedColor,omitempty"`
+	// FocusedBackground is the background color of the container when
+	// focused or nil if not set.
+	FocusedBackground *cell.Color `json:"focusedBackground,omitempty"`
+
+	// Margin is the space reserved on the outside of the container.
+	Margin LayoutSpacing `json:"margin"`
+	// Padding is the space reserved between the edge of the container and
+	// its content.
+	Padding LayoutSpacing `json:"padding"`
+	// PaddingColor is the background color of the padding or nil if not set.
+	PaddingColor *cell.Color `json:"paddingColor,omitempty"`
+	// ShadowOffsetX and ShadowOffsetY are the offset of the shadow drawn
+	// behind the container, see the Shadow option.
+	ShadowOffsetX int `json:"shadowOffsetX,omitempty"`
+	ShadowOffsetY int `json:"shadowOffsetY,omitempty"`
+	// ShadowColor is the color of the shadow or nil if no shadow is drawn.
+	ShadowColor *cell.Color `json:"shadowColor,omitempty"`
+
+	// HideBelowWidth and HideBelowHeight are the terminal size below which
+	// the container is hidden, see the HideBelowSize option.
+	HideBelowWidth  int `json:"hideBelowWidth,omitempty"`
+	HideBelowHeight int `json:"hideBelowHeight,omitempty"`
+
+	// KeyFocusSkip indicates that the container is skipped when moving the
+	// focus using keyboard.
+	KeyFocusSkip bool `json:"keyFocusSkip,omitempty"`
+	// KeyFocusGroups are the focus groups the container belongs to.
+	KeyFocusGroups []FocusGroup `json:"keyFocusGroups,omitempty"`
+	// FocusOrder is the position of the container when the keyboard focus
+	// is moved or nil if not set, see the FocusOrder option.
+	FocusOrder *int `json:"focusOrder,omitempty"`
+}
+
+// Layout returns the description of the layout of this container and all of
+// its sub containers. The containers are traversed in a stable order, the
+// first sub container before the second one.
+func (c *Container) Layout() *Layout {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return layoutOf(c)
+}
+
+// layoutOf returns the layout of the container and its sub containers.
+// Caller must hold c.mu.
+func layoutOf(c *Container) *Layout {
+	o := c.opts
+	l := &Layout{
+		ID:                o.id,
+		HasWidget:         o.widget != nil,
+		AlignHorizontal:   o.hAlign,
+		AlignVertical:     o.vAlign,
+		Border:            o.border,
+		BorderOnFocus:     o.focusedBorder,
+		BorderTitle:       o.borderTitle,
+		BorderTitleAlign:  o.borderTitleHAlign,
+		BorderColor:       o.inherited.borderColor,
+		FocusedColor:      o.inherited.focusedColor,
+		TitleColor:        o.inherited.titleColor,
+		TitleFocusedColor: o.inherited.titleFocusedColor,
+		FocusedBackground: o.inherited.focusedBackground,
+		RightToLeft:       o.inherited.rightToLeft,
+		Margin: LayoutSpacing{
+			TopCells:      o.margin.topCells,
+			TopPercent:    o.margin.topPerc,
+			RightCells:    o.margin.rightCells,
+			RightPercent:  o.margin.rightPerc,
+			BottomCells:   o.margin.bottomCells,
+			BottomPercent: o.margin.bottomPerc,
+			LeftCells:     o.margin.leftCells,
+			LeftPercent:   o.margin.leftPerc,
+		},
+		Padding: LayoutSpacing{
+			TopCells:      o.padding.topCells,
+			TopPercent:    o.padding.topPerc,
+			RightCells:    o.padding.rightCells,
+			RightPercent:  o.padding.rightPerc,
+			BottomCells:   o.padding.bottomCells,
+			BottomPercent: o.padding.bottomPerc,
+			LeftCells:     o.padding.leftCells,
+			LeftPercent:   o.padding.leftPerc,
+		},
+		PaddingColor:    o.paddingColor,
+		ShadowOffsetX:   o.shadowOffset.X,
+		ShadowOffsetY:   o.shadowOffset.Y,
+		ShadowColor:     o.shadowColor,
+		HideBelowWidth:  o.hideBelow.X,
+		HideBelowHeight: o.hideBelow.Y,
+		KeyFocusSkip:    o.keyFocusSkip,
+		KeyFocusGroups:  append([]FocusGroup(nil), o.keyFocusGroups...),
+	}
+	if o.focusOrder != nil {
+		order := *o.focusOrder
+		l.FocusOrder = &order
+	}
+
+	if c.first != nil && c.second != nil {
+		if o.split == splitTypeVertical {
+			l.Split = LayoutSplitVertical
+		} else {
+			l.Split = LayoutSplitHorizontal
+		}
+		l.SplitPercent = o.splitPercent
+		if o.splitFixed > DefaultSplitFixed {
+			fixed := o.splitFixed
+			l.SplitFixed = &fixed
+		}
+		l.SplitFromEnd = o.splitReversed
+		if sep := o.splitSeparator; sep != nil {
+			l.SplitSeparator = &LayoutSeparator{
+				LineStyle: sep.lineStyle,
+				CellOpts:  *cell.NewOptions(sep.cellOpts...),
+			}
+		}
+		l.First = layoutOf(c.first)
+		l.Second = layoutOf(c.second)
+	}
+	return l
+}
+
+// Options returns options that restore the described layout when provided to
+// New or to Container.Update.
+//
+// The widgets map container IDs to the widgets placed in them. Every
+// container that had a widget must have an ID and a widget must be provided
+// for it.
+func (l *Layout) Options(widgets map[string]widgetapi.Widget) ([]Option, error) {
+	var opts []Option
+	if l.ID != "" {
+		opts = append(opts, ID(l.ID))
+	}
+
+	opts = append(opts,
+		AlignHorizontal(l.AlignHorizontal),
+		AlignVertical(l.AlignVertical),
+		BorderColor(l.BorderColor),
+		FocusedColor(l.FocusedColor),
+	)
+	if l.TitleColor != nil {
+		opts = append(opts, TitleColor(*l.TitleColor))
+	}
+	if l.TitleFocusedColor != nil {
+		opts = append(opts, TitleFocusedColor(*l.TitleFocusedColor))
+	}
+	if l.FocusedBackground != nil {
+		opts = append(opts, FocusedBackground(*l.FocusedBackground))
+	}
+	if l.RightToLeft {
+		opts = append(opts, RightToLeft())
+	}
+	if l.Border != linestyle.None {
+		opts = append(opts, Border(l.Border))
+	}
+	if l.BorderOnFocus != linestyle.None {
+		opts = append(opts, BorderOnFocus(l.BorderOnFocus))
+	}
+	if l.BorderTitle != "" {
+		opts = append(opts, BorderTitle(l.BorderTitle))
+	}
+	switch l.BorderTitleAlign {
+	case align.HorizontalLeft:
+		opts = append(opts, BorderTitleAlignLeft())
+	case align.HorizontalCenter:
+		opts = append(opts, BorderTitleAlignCenter())
+	case align.HorizontalRight:
+		opts = append(opts, BorderTitleAlignRight())
+	default:
+		return nil, fmt.Errorf("invalid border title alignment %v in the layout of container %q", l.BorderTitleAlign, l.ID)
+	}
+	opts = append(opts, l.Margin.options(
+		MarginTop, MarginRight, MarginBottom, MarginLeft,
+		MarginTopPercent, MarginRightPercent, MarginBottomPercent, MarginLeftPercent,
+	)...)
+	opts = append(opts, l.Padding.options(
+		PaddingTop, PaddingRight, PaddingBottom, PaddingLeft,
+		PaddingTopPercent, PaddingRightPercent, PaddingBottomPercent, PaddingLeftPercent,
+	)...)
+	if l.PaddingColor != nil {
+		opts = append(opts, PaddingColor(*l.PaddingColor))
+	}
+	if l.ShadowColor != nil {
+		opts = append(opts, Shadow(image.Point{l.ShadowOffsetX, l.ShadowOffsetY}, *l.ShadowColor))
+	}
+	if l.HideBelowWidth != 0 || l.HideBelowHeight != 0 {
+		opts = append(opts, HideBelowSize(l.HideBelowWidth, l.HideBelowHeight))
+	}
+	if l.KeyFocusSkip {
+		opts = append(opts, KeyFocusSkip())
+	}
+	if len(l.KeyFocusGroups) > 0 {
+		opts = append(opts, KeyFocusGroups(l.KeyFocusGroups...))
+	}
+	if l.FocusOrder != nil {
+		opts = append(opts, FocusOrder(*l.FocusOrder))
+	}
+
+	switch l.Split {
+	case LayoutSplitNone:
+		if !l.HasWidget {
+			return append(opts, Clear()), nil
+		}
+		w, ok := widgets[l.ID]
+		if !ok || w == nil {
+			return nil, fmt.Errorf("no widget provided for container %q", l.ID)
+		}
+		return append(opts, PlaceWidget(w)), nil
+
+	case LayoutSplitVertical, LayoutSplitHorizontal:
+		if l.First == nil || l.Second == nil {
+			return nil, fmt.Errorf("the layout of split container %q must describe both sub containers", l.ID)
+		}
+		first, err := l.First.Options(widgets)
+		if err != nil {
+			return nil, err
+		}
+		second, err := l.Second.Options(widgets)
+		if err != nil {
+			return nil, err
+		}
+
+		splitOpts := []SplitOption{l.splitOption()}
+		if sep := l.SplitSeparator; sep != nil {
+			cellOpts := sep.CellOpts
+			splitOpts = append(splitOpts, SplitSeparator(sep.LineStyle, &cellOpts))
+		}
+		if l.Split == LayoutSplitVertical {
+			return append(opts, SplitVertical(Left(first...), Right(second...), splitOpts...)), nil
+		}
+		return append(opts, SplitHorizontal(Top(first...), Bottom(second...), splitOpts...)), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported split %q in the layout of container %q", l.Split, l.ID)
+	}
+}
+
+// splitOption returns the option that sets the size of the split.
+func (l *Layout) splitOption() SplitOption {
+	perc := l.SplitPercent
+	if perc == 0 {
+		perc = DefaultSplitPercent
+	}
+	switch {
+	case l.SplitFixed != nil && l.SplitFromEnd:
+		return SplitFixedFromEnd(*l.SplitFixed)
+	case l.SplitFixed != nil:
+		return SplitFixed(*l.SplitFixed)
+	case l.SplitFromEnd:
+		return SplitPercentFromEnd(perc)
+	default:
+		return SplitPercent(perc)
+	}
+}
+
+// options returns options that set the spacing using the provided functions,
+// which create the options for cells and percentages in each direction.
+func (ls LayoutSpacing) options(top, right, bottom, left, topPerc, rightPerc, bottomPerc, leftPerc func(int) Option) []Option {
+	var opts []Option
+	for _, s := range []struct {
+		value int
+		fn    func(int) Option
+	}{
+		{ls.TopCells, top},
+		{ls.RightCells, right},
+		{ls.BottomCells, bottom},
+		{ls.LeftCells, left},
+		{ls.TopPercent, topPerc},
+		{ls.RightPercent, rightPerc},
+		{ls.BottomPercent, bottomPerc},
+		{ls.LeftPercent, leftPerc},
+	} {
+		if s.value != 0 {
+			opts = append(opts, s.fn(s.value))
+		}
+	}
+	return opts
+}
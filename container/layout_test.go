@@ -0,0 +1,397 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"encoding/json"
+	"image"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/mum4k/termdash/align"
+	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/linestyle"
+	"github.com/mum4k/termdash/private/faketerm"
+	"github.com/mum4k/termdash/private/fakewidget"
+	"github.com/mum4k/termdash/widgetapi"
+)
+
+// focusOrderIDs returns the IDs of the containers in the order the keyboard
+// focus visits them.
+func focusOrderIDs(c *Container) []string {
+	var ids []string
+	for _, cont := range focusOrder(c) {
+		ids = append(ids, cont.opts.id)
+	}
+	return ids
+}
+
+func TestLayoutRoundTrip(t *testing.T) {
+	widgets := map[string]widgetapi.Widget{
+		"left":        fakewidget.New(widgetapi.Options{}),
+		"topRight":    fakewidget.New(widgetapi.Options{}),
+		"bottomRight": fakewidget.New(widgetapi.Options{}),
+		"fixed":       fakewidget.New(widgetapi.Options{}),
+	}
+
+	tests := []struct {
+		desc string
+		opts []Option
+	}{
+		{
+			desc: "empty container",
+		},
+		{
+			desc: "single widget with options",
+			opts: []Option{
+				ID("left"),
+				PlaceWidget(widgets["left"]),
+				Border(linestyle.Double),
+				BorderTitle("title"),
+				BorderTitleAlignRight(),
+				BorderColor(cell.ColorRed),
+				FocusedColor(cell.ColorBlue),
+				TitleColor(cell.ColorGreen),
+				AlignHorizontal(align.HorizontalLeft),
+				AlignVertical(align.VerticalBottom),
+				MarginTop(1),
+				MarginLeftPercent(10),
+				PaddingRight(2),
+				PaddingBottomPercent(20),
+				PaddingColor(cell.ColorGreen),
+				HideBelowSize(40, 0),
+				KeyFocusSkip(),
+				KeyFocusGroups(1, 2),
+			},
+		},
+		{
+			desc: "focus indicators",
+			opts: []Option{
+				ID("left"),
+				PlaceWidget(widgets["left"]),
+				BorderOnFocus(linestyle.Round),
+				FocusedBackground(cell.ColorBlue),
+			},
+		},
+		{
+			desc: "focus order",
+			opts: []Option{
+				SplitVertical(
+					Left(
+						ID("left"),
+						PlaceWidget(widgets["left"]),
+						FocusOrder(2),
+					),
+					Right(
+						ID("topRight"),
+						PlaceWidget(widgets["topRight"]),
+						FocusOrder(0),
+					),
+				),
+			},
+		},
+		{
+			desc: "shadow",
+			opts: []Option{
+				ID("left"),
+				PlaceWidget(widgets["left"]),
+				Border(linestyle.Light),
+				MarginRight(2),
+				MarginBottom(1),
+				Shadow(image.Point{2, 1}, cell.ColorBlue),
+			},
+		},
+		{
+			desc: "multi-level layout",
+			opts: []Option{
+				ID("root"),
+				Border(linestyle.Light),
+				SplitVertical(
+					Left(
+						ID("left"),
+						PlaceWidget(widgets["left"]),
+					),
+					Right(
+						SplitHorizontal(
+							Top(
+								SplitHorizontal(
+									Top(
+										ID("topRight"),
+										PlaceWidget(widgets["topRight"]),
+										Border(linestyle.Round),
+									),
+									Bottom(
+										ID("fixed"),
+										PlaceWidget(widgets["fixed"]),
+									),
+									SplitFixedFromEnd(3),
+								),
+							),
+							Bottom(
+								ID("bottomRight"),
+								PlaceWidget(widgets["bottomRight"]),
+								PaddingTop(1),
+							),
+							SplitPercentFromEnd(30),
+						),
+					),
+					SplitPercent(40),
+				),
+			},
+		},
+		{
+			desc: "right to left layout",
+			opts: []Option{
+				RightToLeft(),
+				SplitVertical(
+					Left(
+						ID("left"),
+						PlaceWidget(widgets["left"]),
+					),
+					Right(
+						ID("topRight"),
+						PlaceWidget(widgets["topRight"]),
+					),
+					SplitPercent(30),
+				),
+			},
+		},
+		{
+			desc: "split separator",
+			opts: []Option{
+				Border(linestyle.Light),
+				SplitVertical(
+					Left(
+						ID("left"),
+						PlaceWidget(widgets["left"]),
+					),
+					Right(
+						SplitHorizontal(
+							Top(
+								ID("topRight"),
+								PlaceWidget(widgets["topRight"]),
+							),
+							Bottom(
+								ID("bottomRight"),
+								PlaceWidget(widgets["bottomRight"]),
+							),
+							SplitSeparator(linestyle.Double),
+						),
+					),
+					SplitSeparator(linestyle.Light, cell.FgColor(cell.ColorRed), cell.Bold()),
+				),
+			},
+		},
+		{
+			desc: "split with zero fixed size",
+			opts: []Option{
+				SplitHorizontal(
+					Top(),
+					Bottom(
+						ID("left"),
+						PlaceWidget(widgets["left"]),
+					),
+					SplitFixed(0),
+				),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			size := image.Point{30, 20}
+			ft := faketerm.MustNew(size)
+			cont, err := New(ft, tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			want := cont.Layout()
+
+			encoded, err := json.Marshal(want)
+			if err != nil {
+				t.Fatalf("json.Marshal => unexpected error: %v", err)
+			}
+			var decoded Layout
+			if err := json.Unmarshal(encoded, &decoded); err != nil {
+				t.Fatalf("json.Unmarshal => unexpected error: %v", err)
+			}
+
+			opts, err := decoded.Options(widgets)
+			if err != nil {
+				t.Fatalf("Options => unexpected error: %v", err)
+			}
+			gotFt := faketerm.MustNew(size)
+			got, err := New(gotFt, opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+
+			if diff := pretty.Compare(want, got.Layout()); diff != "" {
+				t.Errorf("Layout => unexpected diff after the round trip (-want, +got):\n%s", diff)
+			}
+			if diff := pretty.Compare(focusOrderIDs(cont), focusOrderIDs(got)); diff != "" {
+				t.Errorf("focusOrder => unexpected diff after the round trip (-want, +got):\n%s", diff)
+			}
+
+			if err := cont.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+			if err := got.Draw(); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+			if diff := faketerm.Diff(ft, gotFt); diff != "" {
+				t.Errorf("Draw => unexpected diff after the round trip:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLayoutFromJSON(t *testing.T) {
+	tests := []struct {
+		desc string
+		json string
+		// want are options that create the container described by the JSON.
+		want []Option
+	}{
+		{
+			desc: "split in percent without a fixed size",
+			json: `{"split": "vertical", "splitPercent": 30, "first": {}, "second": {}}`,
+			want: []Option{
+				SplitVertical(Left(), Right(), SplitPercent(30)),
+			},
+		},
+		{
+			desc: "split without any size uses the default",
+			json: `{"split": "horizontal", "first": {}, "second": {}}`,
+			want: []Option{
+				SplitHorizontal(Top(), Bottom()),
+			},
+		},
+		{
+			desc: "split with zero fixed size",
+			json: `{"split": "vertical", "splitFixed": 0, "first": {}, "second": {}}`,
+			want: []Option{
+				SplitVertical(Left(), Right(), SplitFixed(0)),
+			},
+		},
+		{
+			desc: "split with fixed size from the end",
+			json: `{"split": "vertical", "splitFixed": 5, "splitFromEnd": true, "first": {}, "second": {}}`,
+			want: []Option{
+				SplitVertical(Left(), Right(), SplitFixedFromEnd(5)),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			var l Layout
+			if err := json.Unmarshal([]byte(tc.json), &l); err != nil {
+				t.Fatalf("json.Unmarshal => unexpected error: %v", err)
+			}
+			opts, err := l.Options(nil)
+			if err != nil {
+				t.Fatalf("Options => unexpected error: %v", err)
+			}
+
+			size := image.Point{30, 20}
+			got, err := New(faketerm.MustNew(size), opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			want, err := New(faketerm.MustNew(size), tc.want...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+
+			// Only the split is compared, the JSON leaves the remaining
+			// fields at their zero values.
+			if got, want := got.opts.splitPercent, want.opts.splitPercent; got != want {
+				t.Errorf("splitPercent => %d, want %d", got, want)
+			}
+			if got, want := got.opts.splitFixed, want.opts.splitFixed; got != want {
+				t.Errorf("splitFixed => %d, want %d", got, want)
+			}
+			if got, want := got.opts.splitReversed, want.opts.splitReversed; got != want {
+				t.Errorf("splitReversed => %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestLayoutOptionsFails(t *testing.T) {
+	tests := []struct {
+		desc    string
+		layout  *Layout
+		widgets map[string]widgetapi.Widget
+	}{
+		{
+			desc: "no widget for the container",
+			layout: &Layout{
+				ID:        "id",
+				HasWidget: true,
+			},
+		},
+		{
+			desc: "widget in a container without an ID",
+			layout: &Layout{
+				HasWidget: true,
+			},
+			widgets: map[string]widgetapi.Widget{
+				"id": fakewidget.New(widgetapi.Options{}),
+			},
+		},
+		{
+			desc: "unsupported split",
+			layout: &Layout{
+				Split:  "diagonal",
+				First:  &Layout{},
+				Second: &Layout{},
+			},
+		},
+		{
+			desc: "split without the second sub container",
+			layout: &Layout{
+				Split: LayoutSplitVertical,
+				First: &Layout{},
+			},
+		},
+		{
+			desc: "invalid border title alignment",
+			layout: &Layout{
+				BorderTitleAlign: align.Horizontal(-1),
+			},
+		},
+		{
+			desc: "error in a sub container",
+			layout: &Layout{
+				Split: LayoutSplitHorizontal,
+				First: &Layout{},
+				Second: &Layout{
+					ID:        "id",
+					HasWidget: true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			if _, err := tc.layout.Options(tc.widgets); err == nil {
+				t.Errorf("Options => got nil error, want an error")
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/mum4k/termdash/cell"
 	"github.com/mum4k/termdash/keyboard"
 	"github.com/mum4k/termdash/linestyle"
+	"github.com/mum4k/termdash/mouse"
 	"github.com/mum4k/termdash/private/area"
 	"github.com/mum4k/termdash/widgetapi"
 )
@@ -112,11 +113,17 @@ type options struct {
 	splitReversed bool
 	splitPercent  int
 	splitFixed    int
+	// splitSeparator is the line drawn between the sub containers or nil if
+	// the SplitSeparator option wasn't provided.
+	splitSeparator *splitSeparator
 
 	// widget is the widget in the container.
 	// A container can have either two sub containers (left and right) or a
 	// widget. But not both.
 	widget widgetapi.Widget
+	// placement identifies the placement of the widget into the container,
+	// unique within the tree. Zero if there is no widget.
+	placement uint64
 
 	// Alignment of the widget if present.
 	hAlign align.Horizontal
@@ -126,19 +133,43 @@ type options struct {
 	border            linestyle.LineStyle
 	borderTitle       string
 	borderTitleHAlign align.Horizontal
+	// focusedBorder is the border drawn around the container only when it
+	// is focused.
+	focusedBorder linestyle.LineStyle
 
 	// padding is a space reserved between the outer edge of the container and
 	// its content (the widget or other sub-containers).
 	padding padding
+	// paddingColor is the background color of the padding region, if set.
+	paddingColor *cell.Color
 
 	// margin is a space reserved on the outside of the container.
 	margin margin
 
+	// shadowOffset is the offset of the shadow drawn behind the container.
+	shadowOffset image.Point
+	// shadowColor is the color of the shadow or nil if no shadow is drawn.
+	shadowColor *cell.Color
+
+	// hideBelow is the terminal size below which the container is hidden.
+	// The zero value means the container is never hidden.
+	hideBelow image.Point
+
+	// keyToggleChrome when set is the key that toggles the visibility of
+	// borders and titles of this container and its sub containers.
+	keyToggleChrome *keyboard.Key
+	// chromeHidden indicates that the KeyToggleChrome key was pressed to hide
+	// borders and titles of this container and its sub containers.
+	chromeHidden bool
+
 	// keyFocusSkip asserts whether this container should be skipped when focus
 	// is being moved using either of KeyFocusNext or KeyFocusPrevious.
 	keyFocusSkip bool
 	// keyFocusGroups are the focus groups this container belongs to.
 	keyFocusGroups []FocusGroup
+	// focusOrder is the position of this container when the keyboard focus
+	// is moved using the keys, nil if the tree order is used.
+	focusOrder *int
 }
 
 // margin stores the configured margin for the container.
@@ -199,6 +230,12 @@ type inherited struct {
 	titleColor *cell.Color
 	// titleFocusedColor is the color used for the title when focused.
 	titleFocusedColor *cell.Color
+	// focusedBackground is the background color of the container when
+	// focused.
+	focusedBackground *cell.Color
+	// rightToLeft indicates that vertical splits place the left sub container
+	// on the right.
+	rightToLeft bool
 }
 
 // focusGroups maps focus group numbers that have the same key assigned.
@@ -234,6 +271,11 @@ type globalOptions struct {
 	// container within a focus group to the focus groups they should work on
 	// in the order they were configured.
 	keyFocusGroupsPrevious map[keyboard.Key]focusGroups
+	// focusFollowsMouse indicates that the focus moves to the container under
+	// the mouse cursor when the mouse moves.
+	focusFollowsMouse bool
+	// focusButtons are the mouse buttons whose clicks move the focus.
+	focusButtons []mouse.Button
 }
 
 // newOptions returns a new options instance with the default values.
@@ -244,6 +286,7 @@ func newOptions(parent *options) *options {
 		global: &globalOptions{
 			keyFocusGroupsNext:     map[keyboard.Key]focusGroups{},
 			keyFocusGroupsPrevious: map[keyboard.Key]focusGroups{},
+			focusButtons:           []mouse.Button{DefaultFocusButton},
 		},
 		inherited: inherited{
 			focusedColor: cell.ColorYellow,
@@ -370,6 +413,39 @@ func SplitFixedFromEnd(cells int) SplitOption {
 	})
 }
 
+// splitSeparator is the line drawn on the boundary between the sub
+// containers, see SplitSeparator.
+type splitSeparator struct {
+	lineStyle linestyle.LineStyle
+	cellOpts  []cell.Option
+}
+
+// SplitSeparator draws a line of the specified style on the boundary between
+// the two sub containers, which is useful when a divider between them is
+// preferred over full borders around each of them.
+// The line takes one cell from the layout. The cell is taken from the second
+// sub container, or from the first one when the size of the split applies to
+// the second sub container, i.e. when SplitPercentFromEnd or
+// SplitFixedFromEnd is provided.
+// If the container has a border of the same line style and no padding
+// separates it from the line, the line is joined to the border with
+// T-junctions.
+// The cell options are applied to the cells of the line, including the
+// T-junctions. The line isn't drawn while one of the sub containers is
+// hidden, see HideBelowSize.
+func SplitSeparator(ls linestyle.LineStyle, cOpts ...cell.Option) SplitOption {
+	return splitOption(func(opts *options) error {
+		if ls == linestyle.None {
+			return errors.New("the line style provided to SplitSeparator cannot be linestyle.None")
+		}
+		opts.splitSeparator = &splitSeparator{
+			lineStyle: ls,
+			cellOpts:  cOpts,
+		}
+		return nil
+	})
+}
+
 // SplitVertical splits the container along the vertical axis into two sub
 // containers. The use of this option removes any widget placed at this
 // container, containers with sub containers cannot contain widgets.
@@ -377,6 +453,7 @@ func SplitVertical(l LeftOption, r RightOption, opts ...SplitOption) Option {
 	return option(func(c *Container) error {
 		c.opts.split = splitTypeVertical
 		c.opts.widget = nil
+		c.opts.placement = 0
 		for _, opt := range opts {
 			if err := opt.setSplit(c.opts); err != nil {
 				return err
@@ -397,6 +474,7 @@ func SplitHorizontal(t TopOption, b BottomOption, opts ...SplitOption) Option {
 	return option(func(c *Container) error {
 		c.opts.split = splitTypeHorizontal
 		c.opts.widget = nil
+		c.opts.placement = 0
 		for _, opt := range opts {
 			if err := opt.setSplit(c.opts); err != nil {
 				return err
@@ -431,6 +509,7 @@ func ID(id string) Option {
 func Clear() Option {
 	return option(func(c *Container) error {
 		c.opts.widget = nil
+		c.opts.placement = 0
 		c.first = nil
 		c.second = nil
 		return nil
@@ -443,6 +522,8 @@ func Clear() Option {
 func PlaceWidget(w widgetapi.Widget) Option {
 	return option(func(c *Container) error {
 		c.opts.widget = w
+		c.focusTracker.placements++
+		c.opts.placement = c.focusTracker.placements
 		c.first = nil
 		c.second = nil
 		return nil
@@ -721,8 +802,24 @@ func PaddingLeftPercent(perc int) Option {
 	})
 }
 
+// PaddingColor sets the background color of the padding, i.e. of the space
+// between the container's border (or its outer edge if it has no border) and
+// its content. The margin and the content itself aren't affected. Has no
+// effect if the container has no padding.
+// This option isn't inherited to sub containers.
+func PaddingColor(color cell.Color) Option {
+	return option(func(c *Container) error {
+		c.opts.paddingColor = &color
+		return nil
+	})
+}
+
 // AlignHorizontal sets the horizontal alignment for the widget placed in the
 // container. Has no effect if the container contains no widget.
+// The alignment applies when the widget's canvas is narrower than the
+// container, i.e. when the widget requests a Ratio or a MaximumSize in its
+// options. The position of mouse events forwarded to the widget remains
+// relative to its aligned canvas.
 // Defaults to alignment in the center.
 func AlignHorizontal(h align.Horizontal) Option {
 	return option(func(c *Container) error {
@@ -733,6 +830,10 @@ func AlignHorizontal(h align.Horizontal) Option {
 
 // AlignVertical sets the vertical alignment for the widget placed in the container.
 // Has no effect if the container contains no widget.
+// The alignment applies when the widget's canvas is shorter than the
+// container, i.e. when the widget requests a Ratio or a MaximumSize in its
+// options. The position of mouse events forwarded to the widget remains
+// relative to its aligned canvas.
 // Defaults to alignment in the middle.
 func AlignVertical(v align.Vertical) Option {
 	return option(func(c *Container) error {
@@ -819,6 +920,98 @@ func TitleFocusedColor(color cell.Color) Option {
 	})
 }
 
+// FocusedBackground sets the background color of the container when it has
+// keyboard focus. The color applies to all the cells of the container whose
+// background color wasn't set by the widget or the border. This allows to
+// indicate focus of containers that don't have a border.
+// This option is inherited to sub containers created by container splits.
+func FocusedBackground(color cell.Color) Option {
+	return option(func(c *Container) error {
+		c.opts.inherited.focusedBackground = &color
+		return nil
+	})
+}
+
+// RightToLeft mirrors the layout of vertical splits for right-to-left
+// languages. The sub container provided via the Left option is placed on the
+// right and the one provided via the Right option on the left. The split
+// percentage or fixed size still applies to the sub container provided via
+// the Left option. Only affects the layout, the content of widgets isn't
+// mirrored.
+// This option is inherited to sub containers created by container splits.
+func RightToLeft() Option {
+	return option(func(c *Container) error {
+		c.opts.inherited.rightToLeft = true
+		return nil
+	})
+}
+
+// HideBelowSize hides the container and all of its sub containers while the
+// terminal is narrower than the width or lower than the height in cells.
+// The space of the hidden container is given to its sibling, i.e. to the other
+// sub container created by the same split. If both sub containers are hidden,
+// the area of their parent remains empty. This allows to build responsive
+// layouts that drop less important panes on small terminals instead of
+// asking the user to resize the terminal.
+// Hidden containers don't receive the keyboard focus and their widgets don't
+// receive keyboard or mouse events. If the focused container gets hidden, the
+// focus moves to the next container in the focus order.
+// Both values must be zero or positive, a zero value disables hiding in that
+// dimension.
+// This option isn't inherited to sub containers.
+func HideBelowSize(width, height int) Option {
+	return option(func(c *Container) error {
+		if width < 0 || height < 0 {
+			return fmt.Errorf("invalid HideBelowSize(%d, %d), the width and height must be zero or positive", width, height)
+		}
+		c.opts.hideBelow = image.Point{width, height}
+		return nil
+	})
+}
+
+// KeyToggleChrome configures a key that toggles the visibility of borders and
+// border titles of this container and all of its sub containers when pressed.
+// While hidden, the cells of the borders are given to the widgets and sub
+// containers, pressing the key again restores the borders.
+// This is useful for a "focus mode" that hides all the chrome around the
+// widgets.
+// This option isn't inherited to sub containers.
+func KeyToggleChrome(key keyboard.Key) Option {
+	return option(func(c *Container) error {
+		c.opts.keyToggleChrome = &key
+		return nil
+	})
+}
+
+// Shadow draws a shadow behind the container, i.e. fills the region of the
+// container's area displaced by the offset with the specified background
+// color. Positive offset values place the shadow to the right and below the
+// container. This is a purely visual effect, the shadow is only drawn into the
+// space reserved around the container by its margin and is clipped otherwise.
+// E.g. use MarginRight and MarginBottom of at least the offset to make room for
+// a shadow with a positive offset.
+// This option isn't inherited to sub containers.
+func Shadow(offset image.Point, color cell.Color) Option {
+	return option(func(c *Container) error {
+		c.opts.shadowOffset = offset
+		c.opts.shadowColor = &color
+		return nil
+	})
+}
+
+// BorderOnFocus configures the container to have a border of the specified
+// style only when it has keyboard focus. The space for the border is always
+// reserved, so that the widget doesn't get resized when the focus changes.
+// If provided together with the Border option, the container has a border of
+// the style set by Border when not focused and of the style set here when
+// focused.
+func BorderOnFocus(ls linestyle.LineStyle) Option {
+	return option(func(c *Container) error {
+		c.opts.focusedBorder = ls
+		return nil
+	})
+}
+
 // splitType identifies how a container is split.
 type splitType int
 
@@ -941,6 +1134,46 @@ func Bottom(opts ...Option) BottomOption {
 	})
 }
 
+// DefaultFocusButton is the default value for the FocusButtons option.
+const DefaultFocusButton = mouse.ButtonLeft
+
+// FocusButtons configures the mouse buttons whose clicks move the keyboard
+// focus to the clicked container, e.g. to reserve the right button for
+// context menus. Clicks of other buttons are delivered to the widgets without
+// moving the focus. Only the left, right and middle buttons are supported.
+// Providing no buttons means that mouse clicks never move the focus.
+// Defaults to DefaultFocusButton.
+//
+// This option is global and applies to all created containers.
+func FocusButtons(buttons ...mouse.Button) Option {
+	return option(func(c *Container) error {
+		for _, b := range buttons {
+			switch b {
+			case mouse.ButtonLeft, mouse.ButtonRight, mouse.ButtonMiddle:
+			default:
+				return fmt.Errorf("invalid FocusButtons button %v, must be one of %v, %v or %v", b, mouse.ButtonLeft, mouse.ButtonRight, mouse.ButtonMiddle)
+			}
+		}
+		c.opts.global.focusButtons = buttons
+		return nil
+	})
+}
+
+// FocusFollowsMouse configures the containers so that the keyboard focus moves
+// to the container under the mouse cursor when the mouse moves, without the
+// need to click. Requires a terminal that reports mouse motion events, i.e.
+// mouse events with the mouse.ButtonRelease button while no button is pressed.
+// Clicking still moves the focus the same way as without this option.
+// Widgets implementing widgetapi.FocusGuard can prevent the focus from moving.
+//
+// This option is global and applies to all created containers.
+func FocusFollowsMouse() Option {
+	return option(func(c *Container) error {
+		c.opts.global.focusFollowsMouse = true
+		return nil
+	})
+}
+
 // KeyFocusNext configures a key that moves the keyboard focus to the next
 // container when pressed.
 //
@@ -990,6 +1223,21 @@ func KeyFocusSkip() Option {
 	})
 }
 
+// FocusOrder sets the position of this container in the order in which the
+// keyboard focus visits the containers when moved using any of the keys
+// configured with KeyFocusNext, KeyFocusPrevious, KeyFocusGroupsNext or
+// KeyFocusGroupsPrevious.
+//
+// Containers with this option are visited first, in the ascending order of the
+// provided values. Containers with equal values and containers without this
+// option are visited in the order they appear in the container tree.
+func FocusOrder(order int) Option {
+	return option(func(c *Container) error {
+		c.opts.focusOrder = &order
+		return nil
+	})
+}
+
 // FocusGroup represents a group of containers that can have the keyboard focus
 // moved between them sharing the same keyboard key.
 type FocusGroup int
@@ -84,3 +84,43 @@ func findID(root *Container, id string) (*Container, error) {
 	}
 	return cont, nil
 }
+
+// findMatching finds all the containers whose ID matches the provided
+// function in the tree rooted at the provided container, in pre-order.
+// Returns an error if no container matches.
+func findMatching(root *Container, match func(id string) bool) ([]*Container, error) {
+	if match == nil {
+		return nil, errors.New("the function matching container IDs must not be nil")
+	}
+
+	var (
+		errStr string
+		conts  []*Container
+	)
+	preOrder(root, &errStr, visitFunc(func(c *Container) error {
+		if match(c.opts.id) {
+			conts = append(conts, c)
+		}
+		return nil
+	}))
+	if len(conts) == 0 {
+		return nil, errors.New("no container matches")
+	}
+	return conts, nil
+}
+
+// isReachable asserts whether the container is in the tree rooted at the
+// provided container.
+func isReachable(root, c *Container) bool {
+	var (
+		errStr    string
+		reachable bool
+	)
+	preOrder(root, &errStr, visitFunc(func(cur *Container) error {
+		if cur == c {
+			reachable = true
+		}
+		return nil
+	}))
+	return reachable
+}
@@ -0,0 +1,201 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+// tree.go contains code that inspects the tree of containers.
+
+import (
+	"fmt"
+	"image"
+	"strings"
+
+	"github.com/mum4k/termdash/linestyle"
+)
+
+// TreeSpacing is the margin or padding of a container in cells, i.e. after
+// any percentages were resolved against the size of the container.
+type TreeSpacing struct {
+	Top    int
+	Right  int
+	Bottom int
+	Left   int
+}
+
+// TreeNode is a read-only snapshot of a container and its sub containers,
+// useful for debugging of nested layouts and for tests. Unlike Layout, which
+// records the configured options, TreeNode records the state of the
+// containers as of the last call to Draw, e.g. the areas they occupy on the
+// terminal. The areas are zero until the first call to Draw.
+type TreeNode struct {
+	// ID is the identifier of the container, see the ID option.
+	ID string
+
+	// Outer is the area allocated to the container before its margin was
+	// applied.
+	Outer image.Rectangle
+	// Area is the area of the container, including its border and padding.
+	Area image.Rectangle
+	// Margin is the space between Outer and Area.
+	Margin TreeSpacing
+	// Padding is the space between the border (or Area if there is no
+	// border) and the content of the container.
+	Padding TreeSpacing
+
+	// Border is the style of the border drawn around the container, this
+	// is linestyle.None if the container currently has no border, e.g.
+	// because the border is only drawn when focused or it was hidden using
+	// the KeyToggleChrome key.
+	Border linestyle.LineStyle
+	// BorderTitle is the text title within the border, if any.
+	BorderTitle string
+
+	// Split indicates how is the container split. When split, First and
+	// Second are the two sub containers.
+	Split  LayoutSplit
+	First  *TreeNode
+	Second *TreeNode
+	// SplitPercent is the size of the split in percent, only used if
+	// SplitFixed is negative.
+	SplitPercent int
+	// SplitFixed is the size of the split in cells or a negative number if
+	// the size is set in percent.
+	SplitFixed int
+	// SplitFromEnd indicates that the size of the split applies to the
+	// second sub container.
+	SplitFromEnd bool
+
+	// HasWidget indicates if a widget is placed in the container.
+	HasWidget bool
+	// WidgetArea is the area of the canvas of the widget, zero if the
+	// container has no widget.
+	WidgetArea image.Rectangle
+
+	// Focused indicates if the container is focused.
+	Focused bool
+	// Hidden indicates if the container is hidden, see the HideBelowSize
+	// option.
+	Hidden bool
+}
+
+// Tree returns a snapshot of this container and all of its sub containers.
+// The containers are traversed in a stable order, the first sub container
+// before the second one.
+func (c *Container) Tree() *TreeNode {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return treeOf(c)
+}
+
+// treeOf returns the snapshot of the container and its sub containers.
+// Caller must hold c.mu.
+func treeOf(c *Container) *TreeNode {
+	o := c.opts
+	n := &TreeNode{
+		ID:    o.id,
+		Outer: c.outer,
+		Area:  c.area,
+		Margin: TreeSpacing{
+			Top:    c.area.Min.Y - c.outer.Min.Y,
+			Right:  c.outer.Max.X - c.area.Max.X,
+			Bottom: c.outer.Max.Y - c.area.Max.Y,
+			Left:   c.area.Min.X - c.outer.Min.X,
+		},
+		BorderTitle: o.borderTitle,
+		Border:      c.borderStyle(),
+		HasWidget:   c.hasWidget(),
+		Focused:     c.focusTracker.isActive(c),
+		Hidden:      c.isHidden(),
+	}
+
+	// The padding and the widget area can't be determined if the area is
+	// too small, e.g. before the first call to Draw. These are left zero.
+	usable := c.usable()
+	if padded, err := o.padding.apply(usable); err == nil {
+		n.Padding = TreeSpacing{
+			Top:    padded.Min.Y - usable.Min.Y,
+			Right:  usable.Max.X - padded.Max.X,
+			Bottom: usable.Max.Y - padded.Max.Y,
+			Left:   padded.Min.X - usable.Min.X,
+		}
+	}
+	if wa, err := c.widgetArea(); err == nil {
+		n.WidgetArea = wa
+	}
+
+	if c.first != nil && c.second != nil {
+		if o.split == splitTypeVertical {
+			n.Split = LayoutSplitVertical
+		} else {
+			n.Split = LayoutSplitHorizontal
+		}
+		n.SplitPercent = o.splitPercent
+		n.SplitFixed = o.splitFixed
+		n.SplitFromEnd = o.splitReversed
+		n.First = treeOf(c.first)
+		n.Second = treeOf(c.second)
+	}
+	return n
+}
+
+// String returns the tree in a human readable format, one container per line
+// with the sub containers indented below their parent.
+// Implements fmt.Stringer.
+func (n *TreeNode) String() string {
+	var b strings.Builder
+	n.write(&b, 0)
+	return b.String()
+}
+
+// write writes the node and its sub nodes into the builder indented to the
+// specified depth.
+func (n *TreeNode) write(b *strings.Builder, depth int) {
+	b.WriteString(strings.Repeat("  ", depth))
+	if n.ID != "" {
+		fmt.Fprintf(b, "%q ", n.ID)
+	}
+	fmt.Fprintf(b, "area:%v", n.Area)
+	if n.Margin != (TreeSpacing{}) {
+		fmt.Fprintf(b, " margin:%+v", n.Margin)
+	}
+	if n.Padding != (TreeSpacing{}) {
+		fmt.Fprintf(b, " padding:%+v", n.Padding)
+	}
+	if n.Border != linestyle.None {
+		fmt.Fprintf(b, " border:%v", n.Border)
+	}
+	if n.BorderTitle != "" {
+		fmt.Fprintf(b, " title:%q", n.BorderTitle)
+	}
+	if n.Split != LayoutSplitNone {
+		fmt.Fprintf(b, " split:%v", n.Split)
+	}
+	if n.HasWidget {
+		fmt.Fprintf(b, " widget:%v", n.WidgetArea)
+	}
+	if n.Focused {
+		b.WriteString(" focused")
+	}
+	if n.Hidden {
+		b.WriteString(" hidden")
+	}
+	b.WriteString("\n")
+
+	if n.First != nil {
+		n.First.write(b, depth+1)
+	}
+	if n.Second != nil {
+		n.Second.write(b, depth+1)
+	}
+}
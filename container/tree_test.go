@@ -0,0 +1,221 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package container
+
+import (
+	"image"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/mum4k/termdash/linestyle"
+	"github.com/mum4k/termdash/private/faketerm"
+	"github.com/mum4k/termdash/private/fakewidget"
+	"github.com/mum4k/termdash/widgetapi"
+)
+
+func TestTree(t *testing.T) {
+	tests := []struct {
+		desc string
+		opts []Option
+		// draw indicates if the container should be drawn before getting the
+		// tree.
+		draw bool
+		want *TreeNode
+	}{
+		{
+			desc: "single container before draw",
+			opts: []Option{
+				ID("root"),
+				PlaceWidget(fakewidget.New(widgetapi.Options{})),
+			},
+			want: &TreeNode{
+				ID:        "root",
+				HasWidget: true,
+				Focused:   true,
+			},
+		},
+		{
+			desc: "single container with a border and padding",
+			opts: []Option{
+				ID("root"),
+				Border(linestyle.Light),
+				BorderTitle("title"),
+				PaddingLeft(2),
+				PlaceWidget(fakewidget.New(widgetapi.Options{})),
+			},
+			draw: true,
+			want: &TreeNode{
+				ID:          "root",
+				Outer:       image.Rect(0, 0, 20, 10),
+				Area:        image.Rect(0, 0, 20, 10),
+				Padding:     TreeSpacing{Left: 2},
+				Border:      linestyle.Light,
+				BorderTitle: "title",
+				HasWidget:   true,
+				WidgetArea:  image.Rect(3, 1, 19, 9),
+				Focused:     true,
+			},
+		},
+		{
+			desc: "multi-level layout",
+			opts: []Option{
+				ID("root"),
+				SplitVertical(
+					Left(
+						ID("left"),
+						MarginRight(1),
+						PlaceWidget(fakewidget.New(widgetapi.Options{})),
+					),
+					Right(
+						ID("right"),
+						SplitHorizontal(
+							Top(
+								ID("top"),
+								Border(linestyle.Double),
+							),
+							Bottom(
+								ID("bottom"),
+								PaddingTop(1),
+								PlaceWidget(fakewidget.New(widgetapi.Options{})),
+							),
+							SplitFixed(4),
+						),
+					),
+					SplitPercent(50),
+				),
+			},
+			draw: true,
+			want: &TreeNode{
+				ID:           "root",
+				Outer:        image.Rect(0, 0, 20, 10),
+				Area:         image.Rect(0, 0, 20, 10),
+				Split:        LayoutSplitVertical,
+				SplitPercent: 50,
+				SplitFixed:   DefaultSplitFixed,
+				Focused:      true,
+				First: &TreeNode{
+					ID:         "left",
+					Outer:      image.Rect(0, 0, 10, 10),
+					Area:       image.Rect(0, 0, 9, 10),
+					Margin:     TreeSpacing{Right: 1},
+					HasWidget:  true,
+					WidgetArea: image.Rect(0, 0, 9, 10),
+				},
+				Second: &TreeNode{
+					ID:           "right",
+					Outer:        image.Rect(10, 0, 20, 10),
+					Area:         image.Rect(10, 0, 20, 10),
+					Split:        LayoutSplitHorizontal,
+					SplitPercent: DefaultSplitPercent,
+					SplitFixed:   4,
+					First: &TreeNode{
+						ID:     "top",
+						Outer:  image.Rect(10, 0, 20, 4),
+						Area:   image.Rect(10, 0, 20, 4),
+						Border: linestyle.Double,
+					},
+					Second: &TreeNode{
+						ID:         "bottom",
+						Outer:      image.Rect(10, 4, 20, 10),
+						Area:       image.Rect(10, 4, 20, 10),
+						Padding:    TreeSpacing{Top: 1},
+						HasWidget:  true,
+						WidgetArea: image.Rect(10, 5, 20, 10),
+					},
+				},
+			},
+		},
+		{
+			desc: "hidden container",
+			opts: []Option{
+				SplitHorizontal(
+					Top(
+						HideBelowSize(100, 100),
+					),
+					Bottom(),
+				),
+			},
+			draw: true,
+			want: &TreeNode{
+				Outer:        image.Rect(0, 0, 20, 10),
+				Area:         image.Rect(0, 0, 20, 10),
+				Split:        LayoutSplitHorizontal,
+				SplitPercent: DefaultSplitPercent,
+				SplitFixed:   DefaultSplitFixed,
+				Focused:      true,
+				First: &TreeNode{
+					Hidden: true,
+				},
+				Second: &TreeNode{
+					Outer: image.Rect(0, 0, 20, 10),
+					Area:  image.Rect(0, 0, 20, 10),
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ft, err := faketerm.New(image.Point{20, 10})
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			cont, err := New(ft, tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if tc.draw {
+				if err := cont.Draw(); err != nil {
+					t.Fatalf("Draw => unexpected error: %v", err)
+				}
+			}
+
+			got := cont.Tree()
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("Tree => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTreeNodeString(t *testing.T) {
+	n := &TreeNode{
+		ID:      "root",
+		Area:    image.Rect(0, 0, 20, 10),
+		Border:  linestyle.Light,
+		Split:   LayoutSplitVertical,
+		Focused: true,
+		First: &TreeNode{
+			ID:         "left",
+			Area:       image.Rect(1, 1, 10, 9),
+			Margin:     TreeSpacing{Right: 1},
+			HasWidget:  true,
+			WidgetArea: image.Rect(1, 1, 10, 9),
+		},
+		Second: &TreeNode{
+			Area:        image.Rect(10, 1, 19, 9),
+			Padding:     TreeSpacing{Top: 1},
+			BorderTitle: "title",
+			Hidden:      true,
+		},
+	}
+	want := `"root" area:(0,0)-(20,10) border:LineStyleLight split:vertical focused
+  "left" area:(1,1)-(10,9) margin:{Top:0 Right:1 Bottom:0 Left:0} widget:(1,1)-(10,9)
+  area:(10,1)-(19,9) padding:{Top:1 Right:0 Bottom:0 Left:0} title:"title" hidden
+`
+	if got := n.String(); got != want {
+		t.Errorf("String => %q, want %q", got, want)
+	}
+}
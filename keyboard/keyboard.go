@@ -22,6 +22,8 @@ package keyboard
 type Key rune
 
 // String implements fmt.Stringer()
+// The returned value is the name of the constant, see Name for a name that is
+// suitable for displaying to the users.
 func (b Key) String() string {
 	if n, ok := buttonNames[b]; ok {
 		return n
@@ -92,6 +94,83 @@ var buttonNames = map[Key]string{
 	KeyBackspace2: "KeyBackspace2",
 }
 
+// Name returns a human readable name of the key, suitable for displaying
+// key bindings to the users, e.g. "Enter", "Ctrl+C" or "F5". Printable
+// characters are returned as they are, except for the space which is named
+// "Space".
+//
+// Keys that termbox declares as duplicates share the same name, e.g.
+// KeyCtrlM is named "Enter".
+func (b Key) Name() string {
+	if n, ok := keyNames[b]; ok {
+		return n
+	} else if b >= 0 {
+		return string(b)
+	}
+	return "Unknown"
+}
+
+// keyNames maps Key values to names displayed to the users.
+var keyNames = map[Key]string{
+	KeyF1:         "F1",
+	KeyF2:         "F2",
+	KeyF3:         "F3",
+	KeyF4:         "F4",
+	KeyF5:         "F5",
+	KeyF6:         "F6",
+	KeyF7:         "F7",
+	KeyF8:         "F8",
+	KeyF9:         "F9",
+	KeyF10:        "F10",
+	KeyF11:        "F11",
+	KeyF12:        "F12",
+	KeyInsert:     "Insert",
+	KeyDelete:     "Delete",
+	KeyHome:       "Home",
+	KeyEnd:        "End",
+	KeyPgUp:       "PgUp",
+	KeyPgDn:       "PgDn",
+	KeyArrowUp:    "Up",
+	KeyArrowDown:  "Down",
+	KeyArrowLeft:  "Left",
+	KeyArrowRight: "Right",
+	KeyCtrlTilde:  "Ctrl+~",
+	KeyCtrlA:      "Ctrl+A",
+	KeyCtrlB:      "Ctrl+B",
+	KeyCtrlC:      "Ctrl+C",
+	KeyCtrlD:      "Ctrl+D",
+	KeyCtrlE:      "Ctrl+E",
+	KeyCtrlF:      "Ctrl+F",
+	KeyCtrlG:      "Ctrl+G",
+	KeyBackspace:  "Backspace",
+	KeyTab:        "Tab",
+	KeyBacktab:    "Shift+Tab",
+	KeyCtrlJ:      "Ctrl+J",
+	KeyCtrlK:      "Ctrl+K",
+	KeyCtrlL:      "Ctrl+L",
+	KeyEnter:      "Enter",
+	KeyCtrlN:      "Ctrl+N",
+	KeyCtrlO:      "Ctrl+O",
+	KeyCtrlP:      "Ctrl+P",
+	KeyCtrlQ:      "Ctrl+Q",
+	KeyCtrlR:      "Ctrl+R",
+	KeyCtrlS:      "Ctrl+S",
+	KeyCtrlT:      "Ctrl+T",
+	KeyCtrlU:      "Ctrl+U",
+	KeyCtrlV:      "Ctrl+V",
+	KeyCtrlW:      "Ctrl+W",
+	KeyCtrlX:      "Ctrl+X",
+	KeyCtrlY:      "Ctrl+Y",
+	KeyCtrlZ:      "Ctrl+Z",
+	KeyEsc:        "Esc",
+	KeyCtrl4:      "Ctrl+4",
+	KeyCtrl5:      "Ctrl+5",
+	KeyCtrl6:      "Ctrl+6",
+	KeyCtrl7:      "Ctrl+7",
+	KeySpace:      "Space",
+	KeyBackspace2: "Backspace",
+}
+
 // Printable characters, but worth having constants for them.
 const (
 	KeySpace = ' '
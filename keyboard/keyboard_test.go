@@ -47,3 +47,80 @@ func TestString(t *testing.T) {
 		})
 	}
 }
+
+func TestName(t *testing.T) {
+	tests := []struct {
+		desc string
+		key  Key
+		want string
+	}{
+		{
+			desc: "unknown",
+			key:  Key(-1000),
+			want: "Unknown",
+		},
+		{
+			desc: "enter",
+			key:  KeyEnter,
+			want: "Enter",
+		},
+		{
+			desc: "duplicate of enter",
+			key:  KeyCtrlM,
+			want: "Enter",
+		},
+		{
+			desc: "function key",
+			key:  KeyF5,
+			want: "F5",
+		},
+		{
+			desc: "control key",
+			key:  KeyCtrlC,
+			want: "Ctrl+C",
+		},
+		{
+			desc: "arrow key",
+			key:  KeyArrowUp,
+			want: "Up",
+		},
+		{
+			desc: "backtab",
+			key:  KeyBacktab,
+			want: "Shift+Tab",
+		},
+		{
+			desc: "escape",
+			key:  KeyEsc,
+			want: "Esc",
+		},
+		{
+			desc: "space",
+			key:  KeySpace,
+			want: "Space",
+		},
+		{
+			desc: "letter",
+			key:  'a',
+			want: "a",
+		},
+		{
+			desc: "digit",
+			key:  '7',
+			want: "7",
+		},
+		{
+			desc: "unicode rune",
+			key:  'ü',
+			want: "ü",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := tc.key.Name(); got != tc.want {
+				t.Errorf("Name => %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -32,6 +32,11 @@ func TestString(t *testing.T) {
 			button: ButtonLeft,
 			want:   "ButtonLeft",
 		},
+		{
+			desc:   "horizontal wheel",
+			button: ButtonWheelRight,
+			want:   "ButtonWheelRight",
+		},
 	}
 
 	for _, tc := range tests {
@@ -65,9 +65,7 @@ func (c *Cell) Copy() *Cell {
 
 // Apply applies the provided options to the cell.
 func (c *Cell) Apply(opts ...cell.Option) {
-	for _, opt := range opts {
-		opt.Set(c.Opts)
-	}
+	c.Opts.Apply(opts...)
 }
 
 // Buffer is a 2-D buffer of cells.
@@ -180,3 +180,15 @@ func Border(c *canvas.Canvas, border image.Rectangle, opts ...BorderOption) erro
 	}
 	return nil
 }
+
+// RoundedRectangle draws the outline of a rectangle with rounded corners on
+// the canvas. Accepts the same options as Border, but the outline is always
+// drawn with the linestyle.Round line style, the BorderLineStyle option is
+// ignored.
+// The rectangle must fall within the canvas and be at least 2x2 cells.
+func RoundedRectangle(c *canvas.Canvas, ar image.Rectangle, opts ...BorderOption) error {
+	rOpts := make([]BorderOption, 0, len(opts)+1)
+	rOpts = append(rOpts, opts...)
+	rOpts = append(rOpts, BorderLineStyle(linestyle.Round))
+	return Border(c, ar, rOpts...)
+}
@@ -501,3 +501,159 @@ func TestBorder(t *testing.T) {
 		})
 	}
 }
+
+func TestRoundedRectangle(t *testing.T) {
+	tests := []struct {
+		desc    string
+		canvas  image.Rectangle
+		ar      image.Rectangle
+		opts    []BorderOption
+		want    func(size image.Point) *faketerm.Terminal
+		wantErr bool
+	}{
+		{
+			desc:   "fails when the rectangle falls outside of the canvas",
+			canvas: image.Rect(0, 0, 3, 3),
+			ar:     image.Rect(1, 1, 4, 4),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc:   "fails when the rectangle is too small",
+			canvas: image.Rect(0, 0, 3, 3),
+			ar:     image.Rect(0, 0, 1, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc:   "draws the smallest rectangle",
+			canvas: image.Rect(0, 0, 2, 2),
+			ar:     image.Rect(0, 0, 2, 2),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, '╭')
+				testcanvas.MustSetCell(c, image.Point{1, 0}, '╮')
+				testcanvas.MustSetCell(c, image.Point{0, 1}, '╰')
+				testcanvas.MustSetCell(c, image.Point{1, 1}, '╯')
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "draws rounded corners and straight edges inside the canvas",
+			canvas: image.Rect(0, 0, 6, 5),
+			ar:     image.Rect(1, 1, 5, 4),
+			opts: []BorderOption{
+				BorderCellOpts(cell.FgColor(cell.ColorRed)),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				opts := []cell.Option{cell.FgColor(cell.ColorRed)}
+
+				testcanvas.MustSetCell(c, image.Point{1, 1}, '╭', opts...)
+				testcanvas.MustSetCell(c, image.Point{2, 1}, '─', opts...)
+				testcanvas.MustSetCell(c, image.Point{3, 1}, '─', opts...)
+				testcanvas.MustSetCell(c, image.Point{4, 1}, '╮', opts...)
+
+				testcanvas.MustSetCell(c, image.Point{1, 2}, '│', opts...)
+				testcanvas.MustSetCell(c, image.Point{4, 2}, '│', opts...)
+
+				testcanvas.MustSetCell(c, image.Point{1, 3}, '╰', opts...)
+				testcanvas.MustSetCell(c, image.Point{2, 3}, '─', opts...)
+				testcanvas.MustSetCell(c, image.Point{3, 3}, '─', opts...)
+				testcanvas.MustSetCell(c, image.Point{4, 3}, '╯', opts...)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "ignores the line style option",
+			canvas: image.Rect(0, 0, 3, 3),
+			ar:     image.Rect(0, 0, 3, 3),
+			opts: []BorderOption{
+				BorderLineStyle(linestyle.Double),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, '╭')
+				testcanvas.MustSetCell(c, image.Point{1, 0}, '─')
+				testcanvas.MustSetCell(c, image.Point{2, 0}, '╮')
+				testcanvas.MustSetCell(c, image.Point{0, 1}, '│')
+				testcanvas.MustSetCell(c, image.Point{2, 1}, '│')
+				testcanvas.MustSetCell(c, image.Point{0, 2}, '╰')
+				testcanvas.MustSetCell(c, image.Point{1, 2}, '─')
+				testcanvas.MustSetCell(c, image.Point{2, 2}, '╯')
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "draws a title",
+			canvas: image.Rect(0, 0, 5, 2),
+			ar:     image.Rect(0, 0, 5, 2),
+			opts: []BorderOption{
+				BorderTitle("ab", OverrunModeTrim),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, '╭')
+				testcanvas.MustSetCell(c, image.Point{1, 0}, 'a')
+				testcanvas.MustSetCell(c, image.Point{2, 0}, 'b')
+				testcanvas.MustSetCell(c, image.Point{3, 0}, '─')
+				testcanvas.MustSetCell(c, image.Point{4, 0}, '╮')
+				testcanvas.MustSetCell(c, image.Point{0, 1}, '╰')
+				testcanvas.MustSetCell(c, image.Point{1, 1}, '─')
+				testcanvas.MustSetCell(c, image.Point{2, 1}, '─')
+				testcanvas.MustSetCell(c, image.Point{3, 1}, '─')
+				testcanvas.MustSetCell(c, image.Point{4, 1}, '╯')
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			c, err := canvas.New(tc.canvas)
+			if err != nil {
+				t.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+
+			err = RoundedRectangle(c, tc.ar, tc.opts...)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("RoundedRectangle => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			got, err := faketerm.New(c.Size())
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+
+			if err := c.Apply(got); err != nil {
+				t.Fatalf("Apply => unexpected error: %v", err)
+			}
+
+			if diff := faketerm.Diff(tc.want(c.Size()), got); diff != "" {
+				t.Errorf("RoundedRectangle => %v", diff)
+			}
+		})
+	}
+}
@@ -148,6 +148,21 @@ func BrailleCircle(bc *braille.Canvas, mid image.Point, radius int, opts ...Brai
 	return nil
 }
 
+// BrailleArc draws an arc, i.e. a portion of an approximated circle with the
+// specified mid point and radius, between the two angles in degrees.
+// Each angle must be in range 0 <= angle <= 360. Start and end must not be
+// equal. The zero angle is on the X axis, angles grow counter-clockwise.
+//
+// This is a shorthand for BrailleCircle with the BrailleCircleArcOnly option
+// and has the same requirements on the mid point and radius. Accepts the same
+// options, e.g. BrailleCircleFilled draws a circular sector instead of just
+// the arc.
+func BrailleArc(bc *braille.Canvas, mid image.Point, radius, startDegree, endDegree int, opts ...BrailleCircleOption) error {
+	arcOpts := append([]BrailleCircleOption{}, opts...)
+	arcOpts = append(arcOpts, BrailleCircleArcOnly(startDegree, endDegree))
+	return BrailleCircle(bc, mid, radius, arcOpts...)
+}
+
 // drawPoints draws the points onto the canvas.
 func drawPoints(bc *braille.Canvas, points []image.Point, opt *brailleCircleOptions) error {
 	for _, p := range points {
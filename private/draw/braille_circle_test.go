@@ -1189,3 +1189,125 @@ func TestBrailleCircle(t *testing.T) {
 		})
 	}
 }
+
+func TestBrailleArc(t *testing.T) {
+	tests := []struct {
+		desc       string
+		canvas     image.Rectangle
+		mid        image.Point
+		radius     int
+		start, end int
+		opts       []BrailleCircleOption
+		want       func(size image.Point) *faketerm.Terminal
+		wantErr    bool
+	}{
+		{
+			desc:    "fails when the arc doesn't fit",
+			canvas:  image.Rect(0, 0, 1, 1),
+			mid:     image.Point{0, 0},
+			radius:  2,
+			start:   0,
+			end:     90,
+			wantErr: true,
+		},
+		{
+			desc:    "fails on angle out of range",
+			canvas:  image.Rect(0, 0, 3, 3),
+			mid:     image.Point{2, 2},
+			radius:  2,
+			start:   0,
+			end:     361,
+			wantErr: true,
+		},
+		{
+			desc:    "fails on equal angles",
+			canvas:  image.Rect(0, 0, 3, 3),
+			mid:     image.Point{2, 2},
+			radius:  2,
+			start:   90,
+			end:     90,
+			wantErr: true,
+		},
+		{
+			desc:   "quarter arc",
+			canvas: image.Rect(0, 0, 3, 3),
+			mid:    image.Point{2, 2},
+			radius: 2,
+			start:  0,
+			end:    90,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				bc := testbraille.MustNew(ft.Area())
+
+				testbraille.MustSetPixel(bc, image.Point{2, 0})
+				testbraille.MustSetPixel(bc, image.Point{3, 0})
+				testbraille.MustSetPixel(bc, image.Point{4, 1})
+				testbraille.MustSetPixel(bc, image.Point{4, 2})
+
+				testbraille.MustApply(bc, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "half arc with cell options",
+			canvas: image.Rect(0, 0, 3, 3),
+			mid:    image.Point{2, 2},
+			radius: 2,
+			start:  0,
+			end:    180,
+			opts: []BrailleCircleOption{
+				BrailleCircleCellOpts(cell.FgColor(cell.ColorRed)),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				bc := testbraille.MustNew(ft.Area())
+
+				opts := []cell.Option{cell.FgColor(cell.ColorRed)}
+				testbraille.MustSetPixel(bc, image.Point{1, 0}, opts...)
+				testbraille.MustSetPixel(bc, image.Point{2, 0}, opts...)
+				testbraille.MustSetPixel(bc, image.Point{3, 0}, opts...)
+				testbraille.MustSetPixel(bc, image.Point{0, 1}, opts...)
+				testbraille.MustSetPixel(bc, image.Point{4, 1}, opts...)
+				testbraille.MustSetPixel(bc, image.Point{0, 2}, opts...)
+				testbraille.MustSetPixel(bc, image.Point{4, 2}, opts...)
+
+				testbraille.MustApply(bc, ft)
+				return ft
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			bc, err := braille.New(tc.canvas)
+			if err != nil {
+				t.Fatalf("braille.New => unexpected error: %v", err)
+			}
+
+			err = BrailleArc(bc, tc.mid, tc.radius, tc.start, tc.end, tc.opts...)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("BrailleArc => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			size := area.Size(tc.canvas)
+			want := faketerm.MustNew(size)
+			if tc.want != nil {
+				want = tc.want(size)
+			}
+
+			got, err := faketerm.New(size)
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			if err := bc.Apply(got); err != nil {
+				t.Fatalf("bc.Apply => unexpected error: %v", err)
+			}
+			if diff := faketerm.Diff(want, got); diff != "" {
+				t.Fatalf("BrailleArc => %v", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ package draw
 // braille_line.go contains code that draws lines on a braille canvas.
 
 import (
+	"errors"
 	"fmt"
 	"image"
 
@@ -115,20 +116,69 @@ func BrailleLine(bc *braille.Canvas, start, end image.Point, opts ...BrailleLine
 
 	points := brailleLinePoints(start, end)
 	for _, p := range points {
-		switch opt.pixelChange {
-		case braillePixelChangeSet:
-			if err := bc.SetPixel(p, opt.cellOpts...); err != nil {
-				return fmt.Errorf("bc.SetPixel(%v) => %v", p, err)
+		if err := changePixel(bc, p, opt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BraillePolyline draws a path of approximated line segments on the braille
+// canvas that connect the provided points in order. The pixel shared by two
+// consecutive segments is only changed once, so this is equivalent to but
+// faster than calling BrailleLine for each pair of consecutive points.
+// All the points must be valid points within the canvas and at least one
+// point must be provided. A single point sets only one pixel.
+// Accepts the same options as BrailleLine.
+func BraillePolyline(bc *braille.Canvas, points []image.Point, opts ...BrailleLineOption) error {
+	if len(points) == 0 {
+		return errors.New("at least one point must be provided")
+	}
+	ar := bc.Area()
+	for i, p := range points {
+		if !p.In(ar) {
+			return fmt.Errorf("point[%d] %v falls outside of the canvas area %v", i, p, ar)
+		}
+	}
+
+	opt := newBrailleLineOptions()
+	for _, o := range opts {
+		o.set(opt)
+	}
+
+	if len(points) == 1 {
+		return changePixel(bc, points[0], opt)
+	}
+	for i := 1; i < len(points); i++ {
+		start, end := points[i-1], points[i]
+		for _, p := range brailleLinePoints(start, end) {
+			if i > 1 && p == start {
+				// Changed as the end of the previous segment.
+				continue
 			}
-		case braillePixelChangeClear:
-			if err := bc.ClearPixel(p, opt.cellOpts...); err != nil {
-				return fmt.Errorf("bc.ClearPixel(%v) => %v", p, err)
+			if err := changePixel(bc, p, opt); err != nil {
+				return err
 			}
 		}
 	}
 	return nil
 }
 
+// changePixel sets or clears the pixel according to the options.
+func changePixel(bc *braille.Canvas, p image.Point, opt *brailleLineOptions) error {
+	switch opt.pixelChange {
+	case braillePixelChangeSet:
+		if err := bc.SetPixel(p, opt.cellOpts...); err != nil {
+			return fmt.Errorf("bc.SetPixel(%v) => %v", p, err)
+		}
+	case braillePixelChangeClear:
+		if err := bc.ClearPixel(p, opt.cellOpts...); err != nil {
+			return fmt.Errorf("bc.ClearPixel(%v) => %v", p, err)
+		}
+	}
+	return nil
+}
+
 // brailleLinePoints returns the points to set when drawing the line.
 func brailleLinePoints(start, end image.Point) []image.Point {
 	// Implements Bresenham's line algorithm.
@@ -455,3 +455,137 @@ func TestBrailleLine(t *testing.T) {
 		})
 	}
 }
+
+func TestBraillePolyline(t *testing.T) {
+	tests := []struct {
+		desc   string
+		canvas image.Rectangle
+		points []image.Point
+
+		// If not nil, called to prepare the braille canvas before running the test.
+		prepare func(*braille.Canvas) error
+
+		opts    []BrailleLineOption
+		wantErr bool
+	}{
+		{
+			desc:    "fails without points",
+			canvas:  image.Rect(0, 0, 3, 2),
+			wantErr: true,
+		},
+		{
+			desc:    "fails on a negative point",
+			canvas:  image.Rect(0, 0, 3, 2),
+			points:  []image.Point{{0, 0}, {-1, 2}},
+			wantErr: true,
+		},
+		{
+			desc:    "fails on a point outside of the canvas",
+			canvas:  image.Rect(0, 0, 3, 2),
+			points:  []image.Point{{0, 0}, {5, 7}, {6, 8}},
+			wantErr: true,
+		},
+		{
+			desc:   "single point",
+			canvas: image.Rect(0, 0, 3, 2),
+			points: []image.Point{{2, 3}},
+		},
+		{
+			desc:   "two points",
+			canvas: image.Rect(0, 0, 3, 2),
+			points: []image.Point{{0, 0}, {5, 7}},
+		},
+		{
+			desc:   "connected path",
+			canvas: image.Rect(0, 0, 5, 3),
+			points: []image.Point{{0, 11}, {3, 0}, {6, 8}, {9, 8}, {9, 2}},
+		},
+		{
+			desc:   "path returning to its start",
+			canvas: image.Rect(0, 0, 5, 3),
+			points: []image.Point{{0, 0}, {9, 0}, {9, 11}, {0, 0}},
+		},
+		{
+			desc:   "path with cell options",
+			canvas: image.Rect(0, 0, 5, 3),
+			points: []image.Point{{0, 11}, {4, 4}, {9, 11}},
+			opts: []BrailleLineOption{
+				BrailleLineCellOpts(cell.FgColor(cell.ColorRed)),
+			},
+		},
+		{
+			desc:   "clears the pixels of the path",
+			canvas: image.Rect(0, 0, 5, 3),
+			points: []image.Point{{0, 11}, {4, 4}, {9, 11}},
+			prepare: func(bc *braille.Canvas) error {
+				for x := 0; x < 10; x++ {
+					for y := 0; y < 12; y++ {
+						if err := bc.SetPixel(image.Point{x, y}); err != nil {
+							return err
+						}
+					}
+				}
+				return nil
+			},
+			opts: []BrailleLineOption{
+				BrailleLineClearPixels(),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			bc, err := braille.New(tc.canvas)
+			if err != nil {
+				t.Fatalf("braille.New => unexpected error: %v", err)
+			}
+			if tc.prepare != nil {
+				if err := tc.prepare(bc); err != nil {
+					t.Fatalf("tc.prepare => unexpected error: %v", err)
+				}
+			}
+
+			err = BraillePolyline(bc, tc.points, tc.opts...)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("BraillePolyline => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			// The polyline is equivalent to a line between each pair of
+			// consecutive points.
+			wantBC, err := braille.New(tc.canvas)
+			if err != nil {
+				t.Fatalf("braille.New => unexpected error: %v", err)
+			}
+			if tc.prepare != nil {
+				if err := tc.prepare(wantBC); err != nil {
+					t.Fatalf("tc.prepare => unexpected error: %v", err)
+				}
+			}
+			for i := range tc.points {
+				start := tc.points[0]
+				if i > 0 {
+					start = tc.points[i-1]
+				}
+				if err := BrailleLine(wantBC, start, tc.points[i], tc.opts...); err != nil {
+					t.Fatalf("BrailleLine => unexpected error: %v", err)
+				}
+			}
+
+			size := area.Size(tc.canvas)
+			want := faketerm.MustNew(size)
+			if err := wantBC.Apply(want); err != nil {
+				t.Fatalf("wantBC.Apply => unexpected error: %v", err)
+			}
+			got := faketerm.MustNew(size)
+			if err := bc.Apply(got); err != nil {
+				t.Fatalf("bc.Apply => unexpected error: %v", err)
+			}
+			if diff := faketerm.Diff(want, got); diff != "" {
+				t.Fatalf("BraillePolyline => %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package draw
+
+// gradient.go fills an area with a linear color gradient.
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/private/canvas"
+)
+
+// GradientOption is used to provide options to the Gradient function.
+type GradientOption interface {
+	// set sets the provided option.
+	set(*gradientOptions)
+}
+
+// gradientOptions stores the provided options.
+type gradientOptions struct {
+	cellOpts []cell.Option
+	char     rune
+	vertical bool
+}
+
+// gradientOption implements GradientOption.
+type gradientOption func(gOpts *gradientOptions)
+
+// set implements GradientOption.set.
+func (gro gradientOption) set(gOpts *gradientOptions) {
+	gro(gOpts)
+}
+
+// GradientCellOpts sets options on the cells that create the gradient.
+// Any background color set here is overridden by the gradient.
+func GradientCellOpts(opts ...cell.Option) GradientOption {
+	return gradientOption(func(gOpts *gradientOptions) {
+		gOpts.cellOpts = append(gOpts.cellOpts, opts...)
+	})
+}
+
+// DefaultGradientChar is the default value for the GradientChar option.
+const DefaultGradientChar = ' '
+
+// GradientChar sets the character used in each of the cells of the gradient.
+func GradientChar(c rune) GradientOption {
+	return gradientOption(func(gOpts *gradientOptions) {
+		gOpts.char = c
+	})
+}
+
+// GradientVertical makes the gradient go from the top to the bottom of the
+// area. By default the gradient goes from the left to the right.
+func GradientVertical() GradientOption {
+	return gradientOption(func(gOpts *gradientOptions) {
+		gOpts.vertical = true
+	})
+}
+
+// Gradient fills the area on the canvas with a linear gradient of background
+// colors. The first column (or row when GradientVertical is provided) has the
+// from color, the last one has the to color and the ones in between get colors
+// blended using cell.Blend.
+func Gradient(c *canvas.Canvas, r image.Rectangle, from, to cell.Color, opts ...GradientOption) error {
+	opt := &gradientOptions{
+		char: DefaultGradientChar,
+	}
+	for _, o := range opts {
+		o.set(opt)
+	}
+
+	if ar := c.Area(); !r.In(ar) {
+		return fmt.Errorf("the requested area %v doesn't fit the canvas area %v", r, ar)
+	}
+
+	if r.Dx() < 1 || r.Dy() < 1 {
+		return fmt.Errorf("the area must be at least 1x1 cell, got %v", r)
+	}
+
+	steps := r.Dx()
+	if opt.vertical {
+		steps = r.Dy()
+	}
+
+	for col := r.Min.X; col < r.Max.X; col++ {
+		for row := r.Min.Y; row < r.Max.Y; row++ {
+			step := col - r.Min.X
+			if opt.vertical {
+				step = row - r.Min.Y
+			}
+			var t float64
+			if steps > 1 {
+				t = float64(step) / float64(steps-1)
+			}
+
+			cellOpts := append([]cell.Option{}, opt.cellOpts...)
+			cellOpts = append(cellOpts, cell.BgColor(cell.Blend(from, to, t)))
+			cells, err := c.SetCell(image.Point{col, row}, opt.char, cellOpts...)
+			if err != nil {
+				return err
+			}
+			if cells != 1 {
+				return fmt.Errorf("invalid gradient character %q, this character occupies %d cells, the implementation only supports half-width runes that occupy exactly one cell", opt.char, cells)
+			}
+		}
+	}
+	return nil
+}
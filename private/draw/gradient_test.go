@@ -0,0 +1,181 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package draw
+
+import (
+	"image"
+	"testing"
+
+	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/private/canvas"
+	"github.com/mum4k/termdash/private/canvas/testcanvas"
+	"github.com/mum4k/termdash/private/faketerm"
+)
+
+func TestGradient(t *testing.T) {
+	red := cell.ColorRGB24(255, 0, 0)
+	blue := cell.ColorRGB24(0, 0, 255)
+	// The color in the middle between red and blue, i.e. RGB(128, 0, 128)
+	// approximated by the 6x6x6 color cube.
+	purple := cell.ColorRGB6(2, 0, 2)
+
+	tests := []struct {
+		desc    string
+		canvas  image.Rectangle
+		area    image.Rectangle
+		from    cell.Color
+		to      cell.Color
+		opts    []GradientOption
+		want    func(size image.Point) *faketerm.Terminal
+		wantErr bool
+	}{
+		{
+			desc:    "fails when the area doesn't fit the canvas",
+			canvas:  image.Rect(0, 0, 2, 2),
+			area:    image.Rect(0, 0, 3, 1),
+			from:    red,
+			to:      blue,
+			wantErr: true,
+		},
+		{
+			desc:    "fails when the area is empty",
+			canvas:  image.Rect(0, 0, 2, 2),
+			area:    image.Rect(0, 0, 0, 1),
+			from:    red,
+			to:      blue,
+			wantErr: true,
+		},
+		{
+			desc:   "fails when the character occupies multiple cells",
+			canvas: image.Rect(0, 0, 2, 2),
+			area:   image.Rect(0, 0, 1, 1),
+			from:   red,
+			to:     blue,
+			opts: []GradientOption{
+				GradientChar('界'),
+			},
+			wantErr: true,
+		},
+		{
+			desc:   "single cell gets the from color",
+			canvas: image.Rect(0, 0, 2, 2),
+			area:   image.Rect(0, 0, 1, 1),
+			from:   red,
+			to:     blue,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, ' ', cell.BgColor(red))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "horizontal gradient",
+			canvas: image.Rect(0, 0, 4, 3),
+			area:   image.Rect(1, 1, 4, 3),
+			from:   red,
+			to:     blue,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				for row := 1; row < 3; row++ {
+					testcanvas.MustSetCell(c, image.Point{1, row}, ' ', cell.BgColor(red))
+					testcanvas.MustSetCell(c, image.Point{2, row}, ' ', cell.BgColor(purple))
+					testcanvas.MustSetCell(c, image.Point{3, row}, ' ', cell.BgColor(blue))
+				}
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "vertical gradient",
+			canvas: image.Rect(0, 0, 2, 3),
+			area:   image.Rect(0, 0, 2, 3),
+			from:   red,
+			to:     blue,
+			opts: []GradientOption{
+				GradientVertical(),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				for col := 0; col < 2; col++ {
+					testcanvas.MustSetCell(c, image.Point{col, 0}, ' ', cell.BgColor(red))
+					testcanvas.MustSetCell(c, image.Point{col, 1}, ' ', cell.BgColor(purple))
+					testcanvas.MustSetCell(c, image.Point{col, 2}, ' ', cell.BgColor(blue))
+				}
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "sets the character and cell options, gradient overrides the background",
+			canvas: image.Rect(0, 0, 2, 1),
+			area:   image.Rect(0, 0, 2, 1),
+			from:   red,
+			to:     blue,
+			opts: []GradientOption{
+				GradientChar('x'),
+				GradientCellOpts(
+					cell.FgColor(cell.ColorGreen),
+					cell.BgColor(cell.ColorYellow),
+				),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetCell(c, image.Point{0, 0}, 'x', cell.FgColor(cell.ColorGreen), cell.BgColor(red))
+				testcanvas.MustSetCell(c, image.Point{1, 0}, 'x', cell.FgColor(cell.ColorGreen), cell.BgColor(blue))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			c, err := canvas.New(tc.canvas)
+			if err != nil {
+				t.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+
+			err = Gradient(c, tc.area, tc.from, tc.to, tc.opts...)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Gradient => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			got, err := faketerm.New(c.Size())
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+
+			if err := c.Apply(got); err != nil {
+				t.Fatalf("Apply => unexpected error: %v", err)
+			}
+
+			if diff := faketerm.Diff(tc.want(c.Size()), got); diff != "" {
+				t.Errorf("Gradient => %v", diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"image"
 
+	"github.com/mum4k/termdash/cell"
 	"github.com/mum4k/termdash/private/canvas"
 	"github.com/mum4k/termdash/private/canvas/braille"
 	"github.com/mum4k/termdash/private/draw"
@@ -52,6 +53,13 @@ func MustRectangle(c *canvas.Canvas, r image.Rectangle, opts ...draw.RectangleOp
 	}
 }
 
+// MustGradient fills the area with a color gradient or panics.
+func MustGradient(c *canvas.Canvas, r image.Rectangle, from, to cell.Color, opts ...draw.GradientOption) {
+	if err := draw.Gradient(c, r, from, to, opts...); err != nil {
+		panic(fmt.Sprintf("draw.Gradient => unexpected error: %v", err))
+	}
+}
+
 // MustHVLines draws the vertical / horizontal lines or panics.
 func MustHVLines(c *canvas.Canvas, lines []draw.HVLine, opts ...draw.HVLineOption) {
 	if err := draw.HVLines(c, lines, opts...); err != nil {
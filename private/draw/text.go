@@ -149,6 +149,11 @@ func TrimText(text string, maxCells int, om OverrunMode) (string, error) {
 }
 
 // Text prints the provided text on the canvas starting at the provided point.
+//
+// Each rune advances the position by its width as reported by
+// runewidth.RuneWidth. A full-width rune occupies two cells, the second of
+// which is reserved so that no stale content is left behind it. Runes of zero
+// width, like combining marks, don't occupy any cells and are skipped.
 func Text(c *canvas.Canvas, text string, start image.Point, opts ...TextOption) error {
 	ar := c.Area()
 	if !start.In(ar) {
@@ -179,6 +184,16 @@ func Text(c *canvas.Canvas, text string, start image.Point, opts ...TextOption)
 
 	cur := start
 	for _, r := range trimmed {
+		rw := runewidth.RuneWidth(r)
+		if rw == 0 {
+			continue
+		}
+		if rw > 1 {
+			if err := reserveCells(c, cur, rw); err != nil {
+				return err
+			}
+		}
+
 		cells, err := c.SetCell(cur, r, opt.cellOpts...)
 		if err != nil {
 			return err
@@ -188,6 +203,27 @@ func Text(c *canvas.Canvas, text string, start image.Point, opts ...TextOption)
 	return nil
 }
 
+// reserveCells clears the cells that will be occupied by a full-width rune of
+// the specified width drawn at the point. This ensures that the cells
+// following the rune don't hold any stale content, which would otherwise be
+// interpreted as a part of the rune or make the following cell a partial one.
+func reserveCells(c *canvas.Canvas, p image.Point, width int) error {
+	if rem := c.Area().Max.X - p.X; width > rem {
+		return fmt.Errorf("cannot draw rune of width %d at point %v, only have %d remaining cells at this line", width, p, rem)
+	}
+	// Replace any full-width rune at the point first, the cells it occupies
+	// can't be set directly.
+	if _, err := c.SetCell(p, ' '); err != nil {
+		return err
+	}
+	for i := 1; i < width; i++ {
+		if _, err := c.SetCell(image.Point{p.X + i, p.Y}, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ResizeNeeded draws an unicode character indicating that the canvas size is
 // too small to draw meaningful content.
 func ResizeNeeded(cvs *canvas.Canvas) error {
@@ -18,6 +18,7 @@ import (
 	"image"
 	"testing"
 
+	"github.com/kylelemons/godebug/pretty"
 	"github.com/mum4k/termdash/cell"
 	"github.com/mum4k/termdash/private/canvas"
 	"github.com/mum4k/termdash/private/canvas/testcanvas"
@@ -602,6 +603,111 @@ func TestText(t *testing.T) {
 	}
 }
 
+func TestTextCells(t *testing.T) {
+	tests := []struct {
+		desc  string
+		width int
+		// before is text drawn at the start of the row before the tested text.
+		before string
+		text   string
+		start  image.Point
+		opts   []TextOption
+		// want are the runes in the cells of the row after drawing.
+		want    []rune
+		wantErr bool
+	}{
+		{
+			desc:  "mixes ASCII, CJK and emoji",
+			width: 7,
+			text:  "a世😀b",
+			want:  []rune{'a', '世', 0, '😀', 0, 'b', 0},
+		},
+		{
+			desc:  "skips zero-width runes",
+			width: 3,
+			text:  "e\u0301x",
+			want:  []rune{'e', 'x', 0},
+		},
+		{
+			desc:   "reserves the cell after a full-width rune drawn over one",
+			width:  5,
+			before: "a世界",
+			text:   "世",
+			want:   []rune{'世', 0, 0, '界', 0},
+		},
+		{
+			desc:   "reserves the cell after a full-width rune drawn over a partial cell",
+			width:  4,
+			before: "世界",
+			text:   "b世",
+			want:   []rune{'b', '世', 0, 0},
+		},
+		{
+			desc:  "OverrunModeTrim doesn't cut a full-width rune at the boundary",
+			width: 4,
+			text:  "a世",
+			opts: []TextOption{
+				TextMaxX(2),
+				TextOverrunMode(OverrunModeTrim),
+			},
+			want: []rune{'a', 0, 0, 0},
+		},
+		{
+			desc:  "OverrunModeThreeDot replaces an emoji at the boundary",
+			width: 4,
+			text:  "ab😀c",
+			opts: []TextOption{
+				TextOverrunMode(OverrunModeThreeDot),
+			},
+			want: []rune{'a', 'b', '…', 0},
+		},
+		{
+			desc:  "OverrunModeStrict fails when an emoji crosses the boundary",
+			width: 4,
+			text:  "ab😀",
+			start: image.Point{1, 0},
+			opts: []TextOption{
+				TextOverrunMode(OverrunModeStrict),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			c, err := canvas.New(image.Rect(0, 0, tc.width, 1))
+			if err != nil {
+				t.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+			if tc.before != "" {
+				if err := Text(c, tc.before, image.Point{0, 0}); err != nil {
+					t.Fatalf("Text(before) => unexpected error: %v", err)
+				}
+			}
+
+			err = Text(c, tc.text, tc.start, tc.opts...)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Text => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			var got []rune
+			for x := 0; x < tc.width; x++ {
+				cell, err := c.Cell(image.Point{x, 0})
+				if err != nil {
+					t.Fatalf("Cell => unexpected error: %v", err)
+				}
+				got = append(got, cell.Rune)
+			}
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("Text => unexpected cells (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestResizeNeeded(t *testing.T) {
 	tests := []struct {
 		desc   string
@@ -20,6 +20,7 @@ import (
 	"context"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/mum4k/termdash/private/event/eventqueue"
 	"github.com/mum4k/termdash/terminal/terminalapi"
@@ -35,6 +36,7 @@ type Callback func(terminalapi.Event)
 // queue is a queue of terminal events.
 type queue interface {
 	Push(e terminalapi.Event)
+	Pop() terminalapi.Event
 	Pull(ctx context.Context) terminalapi.Event
 	Close()
 }
@@ -51,6 +53,10 @@ type subscriber struct {
 	// queue is a queue of events towards the subscriber.
 	queue queue
 
+	// quiet if positive is the period without new events after which the
+	// most recent event of a burst is delivered, see Coalesce.
+	quiet time.Duration
+
 	// cancel when called terminates the goroutine that forwards events towards
 	// this subscriber.
 	cancel context.CancelFunc
@@ -82,6 +88,7 @@ func newSubscriber(filter []terminalapi.Event, cb Callback, opts *subscribeOptio
 		cb:     cb,
 		filter: f,
 		queue:  q,
+		quiet:  opts.quiet,
 		cancel: cancel,
 	}
 
@@ -106,6 +113,9 @@ func (s *subscriber) callback(ev terminalapi.Event) {
 func (s *subscriber) run(ctx context.Context) {
 	for {
 		ev := s.queue.Pull(ctx)
+		if ev != nil && s.quiet > 0 {
+			ev = s.latest(ctx, ev)
+		}
 		if ev != nil {
 			s.callback(ev)
 		}
@@ -118,6 +128,31 @@ func (s *subscriber) run(ctx context.Context) {
 	}
 }
 
+// latest coalesces a burst of events that starts with the provided event.
+// Waits until no new events arrive for the quiet period and returns the most
+// recent one. Returns nil if the context expires.
+func (s *subscriber) latest(ctx context.Context, ev terminalapi.Event) terminalapi.Event {
+	t := time.NewTimer(s.quiet)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+
+		var newer bool
+		for e := s.queue.Pop(); e != nil; e = s.queue.Pop() {
+			ev = e
+			newer = true
+		}
+		if !newer {
+			return ev
+		}
+		t.Reset(s.quiet)
+	}
+}
+
 // event forwards an event to the subscriber.
 func (s *subscriber) event(ev terminalapi.Event) {
 	if len(s.filter) == 0 {
@@ -197,6 +232,7 @@ type SubscribeOption interface {
 type subscribeOptions struct {
 	throttle bool
 	maxRep   int
+	quiet    time.Duration
 }
 
 // subscribeOption implements Option.
@@ -218,6 +254,20 @@ func MaxRepetitive(maxRep int) SubscribeOption {
 	})
 }
 
+// Coalesce when provided, instructs the system to coalesce bursts of events
+// towards the subscriber. Instead of delivering each event of a burst, only
+// the most recent event is delivered once no new events arrived for the quiet
+// period. This protects subscribers with expensive callbacks from high
+// frequency events like resizes or mouse movement.
+// Coalescing applies to all the events the subscriber receives, subscribe
+// separately to the events that must not be coalesced.
+// Coalesced events that weren't delivered don't count as processed.
+func Coalesce(quiet time.Duration) SubscribeOption {
+	return subscribeOption(func(sOpts *subscribeOptions) {
+		sOpts.quiet = quiet
+	})
+}
+
 // Subscribe subscribes to events according to the filter.
 // An empty filter indicates that the subscriber wishes to receive events of
 // all kinds. If the filter is non-empty, only events of the provided type will
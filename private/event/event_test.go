@@ -291,6 +291,42 @@ func TestDistributionSystem(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "coalesces a burst of events into the most recent one",
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{0, 0}},
+				&terminalapi.Mouse{Position: image.Point{1, 1}},
+				&terminalapi.Mouse{Position: image.Point{2, 2}},
+				&terminalapi.Mouse{Position: image.Point{3, 3}},
+				&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+			},
+			subCase: []*subscriberCase{
+				{
+					filter: []terminalapi.Event{
+						&terminalapi.Mouse{},
+					},
+					opts: []SubscribeOption{
+						Coalesce(100 * time.Millisecond),
+					},
+					rec: newReceiver(receiverModeReceive),
+					want: map[terminalapi.Event]bool{
+						&terminalapi.Mouse{Position: image.Point{3, 3}}: true,
+					},
+				},
+				{
+					filter: []terminalapi.Event{
+						&terminalapi.Mouse{},
+					},
+					rec: newReceiver(receiverModeReceive),
+					want: map[terminalapi.Event]bool{
+						&terminalapi.Mouse{Position: image.Point{0, 0}}: true,
+						&terminalapi.Mouse{Position: image.Point{1, 1}}: true,
+						&terminalapi.Mouse{Position: image.Point{2, 2}}: true,
+						&terminalapi.Mouse{Position: image.Point{3, 3}}: true,
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -423,3 +459,49 @@ func TestProcessed(t *testing.T) {
 		})
 	}
 }
+
+func TestCoalesceBursts(t *testing.T) {
+	t.Parallel()
+
+	eds := NewDistributionSystem()
+	rec := newReceiver(receiverModeReceive)
+	stop := eds.Subscribe(nil, rec.receive, Coalesce(100*time.Millisecond))
+	defer stop()
+
+	bursts := [][]terminalapi.Event{
+		{
+			&terminalapi.Resize{Size: image.Point{1, 1}},
+			&terminalapi.Resize{Size: image.Point{2, 2}},
+			&terminalapi.Resize{Size: image.Point{3, 3}},
+		},
+		{
+			&terminalapi.Resize{Size: image.Point{4, 4}},
+			&terminalapi.Resize{Size: image.Point{5, 5}},
+		},
+	}
+	for i, burst := range bursts {
+		for _, ev := range burst {
+			eds.Event(ev)
+		}
+
+		want := i + 1
+		if err := testevent.WaitFor(5*time.Second, func() error {
+			if got := eds.Processed(); got != want {
+				return fmt.Errorf("got %d processed events, want %d", got, want)
+			}
+			return nil
+		}); err != nil {
+			t.Fatalf("testevent.WaitFor burst[%d] => %v", i, err)
+		}
+	}
+
+	want := []terminalapi.Event{
+		&terminalapi.Resize{Size: image.Point{3, 3}},
+		&terminalapi.Resize{Size: image.Point{5, 5}},
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if diff := pretty.Compare(want, rec.events); diff != "" {
+		t.Errorf("received events => unexpected diff (-want, +got):\n%s", diff)
+	}
+}
@@ -70,6 +70,49 @@ func TestDiff(t *testing.T) {
 			}(),
 			wantDiff: true,
 		},
+		{
+			desc: "no diff when the default color is set explicitly",
+			term1: func() *Terminal {
+				t := MustNew(image.Point{2, 2})
+				t.SetCell(image.Point{0, 0}, 'a', cell.FgColor(cell.ColorDefault), cell.BgColor(cell.ColorDefault))
+				return t
+			}(),
+			term2: func() *Terminal {
+				t := MustNew(image.Point{2, 2})
+				t.SetCell(image.Point{0, 0}, 'a')
+				return t
+			}(),
+			wantDiff: false,
+		},
+		{
+			desc: "default color resets a previously set color",
+			term1: func() *Terminal {
+				t := MustNew(image.Point{2, 2})
+				t.SetCell(image.Point{0, 0}, 'a', cell.FgColor(cell.ColorRed))
+				t.SetCell(image.Point{0, 0}, 'a', cell.FgColor(cell.ColorDefault))
+				return t
+			}(),
+			term2: func() *Terminal {
+				t := MustNew(image.Point{2, 2})
+				t.SetCell(image.Point{0, 0}, 'a')
+				return t
+			}(),
+			wantDiff: false,
+		},
+		{
+			desc: "reports diff between the default color and black",
+			term1: func() *Terminal {
+				t := MustNew(image.Point{2, 2})
+				t.SetCell(image.Point{0, 0}, 'a', cell.FgColor(cell.ColorDefault))
+				return t
+			}(),
+			term2: func() *Terminal {
+				t := MustNew(image.Point{2, 2})
+				t.SetCell(image.Point{0, 0}, 'a', cell.FgColor(cell.ColorBlack))
+				return t
+			}(),
+			wantDiff: true,
+		},
 	}
 
 	for _, tc := range tests {
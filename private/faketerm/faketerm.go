@@ -19,7 +19,6 @@ import (
 	"context"
 	"fmt"
 	"image"
-	"log"
 	"strings"
 	"sync"
 
@@ -52,6 +51,19 @@ func WithEventQueue(eq *eventqueue.Unbound) Option {
 	})
 }
 
+// WithClearStyle sets the colors the cells take when the terminal is cleared
+// and the colors that replace cell.ColorDefault in the cells set on the
+// terminal, like the ClearStyle option of the tcell terminal does.
+// If not provided, the cells keep cell.ColorDefault.
+func WithClearStyle(fg, bg cell.Color) Option {
+	return option(func(t *Terminal) {
+		t.clearStyle = &cell.Options{
+			FgColor: fg,
+			BgColor: bg,
+		}
+	})
+}
+
 // Terminal is a fake terminal.
 // This implementation is thread-safe.
 type Terminal struct {
@@ -61,10 +73,28 @@ type Terminal struct {
 	// events is a queue of input events.
 	events *eventqueue.Unbound
 
-	// mu protects the buffer.
+	// capabilities are the capabilities reported by the terminal.
+	capabilities terminalapi.Capabilities
+
+	// cursor is the position of the cursor or nil if the cursor is hidden.
+	cursor *image.Point
+
+	// clearStyle are the default colors of the cells, nil if not provided.
+	clearStyle *cell.Options
+
+	// mu protects the buffer, the cursor and the events.
 	mu sync.Mutex
 }
 
+// WithCapabilities sets the capabilities reported by the terminal.
+// If not provided, the terminal reports the zero value of
+// terminalapi.Capabilities.
+func WithCapabilities(c terminalapi.Capabilities) Option {
+	return option(func(t *Terminal) {
+		t.capabilities = c
+	})
+}
+
 // New returns a new fake Terminal.
 func New(size image.Point, opts ...Option) (*Terminal, error) {
 	b, err := buffer.New(size)
@@ -78,6 +108,9 @@ func New(size image.Point, opts ...Option) (*Terminal, error) {
 	for _, opt := range opts {
 		opt.set(t)
 	}
+	if t.clearStyle != nil {
+		t.clearBuffer()
+	}
 	return t, nil
 }
 
@@ -102,6 +135,7 @@ func (t *Terminal) Resize(size image.Point) error {
 	}
 
 	t.buffer = b
+	t.clearBuffer()
 	return nil
 }
 
@@ -161,22 +195,69 @@ func (t *Terminal) Clear(opts ...cell.Option) error {
 		return err
 	}
 	t.buffer = b
+	t.clearBuffer(opts...)
 	return nil
 }
 
+// cellOpts returns the options for a cell with the colors left at
+// cell.ColorDefault replaced by the ones from the clear style.
+func (t *Terminal) cellOpts(opts ...cell.Option) *cell.Options {
+	o := cell.NewOptions(opts...)
+	if t.clearStyle == nil {
+		return o
+	}
+	res := cell.NewOptions(t.clearStyle)
+	res.Merge(o)
+	return res
+}
+
+// clearBuffer sets the options of all the cells in the buffer to the
+// provided options on top of the clear style.
+// The caller must hold the mutex.
+func (t *Terminal) clearBuffer(opts ...cell.Option) {
+	if t.clearStyle == nil && len(opts) == 0 {
+		return // Cells of a new buffer already have the default options.
+	}
+	for col := range t.buffer {
+		for row := range t.buffer[col] {
+			t.buffer[col][row].Opts = t.cellOpts(opts...)
+		}
+	}
+}
+
 // Flush implements terminalapi.Terminal.Flush.
 func (t *Terminal) Flush() error {
 	return nil // nowhere to flush to.
 }
 
+// Capabilities implements terminalapi.CapabilitiesReporter.Capabilities.
+func (t *Terminal) Capabilities() terminalapi.Capabilities {
+	return t.capabilities
+}
+
 // SetCursor implements terminalapi.Terminal.SetCursor.
 func (t *Terminal) SetCursor(p image.Point) {
-	log.Fatal("unimplemented")
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.cursor = &p
 }
 
 // HideCursor implements terminalapi.Terminal.HideCursor.
 func (t *Terminal) HideCursor() {
-	log.Fatal("unimplemented")
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.cursor = nil
+}
+
+// Cursor returns the position of the cursor and true if the cursor is
+// visible, i.e. SetCursor was called after the last call to HideCursor.
+func (t *Terminal) Cursor() (image.Point, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.cursor == nil {
+		return image.ZP, false
+	}
+	return *t.cursor, true
 }
 
 // SetCell implements terminalapi.Terminal.SetCell.
@@ -187,16 +268,36 @@ func (t *Terminal) SetCell(p image.Point, r rune, opts ...cell.Option) error {
 	if _, err := t.buffer.SetCell(p, r, opts...); err != nil {
 		return err
 	}
+	c := t.buffer[p.X][p.Y]
+	c.Opts = t.cellOpts(c.Opts)
 	return nil
 }
 
+// Inject enqueues the provided events. Each of them is returned by one of the
+// subsequent calls to Event in the provided order. Creates the event queue if
+// it wasn't provided via the WithEventQueue option.
+func (t *Terminal) Inject(events ...terminalapi.Event) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.events == nil {
+		t.events = eventqueue.New()
+	}
+	for _, ev := range events {
+		t.events.Push(ev)
+	}
+}
+
 // Event implements terminalapi.Terminal.Event.
 func (t *Terminal) Event(ctx context.Context) terminalapi.Event {
-	if t.events == nil {
-		return terminalapi.NewErrorf("no event queue provided, use the WithEventQueue option when creating the fake terminal")
+	t.mu.Lock()
+	events := t.events
+	t.mu.Unlock()
+	if events == nil {
+		return terminalapi.NewErrorf("no event queue provided, use the WithEventQueue option when creating the fake terminal or call Inject")
 	}
 
-	ev := t.events.Pull(ctx)
+	ev := events.Pull(ctx)
 	if ev == nil {
 		return nil
 	}
@@ -0,0 +1,128 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faketerm
+
+import (
+	"image"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/mum4k/termdash/cell"
+)
+
+func TestWithClearStyle(t *testing.T) {
+	tests := []struct {
+		desc string
+		opts []Option
+		// do is called on the terminal before checking its cells.
+		do   func(*Terminal) error
+		want map[image.Point]*cell.Options
+	}{
+		{
+			desc: "cells keep the default colors without the option",
+			do: func(ft *Terminal) error {
+				return ft.Clear()
+			},
+			want: map[image.Point]*cell.Options{
+				{0, 0}: {},
+				{1, 0}: {},
+			},
+		},
+		{
+			desc: "new terminal has the colors",
+			opts: []Option{WithClearStyle(cell.ColorRed, cell.ColorBlue)},
+			want: map[image.Point]*cell.Options{
+				{0, 0}: {FgColor: cell.ColorRed, BgColor: cell.ColorBlue},
+				{1, 0}: {FgColor: cell.ColorRed, BgColor: cell.ColorBlue},
+			},
+		},
+		{
+			desc: "cleared cells carry the colors",
+			opts: []Option{WithClearStyle(cell.ColorRed, cell.ColorBlue)},
+			do: func(ft *Terminal) error {
+				if err := ft.SetCell(image.Point{0, 0}, 'x', cell.BgColor(cell.ColorGreen)); err != nil {
+					return err
+				}
+				return ft.Clear()
+			},
+			want: map[image.Point]*cell.Options{
+				{0, 0}: {FgColor: cell.ColorRed, BgColor: cell.ColorBlue},
+				{1, 0}: {FgColor: cell.ColorRed, BgColor: cell.ColorBlue},
+			},
+		},
+		{
+			desc: "options provided to clear take precedence",
+			opts: []Option{WithClearStyle(cell.ColorRed, cell.ColorBlue)},
+			do: func(ft *Terminal) error {
+				return ft.Clear(cell.BgColor(cell.ColorGreen))
+			},
+			want: map[image.Point]*cell.Options{
+				{0, 0}: {FgColor: cell.ColorRed, BgColor: cell.ColorGreen},
+				{1, 0}: {FgColor: cell.ColorRed, BgColor: cell.ColorGreen},
+			},
+		},
+		{
+			desc: "resized terminal has the colors",
+			opts: []Option{WithClearStyle(cell.ColorRed, cell.ColorBlue)},
+			do: func(ft *Terminal) error {
+				return ft.Resize(image.Point{3, 1})
+			},
+			want: map[image.Point]*cell.Options{
+				{0, 0}: {FgColor: cell.ColorRed, BgColor: cell.ColorBlue},
+				{1, 0}: {FgColor: cell.ColorRed, BgColor: cell.ColorBlue},
+				{2, 0}: {FgColor: cell.ColorRed, BgColor: cell.ColorBlue},
+			},
+		},
+		{
+			desc: "default colors of set cells are replaced",
+			opts: []Option{WithClearStyle(cell.ColorRed, cell.ColorBlue)},
+			do: func(ft *Terminal) error {
+				if err := ft.SetCell(image.Point{0, 0}, 'x', &cell.Options{Bold: true}); err != nil {
+					return err
+				}
+				return ft.SetCell(image.Point{1, 0}, 'y', cell.FgColor(cell.ColorGreen))
+			},
+			want: map[image.Point]*cell.Options{
+				{0, 0}: {FgColor: cell.ColorRed, BgColor: cell.ColorBlue, Bold: true},
+				{1, 0}: {FgColor: cell.ColorGreen, BgColor: cell.ColorBlue},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ft, err := New(image.Point{2, 1}, tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if tc.do != nil {
+				if err := tc.do(ft); err != nil {
+					t.Fatalf("do => unexpected error: %v", err)
+				}
+			}
+
+			got := map[image.Point]*cell.Options{}
+			b := ft.BackBuffer()
+			for col := range b {
+				for row := range b[col] {
+					got[image.Point{col, row}] = b[col][row].Opts
+				}
+			}
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("cell options => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
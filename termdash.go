@@ -61,9 +61,22 @@ func RedrawInterval(t time.Duration) Option {
 	})
 }
 
+// RedrawOnChangeOnly indicates that termdash should only flush the terminal
+// when the content drawn by the container and its widgets changed since the
+// last redraw. This reduces the CPU usage of mostly static dashboards.
+// The terminal is still always flushed after it was resized and when the
+// redraw is triggered via the Controller.
+func RedrawOnChangeOnly() Option {
+	return option(func(td *termdash) {
+		td.redrawOnChangeOnly = true
+	})
+}
+
 // ErrorHandler is used to provide a function that will be called with all
 // errors that occur while the dashboard is running. If not provided, any
 // errors panic the application.
+// If provided, the function is also called when redrawing gets suspended,
+// because the terminal has zero or negative size, e.g. when it was detached.
 // The provided function must be thread-safe.
 func ErrorHandler(f func(error)) Option {
 	return option(func(td *termdash) {
@@ -71,6 +84,30 @@ func ErrorHandler(f func(error)) Option {
 	})
 }
 
+// FrameStats are timings of a single frame drawn by termdash.
+type FrameStats struct {
+	// Draw is how long it took to draw the container and all its widgets.
+	Draw time.Duration
+	// Flush is how long it took to flush the content to the terminal.
+	// Zero if the terminal wasn't flushed.
+	Flush time.Duration
+	// Flushed indicates if the terminal was flushed. This is false when the
+	// flush was skipped due to the RedrawOnChangeOnly option.
+	Flushed bool
+}
+
+// FrameStatsSubscriber registers a function that receives timings of each
+// frame drawn by termdash. Useful to tune the RedrawInterval and to find slow
+// widgets. Frames aren't timed unless this option is provided.
+// The function is called synchronously after each frame, so it should return
+// quickly and it must not call Controller.Redraw.
+// The provided function must be thread-safe.
+func FrameStatsSubscriber(f func(*FrameStats)) Option {
+	return option(func(td *termdash) {
+		td.frameStatsSubscriber = f
+	})
+}
+
 // KeyboardSubscriber registers a subscriber for Keyboard events. Each
 // keyboard event is forwarded to the container and the registered subscriber.
 // The provided function must be thread-safe.
@@ -89,6 +126,16 @@ func MouseSubscriber(f func(*terminalapi.Mouse)) Option {
 	})
 }
 
+// ResizeSubscriber registers a subscriber for Resize events. Each resize of
+// the terminal is reported with both the new size and the size the terminal
+// had before the resize.
+// The provided function must be thread-safe.
+func ResizeSubscriber(f func(*terminalapi.Resize)) Option {
+	return option(func(td *termdash) {
+		td.resizeSubscriber = f
+	})
+}
+
 // withEDS indicates that termdash should run with the provided event
 // distribution system instead of creating one.
 // Useful for tests.
@@ -140,6 +187,11 @@ func NewController(t terminalapi.Terminal, c *container.Container, opts ...Optio
 }
 
 // Redraw triggers redraw of the terminal.
+// The redraw happens synchronously, so the terminal reflects the current state
+// of all the widgets once this returns. This is independent of any
+// RedrawInterval, use it to make updates to the widgets visible immediately.
+// Must not be called from the Tick method of a widgetapi.Ticker, see
+// widgetapi.Ticker.
 func (c *Controller) Redraw() error {
 	if c.td == nil {
 		return errors.New("the termdash instance is no longer running, this controller is now invalid")
@@ -147,7 +199,11 @@ func (c *Controller) Redraw() error {
 
 	c.td.mu.Lock()
 	defer c.td.mu.Unlock()
-	return c.td.redraw()
+	// Ticking under td.mu, so that Tick isn't called concurrently with Draw.
+	if err := c.td.container.Tick(); err != nil {
+		return fmt.Errorf("container.Tick => error: %w", err)
+	}
+	return c.td.redraw( /* force = */ true)
 }
 
 // Close closes the Controller and its termdash instance.
@@ -178,15 +234,22 @@ type termdash struct {
 	// clearNeeded indicates if the terminal needs to be cleared next time
 	// we're drawing it. Terminal needs to be cleared if its sized changed.
 	clearNeeded bool
+	// sizeUnusable indicates that the last redraw was skipped, because the
+	// terminal had zero or negative size.
+	sizeUnusable bool
 
 	// mu protects termdash.
 	mu sync.Mutex
 
 	// Options.
 	redrawInterval     time.Duration
+	redrawOnChangeOnly bool
 	errorHandler       func(error)
 	mouseSubscriber    func(*terminalapi.Mouse)
 	keyboardSubscriber func(*terminalapi.Keyboard)
+	resizeSubscriber   func(*terminalapi.Resize)
+
+	frameStatsSubscriber func(*FrameStats)
 }
 
 // newTermdash creates a new termdash.
@@ -241,6 +304,20 @@ func (td *termdash) subscribers() {
 			td.mouseSubscriber(ev.(*terminalapi.Mouse))
 		})
 	}
+
+	// Resize subscriber specified via options.
+	// The EDS calls each subscriber from a single goroutine, so the last
+	// known size doesn't need any locking.
+	if td.resizeSubscriber != nil {
+		last := td.term.Size()
+		td.eds.Subscribe([]terminalapi.Event{&terminalapi.Resize{}}, func(ev terminalapi.Event) {
+			// Copy the event, it is shared with the other subscribers.
+			res := *ev.(*terminalapi.Resize)
+			res.Previous = last
+			last = res.Size
+			td.resizeSubscriber(&res)
+		})
+	}
 }
 
 // handleError forwards the error to the error handler if one was
@@ -262,21 +339,68 @@ func (td *termdash) setClearNeeded() {
 }
 
 // redraw redraws the container and its widgets.
+// If force is true, the terminal is flushed even if the RedrawOnChangeOnly
+// option was provided and the content didn't change.
+// Skips the redraw while the terminal has zero or negative size, the terminal
+// is cleared and flushed once it has a usable size again.
 // The caller must hold td.mu.
-func (td *termdash) redraw() error {
+func (td *termdash) redraw(force bool) error {
+	if size := td.term.Size(); size.X <= 0 || size.Y <= 0 {
+		if !td.sizeUnusable && td.errorHandler != nil {
+			// Reported via the event distribution system, since the handler
+			// must not be called while holding td.mu.
+			td.eds.Event(terminalapi.NewErrorf("redraw suspended, the terminal has unusable size %v", size))
+		}
+		td.sizeUnusable = true
+		td.clearNeeded = true
+		return nil
+	}
+	td.sizeUnusable = false
+
 	if td.clearNeeded {
 		if err := td.term.Clear(); err != nil {
-			return fmt.Errorf("term.Clear => error: %v", err)
+			return fmt.Errorf("term.Clear => error: %w", err)
 		}
 		td.clearNeeded = false
+		force = true
 	}
 
+	var drawStart time.Time
+	if td.frameStatsSubscriber != nil {
+		drawStart = time.Now()
+	}
 	if err := td.container.Draw(); err != nil {
-		return fmt.Errorf("container.Draw => error: %v", err)
+		return fmt.Errorf("container.Draw => error: %w", err)
+	}
+	if p, ok := td.container.Cursor(); ok {
+		td.term.SetCursor(p)
+	} else {
+		td.term.HideCursor()
 	}
 
+	var stats *FrameStats
+	if td.frameStatsSubscriber != nil {
+		stats = &FrameStats{Draw: time.Since(drawStart)}
+	}
+
+	if td.redrawOnChangeOnly && !force && !td.container.Changed() {
+		if stats != nil {
+			td.frameStatsSubscriber(stats)
+		}
+		return nil
+	}
+
+	var flushStart time.Time
+	if stats != nil {
+		flushStart = time.Now()
+	}
 	if err := td.term.Flush(); err != nil {
-		return fmt.Errorf("term.Flush => error: %v", err)
+		return fmt.Errorf("term.Flush => error: %w", err)
+	}
+	if stats != nil {
+		stats.Flush = time.Since(flushStart)
+		stats.Flushed = true
+		td.frameStatsSubscriber(stats)
 	}
 	return nil
 }
@@ -291,14 +415,19 @@ func (td *termdash) evRedraw() error {
 	// We don't want to actually synchronize until all widgets update, we are
 	// purposefully leaving slow widgets behind.
 	time.Sleep(25 * time.Millisecond)
-	return td.redraw()
+	return td.redraw( /* force = */ false)
 }
 
 // periodicRedraw is called once each RedrawInterval.
+// Widgets implementing widgetapi.Ticker are ticked before the redraw.
 func (td *termdash) periodicRedraw() error {
 	td.mu.Lock()
 	defer td.mu.Unlock()
-	return td.redraw()
+	// Ticking under td.mu, so that Tick isn't called concurrently with Draw.
+	if err := td.container.Tick(); err != nil {
+		return fmt.Errorf("container.Tick => error: %w", err)
+	}
+	return td.redraw( /* force = */ false)
 }
 
 // processEvents processes terminal input events.
@@ -16,6 +16,7 @@ package termdash
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"image"
 	"sync"
@@ -25,7 +26,9 @@ import (
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/mum4k/termdash/container"
 	"github.com/mum4k/termdash/keyboard"
+	"github.com/mum4k/termdash/linestyle"
 	"github.com/mum4k/termdash/mouse"
+	"github.com/mum4k/termdash/private/canvas"
 	"github.com/mum4k/termdash/private/canvas/testcanvas"
 	"github.com/mum4k/termdash/private/event"
 	"github.com/mum4k/termdash/private/event/eventqueue"
@@ -175,10 +178,29 @@ func (ms *mouseSubscriber) receive(m *terminalapi.Mouse) {
 	ms.received = *m
 }
 
+// resizeSubscriber just stores the last resize event.
+type resizeSubscriber struct {
+	received terminalapi.Resize
+	mu       sync.Mutex
+}
+
+func (rs *resizeSubscriber) get() terminalapi.Resize {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	return rs.received
+}
+
+func (rs *resizeSubscriber) receive(r *terminalapi.Resize) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.received = *r
+}
+
 type eventHandlers struct {
-	handler  errorHandler
-	keySub   keySubscriber
-	mouseSub mouseSubscriber
+	handler   errorHandler
+	keySub    keySubscriber
+	mouseSub  mouseSubscriber
+	resizeSub resizeSubscriber
 }
 
 func TestRun(t *testing.T) {
@@ -399,6 +421,41 @@ func TestRun(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc: "forwards resize events to the subscriber",
+			size: image.Point{60, 10},
+			opts: func(eh *eventHandlers) []Option {
+				return []Option{
+					RedrawInterval(1),
+					ResizeSubscriber(eh.resizeSub.receive),
+				}
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Resize{Size: image.Point{70, 10}},
+			},
+			wantProcessed: 2,
+			after: func(eh *eventHandlers) error {
+				want := terminalapi.Resize{
+					Size:     image.Point{70, 10},
+					Previous: image.Point{60, 10},
+				}
+				if diff := pretty.Compare(want, eh.resizeSub.get()); diff != "" {
+					return fmt.Errorf("resizeSubscriber got unexpected value, diff (-want, +got):\n%s", diff)
+				}
+				return nil
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+
+				fakewidget.MustDraw(
+					ft,
+					testcanvas.MustNew(ft.Area()),
+					&widgetapi.Meta{Focused: true},
+					widgetapi.Options{},
+				)
+				return ft
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -407,9 +464,10 @@ func TestRun(t *testing.T) {
 			t.Parallel()
 
 			handlers := &eventHandlers{
-				handler:  errorHandler{},
-				keySub:   keySubscriber{},
-				mouseSub: mouseSubscriber{},
+				handler:   errorHandler{},
+				keySub:    keySubscriber{},
+				mouseSub:  mouseSubscriber{},
+				resizeSub: resizeSubscriber{},
 			}
 
 			eq := eventqueue.New()
@@ -676,3 +734,683 @@ func TestController(t *testing.T) {
 		})
 	}
 }
+
+func TestControllerRedrawIsImmediate(t *testing.T) {
+	t.Parallel()
+
+	size := image.Point{60, 10}
+	got, err := faketerm.New(size, faketerm.WithEventQueue(eventqueue.New()))
+	if err != nil {
+		t.Fatalf("faketerm.New => unexpected error: %v", err)
+	}
+
+	mi := fakewidget.New(widgetapi.Options{})
+	cont, err := container.New(
+		got,
+		container.PlaceWidget(mi),
+	)
+	if err != nil {
+		t.Fatalf("container.New => unexpected error: %v", err)
+	}
+
+	ctrl, err := NewController(got, cont, RedrawInterval(time.Hour))
+	if err != nil {
+		t.Fatalf("NewController => unexpected error: %v", err)
+	}
+	defer ctrl.Close()
+
+	for _, txt := range []string{"hello", "world"} {
+		mi.Text(txt)
+		if err := ctrl.Redraw(); err != nil {
+			t.Fatalf("Redraw => unexpected error: %v", err)
+		}
+
+		want := faketerm.MustNew(size)
+		mirror := fakewidget.New(widgetapi.Options{})
+		mirror.Text(txt)
+		fakewidget.MustDrawWithMirror(
+			mirror,
+			want,
+			testcanvas.MustNew(want.Area()),
+			&widgetapi.Meta{Focused: true},
+		)
+		if diff := faketerm.Diff(want, got); diff != "" {
+			t.Errorf("after Redraw with text %q => %v", txt, diff)
+		}
+	}
+}
+
+// flushCounter is a fake terminal that counts the calls to Flush.
+type flushCounter struct {
+	*faketerm.Terminal
+
+	mu      sync.Mutex
+	flushes int
+}
+
+// Flush implements terminalapi.Terminal.Flush.
+func (fc *flushCounter) Flush() error {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.flushes++
+	return fc.Terminal.Flush()
+}
+
+// get returns the number of calls to Flush so far.
+func (fc *flushCounter) get() int {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	return fc.flushes
+}
+
+func TestRedrawOnChangeOnly(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc string
+		opts []Option
+		// redraws performs redraws on the termdash instance and changes to
+		// the widget in between them.
+		redraws     func(*termdash, *fakewidget.Mirror) error
+		wantFlushes int
+	}{
+		{
+			desc: "flushes identical frames without the option",
+			redraws: func(td *termdash, mi *fakewidget.Mirror) error {
+				if err := td.periodicRedraw(); err != nil {
+					return err
+				}
+				return td.periodicRedraw()
+			},
+			wantFlushes: 3,
+		},
+		{
+			desc: "skips flush of identical frames",
+			opts: []Option{
+				RedrawOnChangeOnly(),
+			},
+			redraws: func(td *termdash, mi *fakewidget.Mirror) error {
+				if err := td.periodicRedraw(); err != nil {
+					return err
+				}
+				return td.periodicRedraw()
+			},
+			wantFlushes: 1,
+		},
+		{
+			desc: "flushes changed frames",
+			opts: []Option{
+				RedrawOnChangeOnly(),
+			},
+			redraws: func(td *termdash, mi *fakewidget.Mirror) error {
+				mi.Text("hello")
+				if err := td.periodicRedraw(); err != nil {
+					return err
+				}
+				mi.Text("world")
+				if err := td.periodicRedraw(); err != nil {
+					return err
+				}
+				return td.periodicRedraw()
+			},
+			wantFlushes: 3,
+		},
+		{
+			desc: "flushes identical frames after a resize",
+			opts: []Option{
+				RedrawOnChangeOnly(),
+			},
+			redraws: func(td *termdash, mi *fakewidget.Mirror) error {
+				td.setClearNeeded()
+				return td.periodicRedraw()
+			},
+			wantFlushes: 2,
+		},
+		{
+			desc: "forced redraw flushes identical frames",
+			opts: []Option{
+				RedrawOnChangeOnly(),
+			},
+			redraws: func(td *termdash, mi *fakewidget.Mirror) error {
+				td.mu.Lock()
+				defer td.mu.Unlock()
+				return td.redraw( /* force = */ true)
+			},
+			wantFlushes: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			tc := tc
+			t.Parallel()
+
+			ft, err := faketerm.New(image.Point{60, 10}, faketerm.WithEventQueue(eventqueue.New()))
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			fc := &flushCounter{Terminal: ft}
+
+			mi := fakewidget.New(widgetapi.Options{})
+			cont, err := container.New(
+				fc,
+				container.PlaceWidget(mi),
+			)
+			if err != nil {
+				t.Fatalf("container.New => unexpected error: %v", err)
+			}
+
+			ctrl, err := NewController(fc, cont, tc.opts...)
+			if err != nil {
+				t.Fatalf("NewController => unexpected error: %v", err)
+			}
+			defer ctrl.Close()
+
+			if err := tc.redraws(ctrl.td, mi); err != nil {
+				t.Fatalf("redraws => unexpected error: %v", err)
+			}
+			if got := fc.get(); got != tc.wantFlushes {
+				t.Errorf("Flush called %d times, want %d", got, tc.wantFlushes)
+			}
+		})
+	}
+}
+
+// sizeOverrider is a fake terminal that can report a size different from the
+// size of the underlying fake terminal.
+type sizeOverrider struct {
+	*flushCounter
+
+	mu   sync.Mutex
+	size *image.Point
+}
+
+// Size implements terminalapi.Terminal.Size.
+func (so *sizeOverrider) Size() image.Point {
+	so.mu.Lock()
+	defer so.mu.Unlock()
+	if so.size != nil {
+		return *so.size
+	}
+	return so.flushCounter.Size()
+}
+
+// override makes the terminal report the specified size, nil removes the
+// override.
+func (so *sizeOverrider) override(size *image.Point) {
+	so.mu.Lock()
+	defer so.mu.Unlock()
+	so.size = size
+}
+
+func TestUnusableSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc string
+		size image.Point
+	}{
+		{
+			desc: "zero width",
+			size: image.Point{0, 10},
+		},
+		{
+			desc: "zero height",
+			size: image.Point{60, 0},
+		},
+		{
+			desc: "negative size",
+			size: image.Point{-1, -1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			tc := tc
+			t.Parallel()
+
+			size := image.Point{60, 10}
+			ft, err := faketerm.New(size, faketerm.WithEventQueue(eventqueue.New()))
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			so := &sizeOverrider{flushCounter: &flushCounter{Terminal: ft}}
+
+			mi := fakewidget.New(widgetapi.Options{})
+			cont, err := container.New(
+				so,
+				container.PlaceWidget(mi),
+			)
+			if err != nil {
+				t.Fatalf("container.New => unexpected error: %v", err)
+			}
+
+			eh := &errorHandler{}
+			ctrl, err := NewController(so, cont, ErrorHandler(eh.handle))
+			if err != nil {
+				t.Fatalf("NewController => unexpected error: %v", err)
+			}
+			defer ctrl.Close()
+
+			so.override(&tc.size)
+			mi.Text("hello")
+			for i := 0; i < 2; i++ {
+				if err := ctrl.Redraw(); err != nil {
+					t.Fatalf("Redraw => unexpected error: %v", err)
+				}
+			}
+			if got, want := so.get(), 1; got != want {
+				t.Errorf("Flush called %d times with unusable size, want %d", got, want)
+			}
+			if err := testevent.WaitFor(5*time.Second, func() error {
+				if eh.get() == nil {
+					return errors.New("the error handler wasn't called")
+				}
+				return nil
+			}); err != nil {
+				t.Fatalf("testevent.WaitFor => %v", err)
+			}
+
+			so.override(nil)
+			if err := ctrl.Redraw(); err != nil {
+				t.Fatalf("Redraw => unexpected error: %v", err)
+			}
+			if got, want := so.get(), 2; got != want {
+				t.Errorf("Flush called %d times after the size was restored, want %d", got, want)
+			}
+
+			want := faketerm.MustNew(size)
+			mirror := fakewidget.New(widgetapi.Options{})
+			mirror.Text("hello")
+			fakewidget.MustDrawWithMirror(
+				mirror,
+				want,
+				testcanvas.MustNew(want.Area()),
+				&widgetapi.Meta{Focused: true},
+			)
+			if diff := faketerm.Diff(want, ft); diff != "" {
+				t.Errorf("after the size was restored => %v", diff)
+			}
+		})
+	}
+}
+
+// slowFlusher is a fake terminal that takes the specified time to flush.
+type slowFlusher struct {
+	*faketerm.Terminal
+	delay time.Duration
+}
+
+// Flush implements terminalapi.Terminal.Flush.
+func (sf *slowFlusher) Flush() error {
+	time.Sleep(sf.delay)
+	return sf.Terminal.Flush()
+}
+
+// slowWidget is a fake widget that takes the specified time to draw.
+type slowWidget struct {
+	*fakewidget.Mirror
+	delay time.Duration
+}
+
+// Draw implements widgetapi.Widget.Draw.
+func (sw *slowWidget) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
+	time.Sleep(sw.delay)
+	return sw.Mirror.Draw(cvs, meta)
+}
+
+// frameStatsCollector collects the received frame stats.
+type frameStatsCollector struct {
+	mu    sync.Mutex
+	stats []FrameStats
+}
+
+// receive receives frame stats.
+func (fsc *frameStatsCollector) receive(fs *FrameStats) {
+	fsc.mu.Lock()
+	defer fsc.mu.Unlock()
+	fsc.stats = append(fsc.stats, *fs)
+}
+
+// get returns all the frame stats received so far.
+func (fsc *frameStatsCollector) get() []FrameStats {
+	fsc.mu.Lock()
+	defer fsc.mu.Unlock()
+	return append([]FrameStats(nil), fsc.stats...)
+}
+
+func TestFrameStatsSubscriber(t *testing.T) {
+	t.Parallel()
+
+	const delay = 2 * time.Millisecond
+	ft, err := faketerm.New(image.Point{60, 10}, faketerm.WithEventQueue(eventqueue.New()))
+	if err != nil {
+		t.Fatalf("faketerm.New => unexpected error: %v", err)
+	}
+	sf := &slowFlusher{Terminal: ft, delay: delay}
+
+	sw := &slowWidget{
+		Mirror: fakewidget.New(widgetapi.Options{}),
+		delay:  delay,
+	}
+	cont, err := container.New(
+		sf,
+		container.PlaceWidget(sw),
+	)
+	if err != nil {
+		t.Fatalf("container.New => unexpected error: %v", err)
+	}
+
+	fsc := &frameStatsCollector{}
+	ctrl, err := NewController(sf, cont, FrameStatsSubscriber(fsc.receive), RedrawOnChangeOnly())
+	if err != nil {
+		t.Fatalf("NewController => unexpected error: %v", err)
+	}
+	defer ctrl.Close()
+
+	for i := 0; i < 2; i++ {
+		if err := ctrl.Redraw(); err != nil {
+			t.Fatalf("Redraw => unexpected error: %v", err)
+		}
+	}
+	// Not forced and the content didn't change, so the flush is skipped.
+	if err := ctrl.td.periodicRedraw(); err != nil {
+		t.Fatalf("periodicRedraw => unexpected error: %v", err)
+	}
+
+	got := fsc.get()
+	if len(got) != 4 {
+		t.Fatalf("FrameStatsSubscriber received %d frames, want 4", len(got))
+	}
+	for i, fs := range got {
+		if fs.Draw < delay {
+			t.Errorf("frame %d: Draw took %v, want at least %v", i, fs.Draw, delay)
+		}
+		if fs.Draw > time.Second {
+			t.Errorf("frame %d: Draw took %v, want at most a second", i, fs.Draw)
+		}
+	}
+	for i, fs := range got[:3] {
+		if !fs.Flushed {
+			t.Errorf("frame %d: Flushed is false, want true", i)
+		}
+		if fs.Flush < delay {
+			t.Errorf("frame %d: Flush took %v, want at least %v", i, fs.Flush, delay)
+		}
+		if fs.Flush > time.Second {
+			t.Errorf("frame %d: Flush took %v, want at most a second", i, fs.Flush)
+		}
+	}
+	if last := got[3]; last.Flushed || last.Flush != 0 {
+		t.Errorf("last frame: Flushed %v, Flush %v, want a skipped flush", last.Flushed, last.Flush)
+	}
+}
+
+// cursorWidget is a fake widget that requests the position of the terminal
+// cursor when drawn.
+type cursorWidget struct {
+	*fakewidget.Mirror
+
+	mu sync.Mutex
+	// cursor is the requested position or nil to not request one.
+	cursor *image.Point
+}
+
+// setCursor sets the position the widget requests on the next draw.
+func (cw *cursorWidget) setCursor(p *image.Point) {
+	cw.mu.Lock()
+	defer cw.mu.Unlock()
+	cw.cursor = p
+}
+
+// Draw implements widgetapi.Widget.Draw.
+func (cw *cursorWidget) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
+	if err := cw.Mirror.Draw(cvs, meta); err != nil {
+		return err
+	}
+
+	cw.mu.Lock()
+	defer cw.mu.Unlock()
+	if cw.cursor != nil {
+		meta.SetCursor(*cw.cursor)
+	}
+	return nil
+}
+
+func TestCursor(t *testing.T) {
+	t.Parallel()
+
+	ft, err := faketerm.New(image.Point{60, 10}, faketerm.WithEventQueue(eventqueue.New()))
+	if err != nil {
+		t.Fatalf("faketerm.New => unexpected error: %v", err)
+	}
+
+	cw := &cursorWidget{
+		Mirror: fakewidget.New(widgetapi.Options{}),
+		cursor: &image.Point{3, 2},
+	}
+	cont, err := container.New(
+		ft,
+		container.Border(linestyle.Light),
+		container.PlaceWidget(cw),
+	)
+	if err != nil {
+		t.Fatalf("container.New => unexpected error: %v", err)
+	}
+
+	ctrl, err := NewController(ft, cont, RedrawInterval(time.Hour))
+	if err != nil {
+		t.Fatalf("NewController => unexpected error: %v", err)
+	}
+	defer ctrl.Close()
+
+	// The position is offset by the border of the container.
+	if got, ok := ft.Cursor(); !ok || got != (image.Point{4, 3}) {
+		t.Errorf("Cursor => %v, %v, want %v, true", got, ok, image.Point{4, 3})
+	}
+
+	cw.setCursor(nil)
+	if err := ctrl.Redraw(); err != nil {
+		t.Fatalf("Redraw => unexpected error: %v", err)
+	}
+	if got, ok := ft.Cursor(); ok {
+		t.Errorf("Cursor => %v, %v, want the cursor hidden", got, ok)
+	}
+}
+
+// tickingWidget is a fake widget that counts the calls to Tick.
+type tickingWidget struct {
+	*fakewidget.Mirror
+
+	mu sync.Mutex
+	// ticks counts the calls to Tick.
+	ticks int
+	// err if not nil is returned from Tick.
+	err error
+}
+
+// Tick implements widgetapi.Ticker.Tick.
+func (tw *tickingWidget) Tick() error {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	tw.ticks++
+	return tw.err
+}
+
+// getTicks returns the number of calls to Tick so far.
+func (tw *tickingWidget) getTicks() int {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	return tw.ticks
+}
+
+func TestTicker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("controller ticks on each redraw", func(t *testing.T) {
+		ft, err := faketerm.New(image.Point{60, 10}, faketerm.WithEventQueue(eventqueue.New()))
+		if err != nil {
+			t.Fatalf("faketerm.New => unexpected error: %v", err)
+		}
+		tw := &tickingWidget{Mirror: fakewidget.New(widgetapi.Options{})}
+		cont, err := container.New(ft, container.PlaceWidget(tw))
+		if err != nil {
+			t.Fatalf("container.New => unexpected error: %v", err)
+		}
+
+		ctrl, err := NewController(ft, cont)
+		if err != nil {
+			t.Fatalf("NewController => unexpected error: %v", err)
+		}
+		defer ctrl.Close()
+
+		for i := 0; i < 2; i++ {
+			if err := ctrl.Redraw(); err != nil {
+				t.Fatalf("Redraw => unexpected error: %v", err)
+			}
+		}
+		// One tick for the initial redraw and one for each call to Redraw.
+		if got, want := tw.getTicks(), 3; got != want {
+			t.Errorf("Tick called %d times, want %d", got, want)
+		}
+	})
+
+	t.Run("controller redraw fails when Tick fails", func(t *testing.T) {
+		ft, err := faketerm.New(image.Point{60, 10}, faketerm.WithEventQueue(eventqueue.New()))
+		if err != nil {
+			t.Fatalf("faketerm.New => unexpected error: %v", err)
+		}
+		tw := &tickingWidget{Mirror: fakewidget.New(widgetapi.Options{})}
+		cont, err := container.New(ft, container.PlaceWidget(tw))
+		if err != nil {
+			t.Fatalf("container.New => unexpected error: %v", err)
+		}
+
+		ctrl, err := NewController(ft, cont)
+		if err != nil {
+			t.Fatalf("NewController => unexpected error: %v", err)
+		}
+		defer ctrl.Close()
+
+		tw.mu.Lock()
+		tw.err = errors.New("tick failed")
+		tw.mu.Unlock()
+		if err := ctrl.Redraw(); err == nil {
+			t.Errorf("Redraw => got nil error, want an error")
+		}
+	})
+
+	t.Run("run ticks on the periodic redraws", func(t *testing.T) {
+		ft, err := faketerm.New(image.Point{60, 10}, faketerm.WithEventQueue(eventqueue.New()))
+		if err != nil {
+			t.Fatalf("faketerm.New => unexpected error: %v", err)
+		}
+		tw := &tickingWidget{Mirror: fakewidget.New(widgetapi.Options{})}
+		cont, err := container.New(ft, container.PlaceWidget(tw))
+		if err != nil {
+			t.Fatalf("container.New => unexpected error: %v", err)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- Run(ctx, ft, cont, RedrawInterval(time.Millisecond))
+		}()
+
+		if err := testevent.WaitFor(5*time.Second, func() error {
+			if got, min := tw.getTicks(), 3; got < min {
+				return fmt.Errorf("Tick called %d times, want at least %d", got, min)
+			}
+			return nil
+		}); err != nil {
+			t.Errorf("testevent.WaitFor => %v", err)
+		}
+		cancel()
+		if err := <-errCh; err != nil {
+			t.Errorf("Run => unexpected error: %v", err)
+		}
+	})
+}
+
+func TestWidgetErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc string
+		// size is the size of the terminal.
+		size image.Point
+		// events are delivered to termdash, if empty the error is expected
+		// from the initial redraw.
+		events []terminalapi.Event
+	}{
+		{
+			desc: "keyboard error reaches the error handler",
+			size: image.Point{60, 10},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: keyboard.KeyEsc},
+			},
+		},
+		{
+			desc: "mouse error reaches the error handler",
+			size: image.Point{60, 10},
+			events: []terminalapi.Event{
+				&terminalapi.Mouse{Position: image.Point{1, 1}, Button: mouse.ButtonRight},
+			},
+		},
+		{
+			desc: "draw error is returned from the redraw",
+			size: image.Point{1, 1},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			t.Parallel()
+
+			eq := eventqueue.New()
+			for _, ev := range tc.events {
+				eq.Push(ev)
+			}
+			ft, err := faketerm.New(tc.size, faketerm.WithEventQueue(eq))
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			cont, err := container.New(
+				ft,
+				container.ID("widget"),
+				container.PlaceWidget(fakewidget.New(widgetapi.Options{
+					WantKeyboard: widgetapi.KeyScopeGlobal,
+					WantMouse:    widgetapi.MouseScopeGlobal,
+				})),
+			)
+			if err != nil {
+				t.Fatalf("container.New => unexpected error: %v", err)
+			}
+
+			eh := &errorHandler{}
+			ctrl, got := NewController(ft, cont, ErrorHandler(eh.handle))
+			if got == nil {
+				defer ctrl.Close()
+				if err := testevent.WaitFor(5*time.Second, func() error {
+					if eh.get() == nil {
+						return errors.New("the error handler got no error")
+					}
+					return nil
+				}); err != nil {
+					t.Fatalf("testevent.WaitFor => %v", err)
+				}
+				got = eh.get()
+			}
+
+			var wErr *widgetapi.Error
+			if !errors.As(got, &wErr) {
+				t.Fatalf("got error %v of type %T, want a *widgetapi.Error", got, got)
+			}
+			if got, want := wErr.ContainerID, "widget"; got != want {
+				t.Errorf("widgetapi.Error.ContainerID => %q, want %q", got, want)
+			}
+		})
+	}
+}
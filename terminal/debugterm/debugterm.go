@@ -0,0 +1,187 @@
+// Copyright 2019 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package debugterm implements a terminal that doesn't display anything and
+// instead writes a human readable log of the draw operations.
+//
+// This is useful when diagnosing why a widget draws incorrectly, since it
+// doesn't require a TTY. Each operation is written as a single line, e.g.:
+//
+//	SetCell (1,0) 'a' fg:ColorRed bg:ColorDefault bold
+//	Flush
+package debugterm
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/private/event/eventqueue"
+	"github.com/mum4k/termdash/terminal/terminalapi"
+)
+
+// Terminal is a terminal that logs the draw operations into an io.Writer.
+//
+// The terminal doesn't produce any input events on its own, the only events
+// it reports are the ones caused by calls to Resize.
+// This implementation is thread-safe.
+type Terminal struct {
+	// w is where the log is written.
+	w io.Writer
+
+	// size is the current size of the terminal.
+	size image.Point
+
+	// events is a queue of input events.
+	events *eventqueue.Unbound
+
+	// mu protects w and size.
+	mu sync.Mutex
+}
+
+// New returns a new debug terminal of the specified size that writes its log
+// into the provided writer. The size must be positive in both dimensions.
+func New(w io.Writer, size image.Point) (*Terminal, error) {
+	if err := validateSize(size); err != nil {
+		return nil, err
+	}
+	return &Terminal{
+		w:      w,
+		size:   size,
+		events: eventqueue.New(),
+	}, nil
+}
+
+// validateSize validates the size of the terminal.
+func validateSize(size image.Point) error {
+	if size.X <= 0 || size.Y <= 0 {
+		return fmt.Errorf("invalid terminal size %v, the width and height must be positive", size)
+	}
+	return nil
+}
+
+// logf writes a single line into the log.
+// Caller must hold t.mu.
+func (t *Terminal) logf(format string, args ...interface{}) error {
+	_, err := fmt.Fprintf(t.w, format+"\n", args...)
+	return err
+}
+
+// formatOpts returns a human readable representation of the cell options.
+// The colors are always included, the attributes only when set.
+func formatOpts(opts ...cell.Option) string {
+	o := cell.NewOptions(opts...)
+	parts := []string{
+		fmt.Sprintf("fg:%v", o.FgColor),
+		fmt.Sprintf("bg:%v", o.BgColor),
+	}
+	for _, attr := range []struct {
+		set  bool
+		name string
+	}{
+		{o.Bold, "bold"},
+		{o.Italic, "italic"},
+		{o.Underline, "underline"},
+		{o.Strikethrough, "strikethrough"},
+		{o.Inverse, "inverse"},
+		{o.Blink, "blink"},
+		{o.Dim, "dim"},
+	} {
+		if attr.set {
+			parts = append(parts, attr.name)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// Resize resizes the terminal to the provided size, logs it and reports it
+// as a terminalapi.Resize event on the next call to Event.
+func (t *Terminal) Resize(size image.Point) error {
+	if err := validateSize(size); err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.size = size
+	t.events.Push(&terminalapi.Resize{Size: size})
+	return t.logf("Resize %dx%d", size.X, size.Y)
+}
+
+// Size implements terminalapi.Terminal.Size.
+func (t *Terminal) Size() image.Point {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.size
+}
+
+// Clear implements terminalapi.Terminal.Clear.
+func (t *Terminal) Clear(opts ...cell.Option) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.logf("Clear %s", formatOpts(opts...))
+}
+
+// Flush implements terminalapi.Terminal.Flush.
+func (t *Terminal) Flush() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.logf("Flush")
+}
+
+// SetCursor implements terminalapi.Terminal.SetCursor.
+// Errors writing the log are ignored, since this method can't report them.
+func (t *Terminal) SetCursor(p image.Point) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.logf("SetCursor (%d,%d)", p.X, p.Y)
+}
+
+// HideCursor implements terminalapi.Terminal.HideCursor.
+// Errors writing the log are ignored, since this method can't report them.
+func (t *Terminal) HideCursor() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.logf("HideCursor")
+}
+
+// SetCell implements terminalapi.Terminal.SetCell.
+// Returns an error if the point falls outside of the terminal.
+func (t *Terminal) SetCell(p image.Point, r rune, opts ...cell.Option) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if ar := image.Rect(0, 0, t.size.X, t.size.Y); !p.In(ar) {
+		return fmt.Errorf("cell at point %v falls outside of the terminal area %v", p, ar)
+	}
+	return t.logf("SetCell (%d,%d) %q %s", p.X, p.Y, r, formatOpts(opts...))
+}
+
+// Event implements terminalapi.Terminal.Event.
+func (t *Terminal) Event(ctx context.Context) terminalapi.Event {
+	return t.events.Pull(ctx)
+}
+
+// Close implements terminalapi.Terminal.Close.
+// Errors writing the log are ignored, since this method can't report them.
+func (t *Terminal) Close() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.events.Close()
+	t.logf("Close")
+}
@@ -0,0 +1,196 @@
+// Copyright 2019 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debugterm
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/terminal/terminalapi"
+)
+
+func TestTerminal(t *testing.T) {
+	tests := []struct {
+		desc string
+		size image.Point
+		// ops are the operations performed on the terminal.
+		ops       func(*Terminal) error
+		wantLog   []string
+		wantErr   bool
+		wantOpErr bool
+	}{
+		{
+			desc:    "fails on zero width",
+			size:    image.Point{0, 1},
+			wantErr: true,
+		},
+		{
+			desc:    "fails on negative height",
+			size:    image.Point{1, -1},
+			wantErr: true,
+		},
+		{
+			desc: "logs nothing without operations",
+			size: image.Point{3, 2},
+		},
+		{
+			desc: "logs a small draw sequence",
+			size: image.Point{3, 2},
+			ops: func(t *Terminal) error {
+				if err := t.Clear(); err != nil {
+					return err
+				}
+				if err := t.SetCell(image.Point{0, 0}, 'a'); err != nil {
+					return err
+				}
+				if err := t.SetCell(image.Point{2, 1}, 'b',
+					cell.FgColor(cell.ColorRed),
+					cell.BgColor(cell.ColorNumber(42)),
+					cell.Bold(),
+					cell.Underline(),
+				); err != nil {
+					return err
+				}
+				t.SetCursor(image.Point{1, 0})
+				t.HideCursor()
+				return t.Flush()
+			},
+			wantLog: []string{
+				"Clear fg:ColorDefault bg:ColorDefault",
+				"SetCell (0,0) 'a' fg:ColorDefault bg:ColorDefault",
+				"SetCell (2,1) 'b' fg:ColorRed bg:Color:43 bold underline",
+				"SetCursor (1,0)",
+				"HideCursor",
+				"Flush",
+			},
+		},
+		{
+			desc: "logs clear with cell options",
+			size: image.Point{3, 2},
+			ops: func(t *Terminal) error {
+				return t.Clear(cell.BgColor(cell.ColorBlue), cell.Dim())
+			},
+			wantLog: []string{
+				"Clear fg:ColorDefault bg:ColorBlue dim",
+			},
+		},
+		{
+			desc: "logs a full-width rune",
+			size: image.Point{3, 2},
+			ops: func(t *Terminal) error {
+				return t.SetCell(image.Point{0, 1}, '世')
+			},
+			wantLog: []string{
+				"SetCell (0,1) '世' fg:ColorDefault bg:ColorDefault",
+			},
+		},
+		{
+			desc: "SetCell fails outside of the terminal",
+			size: image.Point{3, 2},
+			ops: func(t *Terminal) error {
+				return t.SetCell(image.Point{3, 0}, 'a')
+			},
+			wantOpErr: true,
+		},
+		{
+			desc: "logs resize and close",
+			size: image.Point{3, 2},
+			ops: func(t *Terminal) error {
+				if err := t.Resize(image.Point{4, 5}); err != nil {
+					return err
+				}
+				t.Close()
+				return nil
+			},
+			wantLog: []string{
+				"Resize 4x5",
+				"Close",
+			},
+		},
+		{
+			desc: "Resize fails on invalid size",
+			size: image.Point{3, 2},
+			ops: func(t *Terminal) error {
+				return t.Resize(image.Point{0, 0})
+			},
+			wantOpErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			var buf bytes.Buffer
+			term, err := New(&buf, tc.size)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("New => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if tc.ops != nil {
+				err := tc.ops(term)
+				if (err != nil) != tc.wantOpErr {
+					t.Errorf("tc.ops => unexpected error: %v, wantOpErr: %v", err, tc.wantOpErr)
+				}
+				if err != nil {
+					return
+				}
+			}
+
+			var gotLog []string
+			if buf.Len() > 0 {
+				gotLog = strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+			}
+			if diff := pretty.Compare(tc.wantLog, gotLog); diff != "" {
+				t.Errorf("log => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResizeEvent(t *testing.T) {
+	var buf bytes.Buffer
+	term, err := New(&buf, image.Point{3, 2})
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+	defer term.Close()
+
+	want := image.Point{4, 5}
+	if err := term.Resize(want); err != nil {
+		t.Fatalf("Resize => unexpected error: %v", err)
+	}
+	if got := term.Size(); got != want {
+		t.Errorf("Size => %v, want %v", got, want)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ev := term.Event(ctx)
+	res, ok := ev.(*terminalapi.Resize)
+	if !ok {
+		t.Fatalf("Event => %T, want a *terminalapi.Resize", ev)
+	}
+	if res.Size != want {
+		t.Errorf("Event => Resize{Size: %v}, want Resize{Size: %v}", res.Size, want)
+	}
+}
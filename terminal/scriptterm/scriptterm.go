@@ -0,0 +1,103 @@
+// Copyright 2019 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package scriptterm implements a terminal that wraps another terminal and
+// allows injecting synthetic input events.
+//
+// This is useful for end-to-end tests of dashboards with real widgets and for
+// macros that replay a sequence of keyboard or mouse events. The injected
+// events are reported by Event together with the events of the wrapped
+// terminal in the order they arrived.
+package scriptterm
+
+import (
+	"context"
+
+	"github.com/mum4k/termdash/private/event/eventqueue"
+	"github.com/mum4k/termdash/terminal/terminalapi"
+)
+
+// Terminal wraps a terminal and reports injected events in addition to the
+// events of the wrapped terminal. All other methods are delegated to the
+// wrapped terminal.
+//
+// This implementation is thread-safe.
+type Terminal struct {
+	terminalapi.Terminal
+
+	// events is a queue of the injected events and the events pulled from
+	// the wrapped terminal.
+	events *eventqueue.Unbound
+
+	// cancel stops the goroutine pulling events from the wrapped terminal.
+	cancel context.CancelFunc
+}
+
+// New returns a new terminal that wraps the provided terminal.
+// The terminal starts pulling events from the wrapped terminal, call Close to
+// release the resources. The Event method of the wrapped terminal must block
+// until an event arrives, like the terminals in the terminal package do.
+func New(t terminalapi.Terminal) *Terminal {
+	ctx, cancel := context.WithCancel(context.Background())
+	st := &Terminal{
+		Terminal: t,
+		events:   eventqueue.New(),
+		cancel:   cancel,
+	}
+	go st.pull(ctx)
+	return st
+}
+
+// pull pulls events from the wrapped terminal into the queue until the
+// context is canceled.
+func (t *Terminal) pull(ctx context.Context) {
+	for {
+		ev := t.Terminal.Event(ctx)
+		if ev == nil {
+			return // Context canceled.
+		}
+		t.events.Push(ev)
+	}
+}
+
+// Inject enqueues the provided events. Each of them is returned by one of the
+// subsequent calls to Event in the provided order.
+func (t *Terminal) Inject(events ...terminalapi.Event) {
+	for _, ev := range events {
+		t.events.Push(ev)
+	}
+}
+
+// Event implements terminalapi.Terminal.Event.
+func (t *Terminal) Event(ctx context.Context) terminalapi.Event {
+	return t.events.Pull(ctx)
+}
+
+// Capabilities implements terminalapi.CapabilitiesReporter.Capabilities.
+// Reports the capabilities of the wrapped terminal or the zero value if the
+// wrapped terminal doesn't report any.
+func (t *Terminal) Capabilities() terminalapi.Capabilities {
+	if cr, ok := t.Terminal.(terminalapi.CapabilitiesReporter); ok {
+		return cr.Capabilities()
+	}
+	return terminalapi.Capabilities{}
+}
+
+// Close stops pulling events and closes the wrapped terminal.
+// Implements terminalapi.Terminal.Close.
+func (t *Terminal) Close() {
+	t.cancel()
+	t.events.Close()
+	t.Terminal.Close()
+}
@@ -0,0 +1,164 @@
+// Copyright 2019 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scriptterm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/mum4k/termdash"
+	"github.com/mum4k/termdash/container"
+	"github.com/mum4k/termdash/keyboard"
+	"github.com/mum4k/termdash/private/canvas"
+	"github.com/mum4k/termdash/private/event/eventqueue"
+	"github.com/mum4k/termdash/private/event/testevent"
+	"github.com/mum4k/termdash/private/faketerm"
+	"github.com/mum4k/termdash/terminal/terminalapi"
+	"github.com/mum4k/termdash/widgetapi"
+)
+
+// nextEvent returns the next event from the terminal or fails the test.
+func nextEvent(t *testing.T, term terminalapi.Terminal) terminalapi.Event {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ev := term.Event(ctx)
+	if ev == nil {
+		t.Fatalf("Event => timed out waiting for an event")
+	}
+	return ev
+}
+
+func TestInject(t *testing.T) {
+	ft := faketerm.MustNew(image.Point{3, 3}, faketerm.WithEventQueue(eventqueue.New()))
+	term := New(ft)
+	defer term.Close()
+
+	want := []terminalapi.Event{
+		&terminalapi.Keyboard{Key: 'a'},
+		&terminalapi.Keyboard{Key: keyboard.KeyEnter},
+		&terminalapi.Keyboard{Key: 'b'},
+	}
+	term.Inject(want[:2]...)
+	term.Inject(want[2])
+
+	var got []terminalapi.Event
+	for range want {
+		got = append(got, nextEvent(t, term))
+	}
+	if diff := pretty.Compare(want, got); diff != "" {
+		t.Errorf("Event => unexpected diff (-want, +got):\n%s", diff)
+	}
+}
+
+func TestWrappedEvents(t *testing.T) {
+	ft := faketerm.MustNew(image.Point{3, 3}, faketerm.WithEventQueue(eventqueue.New()))
+	term := New(ft)
+	defer term.Close()
+
+	want := []terminalapi.Event{
+		&terminalapi.Keyboard{Key: 'a'},
+		&terminalapi.Resize{Size: image.Point{4, 5}},
+	}
+	ft.Inject(want...)
+
+	var got []terminalapi.Event
+	for range want {
+		got = append(got, nextEvent(t, term))
+	}
+	if diff := pretty.Compare(want, got); diff != "" {
+		t.Errorf("Event => unexpected diff (-want, +got):\n%s", diff)
+	}
+	if got, want := term.Size(), (image.Point{4, 5}); got != want {
+		t.Errorf("Size => %v, want %v", got, want)
+	}
+}
+
+// keyRecorder is a widget that records the keys it receives.
+type keyRecorder struct {
+	mu   sync.Mutex
+	keys []keyboard.Key
+}
+
+// Draw implements widgetapi.Widget.Draw.
+func (kr *keyRecorder) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
+	return nil
+}
+
+// Keyboard implements widgetapi.Widget.Keyboard.
+func (kr *keyRecorder) Keyboard(k *terminalapi.Keyboard, meta *widgetapi.EventMeta) error {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	kr.keys = append(kr.keys, k.Key)
+	return nil
+}
+
+// Mouse implements widgetapi.Widget.Mouse.
+func (kr *keyRecorder) Mouse(m *terminalapi.Mouse, meta *widgetapi.EventMeta) error {
+	return errors.New("unexpected mouse event")
+}
+
+// Options implements widgetapi.Widget.Options.
+func (kr *keyRecorder) Options() widgetapi.Options {
+	return widgetapi.Options{
+		WantKeyboard: widgetapi.KeyScopeGlobal,
+	}
+}
+
+// got returns the recorded keys.
+func (kr *keyRecorder) got() []keyboard.Key {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	return append([]keyboard.Key(nil), kr.keys...)
+}
+
+func TestDrivesContainer(t *testing.T) {
+	term := New(faketerm.MustNew(image.Point{10, 5}, faketerm.WithEventQueue(eventqueue.New())))
+	defer term.Close()
+
+	kr := &keyRecorder{}
+	cont, err := container.New(term, container.PlaceWidget(kr))
+	if err != nil {
+		t.Fatalf("container.New => unexpected error: %v", err)
+	}
+	ctrl, err := termdash.NewController(term, cont)
+	if err != nil {
+		t.Fatalf("termdash.NewController => unexpected error: %v", err)
+	}
+	defer ctrl.Close()
+
+	want := []keyboard.Key{'h', 'i', keyboard.KeyEnter}
+	for _, k := range want {
+		term.Inject(&terminalapi.Keyboard{Key: k})
+	}
+
+	if err := testevent.WaitFor(5*time.Second, func() error {
+		if got := kr.got(); len(got) != len(want) {
+			return fmt.Errorf("the widget received %d keys, want %d", len(got), len(want))
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("testevent.WaitFor => %v", err)
+	}
+	if diff := pretty.Compare(want, kr.got()); diff != "" {
+		t.Errorf("widget keys => unexpected diff (-want, +got):\n%s", diff)
+	}
+}
@@ -90,10 +90,13 @@ var tcellToTd = map[tcell.Key]keyboard.Key{
 func convKey(event *tcell.EventKey) terminalapi.Event {
 	tcellKey := event.Key()
 
+	alt := event.Modifiers()&tcell.ModAlt != 0
+
 	if tcellKey == tcell.KeyRune {
 		ch := event.Rune()
 		return &terminalapi.Keyboard{
 			Key: keyboard.Key(ch),
+			Alt: alt,
 		}
 	}
 
@@ -104,6 +107,7 @@ func convKey(event *tcell.EventKey) terminalapi.Event {
 
 	return &terminalapi.Keyboard{
 		Key: k,
+		Alt: alt,
 	}
 }
 
@@ -126,6 +130,10 @@ func convMouse(event *tcell.EventMouse) terminalapi.Event {
 		button = mouse.ButtonWheelUp
 	} else if tcellBtn&tcell.WheelDown != 0 {
 		button = mouse.ButtonWheelDown
+	} else if tcellBtn&tcell.WheelLeft != 0 {
+		button = mouse.ButtonWheelLeft
+	} else if tcellBtn&tcell.WheelRight != 0 {
+		button = mouse.ButtonWheelRight
 	}
 
 	// Return wheel event if found
@@ -97,6 +97,16 @@ func TestToTermdashEvents(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc:  "keyboard event with the Alt modifier",
+			event: tcell.NewEventKey(tcell.KeyRune, 'x', tcell.ModAlt),
+			want: []terminalapi.Event{
+				&terminalapi.Keyboard{
+					Key: 'x',
+					Alt: true,
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -122,6 +132,8 @@ func TestMouseButtons(t *testing.T) {
 		{btnMask: tcell.ButtonNone, want: []mouse.Button{mouse.ButtonRelease}},
 		{btnMask: tcell.WheelUp, want: []mouse.Button{mouse.ButtonWheelUp}},
 		{btnMask: tcell.WheelDown, want: []mouse.Button{mouse.ButtonWheelDown}},
+		{btnMask: tcell.WheelLeft, want: []mouse.Button{mouse.ButtonWheelLeft}},
+		{btnMask: tcell.WheelRight, want: []mouse.Button{mouse.ButtonWheelRight}},
 		{btnMask: tcell.Button1 | tcell.Button2, want: nil},
 	}
 
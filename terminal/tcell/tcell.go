@@ -18,10 +18,13 @@ import (
 	"context"
 	"fmt"
 	"image"
+	"strings"
+	"time"
 
 	tcell "github.com/gdamore/tcell/v2"
 	"github.com/gdamore/tcell/v2/encoding"
 	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/keyboard"
 	"github.com/mum4k/termdash/private/event/eventqueue"
 	"github.com/mum4k/termdash/terminal/terminalapi"
 )
@@ -52,6 +55,9 @@ func ColorMode(cm terminalapi.ColorMode) Option {
 }
 
 // ClearStyle sets the style to use for tcell when clearing the screen.
+// The colors also replace ColorDefault in the cells set on the terminal, so
+// they act as the default foreground and background of the whole screen,
+// e.g. to show a themed background behind empty container areas.
 // Defaults to ColorDefault for foreground and background.
 func ClearStyle(fg, bg cell.Color) Option {
 	return option(func(t *Terminal) {
@@ -62,6 +68,65 @@ func ClearStyle(fg, bg cell.Color) Option {
 	})
 }
 
+// DiffFrames makes the terminal remember the content it sent to the screen
+// during the last Flush and only send the cells that changed since then.
+//
+// The tcell screen already batches all cells until they are shown, this
+// option additionally avoids re-sending cells whose rune and style remain the
+// same between frames, which reduces flicker on slow links. Cells are
+// re-sent in full whenever the size of the terminal changes.
+func DiffFrames() Option {
+	return option(func(t *Terminal) {
+		t.diffFrames = true
+	})
+}
+
+// DefaultEscapeTimeout is the default value for the EscapeTimeout option.
+const DefaultEscapeTimeout = 50 * time.Millisecond
+
+// EscapeTimeout sets how long the terminal waits after receiving the Esc key
+// for the next key, in order to tell a lone Esc key from the start of an
+// Alt key combination that reached the terminal in two parts, e.g. over a
+// slow link. If a key that produces a rune arrives within the timeout, the
+// two are reported as a single keyboard event of that rune with the Alt
+// modifier set. Otherwise the Esc key is reported when the timeout expires or
+// when any other event arrives.
+// Setting the timeout to zero reports the Esc key immediately.
+// Defaults to DefaultEscapeTimeout.
+func EscapeTimeout(d time.Duration) Option {
+	return option(func(t *Terminal) {
+		t.escapeTimeout = d
+	})
+}
+
+// Screen makes the terminal use the provided tcell screen instead of creating
+// one for the user's terminal, e.g. a tcell.SimulationScreen whose content can
+// be read back in integration tests. The terminal initializes the screen and
+// finalizes it when closed.
+// Takes precedence over the Tty option.
+func Screen(s tcell.Screen) Option {
+	return option(func(t *Terminal) {
+		t.screen = s
+	})
+}
+
+// Tty makes the terminal read the input from and write the output to the
+// provided tcell.Tty instead of /dev/tty, e.g. to run inside a PTY wrapper or
+// when the standard input and output aren't a terminal. The capabilities of
+// the terminal are looked up in terminfo according to the TERM environment
+// variable.
+func Tty(tty tcell.Tty) Option {
+	return option(func(t *Terminal) {
+		t.tty = tty
+	})
+}
+
+// frameCell is the content of a single cell as sent to the tcell screen.
+type frameCell struct {
+	r  rune
+	st tcell.Style
+}
+
 // Terminal provides input and output to a real terminal. Wraps the
 // gdamore/tcell terminal implementation. This object is not thread-safe.
 // Implements terminalapi.Terminal.
@@ -74,22 +139,33 @@ type Terminal struct {
 
 	// the tcell terminal window
 	screen tcell.Screen
+	// tty when not nil, the screen is created on top of it.
+	tty tcell.Tty
 
 	// Options.
-	colorMode  terminalapi.ColorMode
-	clearStyle *cell.Options
+	colorMode     terminalapi.ColorMode
+	clearStyle    *cell.Options
+	diffFrames    bool
+	escapeTimeout time.Duration
+
+	// pending are the cells set since the last Flush, only used with
+	// DiffFrames.
+	pending map[image.Point]frameCell
+	// last are the cells sent to the screen during the previous Flushes,
+	// only used with DiffFrames.
+	last map[image.Point]frameCell
+	// lastSize is the size of the terminal during the last Flush.
+	lastSize image.Point
 }
 
 // tcellNewScreen can be overridden from tests.
 var tcellNewScreen = tcell.NewScreen
 
+// tcellNewScreenFromTty can be overridden from tests.
+var tcellNewScreenFromTty = tcell.NewTerminfoScreenFromTty
+
 // newTerminal creates the terminal and applies the options.
 func newTerminal(opts ...Option) (*Terminal, error) {
-	screen, err := tcellNewScreen()
-	if err != nil {
-		return nil, fmt.Errorf("tcell.NewScreen => %v", err)
-	}
-
 	t := &Terminal{
 		events:    eventqueue.New(),
 		done:      make(chan struct{}),
@@ -98,12 +174,29 @@ func newTerminal(opts ...Option) (*Terminal, error) {
 			FgColor: cell.ColorDefault,
 			BgColor: cell.ColorDefault,
 		},
-		screen: screen,
+		escapeTimeout: DefaultEscapeTimeout,
+		pending:       map[image.Point]frameCell{},
+		last:          map[image.Point]frameCell{},
 	}
 	for _, opt := range opts {
 		opt.set(t)
 	}
 
+	switch {
+	case t.screen != nil:
+	case t.tty != nil:
+		screen, err := tcellNewScreenFromTty(t.tty)
+		if err != nil {
+			return nil, fmt.Errorf("tcell.NewTerminfoScreenFromTty => %v", err)
+		}
+		t.screen = screen
+	default:
+		screen, err := tcellNewScreen()
+		if err != nil {
+			return nil, fmt.Errorf("tcell.NewScreen => %v", err)
+		}
+		t.screen = screen
+	}
 	return t, nil
 }
 
@@ -129,6 +222,18 @@ func New(opts ...Option) (*Terminal, error) {
 	return t, nil
 }
 
+// Capabilities implements terminalapi.CapabilitiesReporter.Capabilities.
+// The capabilities are determined from the information tcell has about the
+// screen. Bracketed paste is never reported, since this implementation
+// doesn't enable it.
+func (t *Terminal) Capabilities() terminalapi.Capabilities {
+	return terminalapi.Capabilities{
+		Colors:  t.screen.Colors(),
+		Mouse:   t.screen.HasMouse(),
+		Unicode: strings.EqualFold(t.screen.CharacterSet(), "UTF-8"),
+	}
+}
+
 // Size implements terminalapi.Terminal.Size.
 func (t *Terminal) Size() image.Point {
 	w, h := t.screen.Size()
@@ -140,18 +245,48 @@ func (t *Terminal) Size() image.Point {
 
 // Clear implements terminalapi.Terminal.Clear.
 func (t *Terminal) Clear(opts ...cell.Option) error {
-	o := cell.NewOptions(opts...)
+	o := t.cellOpts(opts...)
 	st := cellOptsToStyle(o, t.colorMode)
-	t.screen.Fill(' ', st)
+	if !t.diffFrames {
+		t.screen.Fill(' ', st)
+		return nil
+	}
+
+	size := t.Size()
+	for y := 0; y < size.Y; y++ {
+		for x := 0; x < size.X; x++ {
+			t.pending[image.Point{x, y}] = frameCell{r: ' ', st: st}
+		}
+	}
 	return nil
 }
 
 // Flush implements terminalapi.Terminal.Flush.
 func (t *Terminal) Flush() error {
+	if t.diffFrames {
+		t.flushChanged()
+	}
 	t.screen.Show()
 	return nil
 }
 
+// flushChanged sends the pending cells that differ from the last frame to the
+// screen.
+func (t *Terminal) flushChanged() {
+	if size := t.Size(); size != t.lastSize {
+		t.last = map[image.Point]frameCell{}
+		t.lastSize = size
+	}
+	for p, fc := range t.pending {
+		if last, ok := t.last[p]; ok && last == fc {
+			continue
+		}
+		t.screen.SetContent(p.X, p.Y, fc.r, nil, fc.st)
+		t.last[p] = fc
+	}
+	t.pending = map[image.Point]frameCell{}
+}
+
 // SetCursor implements terminalapi.Terminal.SetCursor.
 func (t *Terminal) SetCursor(p image.Point) {
 	t.screen.ShowCursor(p.X, p.Y)
@@ -164,24 +299,76 @@ func (t *Terminal) HideCursor() {
 
 // SetCell implements terminalapi.Terminal.SetCell.
 func (t *Terminal) SetCell(p image.Point, r rune, opts ...cell.Option) error {
-	o := cell.NewOptions(opts...)
+	o := t.cellOpts(opts...)
 	st := cellOptsToStyle(o, t.colorMode)
+	if t.diffFrames {
+		t.pending[p] = frameCell{r: r, st: st}
+		return nil
+	}
 	t.screen.SetContent(p.X, p.Y, r, nil, st)
 	return nil
 }
 
+// cellOpts returns the options for a cell with the colors left at
+// ColorDefault replaced by the ones from the ClearStyle option.
+func (t *Terminal) cellOpts(opts ...cell.Option) *cell.Options {
+	o := cell.NewOptions(t.clearStyle)
+	o.Merge(cell.NewOptions(opts...))
+	return o
+}
+
 // pollEvents polls and enqueues the input events.
 func (t *Terminal) pollEvents() {
+	tcellEvents := make(chan tcell.Event)
+	go func() {
+		for {
+			ev := t.screen.PollEvent()
+			select {
+			case tcellEvents <- ev:
+			case <-t.done:
+				return
+			}
+		}
+	}()
+
+	var (
+		// escTimer is running while a received Esc key waits for the
+		// next key, see the EscapeTimeout option.
+		escTimer *time.Timer
+		escFired <-chan time.Time
+	)
 	for {
 		select {
 		case <-t.done:
 			return
-		default:
-		}
 
-		events := toTermdashEvents(t.screen.PollEvent())
-		for _, ev := range events {
-			t.events.Push(ev)
+		case <-escFired:
+			escTimer, escFired = nil, nil
+			t.events.Push(&terminalapi.Keyboard{Key: keyboard.KeyEsc})
+
+		case ev := <-tcellEvents:
+			key, isKey := ev.(*tcell.EventKey)
+			if escTimer != nil {
+				escTimer.Stop()
+				escTimer, escFired = nil, nil
+				if isKey && key.Key() == tcell.KeyRune {
+					t.events.Push(&terminalapi.Keyboard{
+						Key: keyboard.Key(key.Rune()),
+						Alt: true,
+					})
+					continue
+				}
+				t.events.Push(&terminalapi.Keyboard{Key: keyboard.KeyEsc})
+			}
+
+			if isKey && key.Key() == tcell.KeyEscape && t.escapeTimeout > 0 {
+				escTimer = time.NewTimer(t.escapeTimeout)
+				escFired = escTimer.C
+				continue
+			}
+			for _, tev := range toTermdashEvents(ev) {
+				t.events.Push(tev)
+			}
 		}
 	}
 }
@@ -15,11 +15,15 @@
 package tcell
 
 import (
+	"context"
+	"image"
 	"testing"
+	"time"
 
 	tcell "github.com/gdamore/tcell/v2"
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/keyboard"
 	"github.com/mum4k/termdash/terminal/terminalapi"
 )
 
@@ -32,7 +36,8 @@ func TestNewTerminalColorMode(t *testing.T) {
 		{
 			desc: "default options",
 			want: &Terminal{
-				colorMode: terminalapi.ColorMode256,
+				colorMode:     terminalapi.ColorMode256,
+				escapeTimeout: DefaultEscapeTimeout,
 			},
 		},
 		{
@@ -41,7 +46,8 @@ func TestNewTerminalColorMode(t *testing.T) {
 				ColorMode(terminalapi.ColorModeNormal),
 			},
 			want: &Terminal{
-				colorMode: terminalapi.ColorModeNormal,
+				colorMode:     terminalapi.ColorModeNormal,
+				escapeTimeout: DefaultEscapeTimeout,
 			},
 		},
 	}
@@ -77,7 +83,8 @@ func TestNewTerminalClearStyle(t *testing.T) {
 		{
 			desc: "default options",
 			want: &Terminal{
-				colorMode: terminalapi.ColorMode256,
+				colorMode:     terminalapi.ColorMode256,
+				escapeTimeout: DefaultEscapeTimeout,
 				clearStyle: &cell.Options{
 					FgColor: cell.ColorDefault,
 					BgColor: cell.ColorDefault,
@@ -90,7 +97,8 @@ func TestNewTerminalClearStyle(t *testing.T) {
 				ClearStyle(cell.ColorRed, cell.ColorBlue),
 			},
 			want: &Terminal{
-				colorMode: terminalapi.ColorMode256,
+				colorMode:     terminalapi.ColorMode256,
+				escapeTimeout: DefaultEscapeTimeout,
 				clearStyle: &cell.Options{
 					FgColor: cell.ColorRed,
 					BgColor: cell.ColorBlue,
@@ -119,3 +127,434 @@ func TestNewTerminalClearStyle(t *testing.T) {
 		})
 	}
 }
+
+// fakeScreen is a simulated tcell screen that reports the provided
+// capabilities.
+type fakeScreen struct {
+	tcell.SimulationScreen
+
+	colors   int
+	hasMouse bool
+}
+
+// Colors implements tcell.Screen.Colors.
+func (fs *fakeScreen) Colors() int {
+	return fs.colors
+}
+
+// HasMouse implements tcell.Screen.HasMouse.
+func (fs *fakeScreen) HasMouse() bool {
+	return fs.hasMouse
+}
+
+func TestCapabilities(t *testing.T) {
+	tests := []struct {
+		desc     string
+		screen   tcell.Screen
+		want     terminalapi.Capabilities
+		wantTrue bool
+	}{
+		{
+			desc: "truecolor and mouse",
+			screen: &fakeScreen{
+				SimulationScreen: tcell.NewSimulationScreen("UTF-8"),
+				colors:           1 << 24,
+				hasMouse:         true,
+			},
+			want: terminalapi.Capabilities{
+				Colors:  terminalapi.TrueColors,
+				Mouse:   true,
+				Unicode: true,
+			},
+			wantTrue: true,
+		},
+		{
+			desc: "256 colors without mouse",
+			screen: &fakeScreen{
+				SimulationScreen: tcell.NewSimulationScreen("UTF-8"),
+				colors:           256,
+			},
+			want: terminalapi.Capabilities{
+				Colors:  256,
+				Unicode: true,
+			},
+		},
+		{
+			desc: "non-unicode character set",
+			screen: &fakeScreen{
+				SimulationScreen: tcell.NewSimulationScreen("US-ASCII"),
+				colors:           8,
+			},
+			want: terminalapi.Capabilities{
+				Colors: 8,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			tcellNewScreen = func() (tcell.Screen, error) { return tc.screen, nil }
+			defer func() {
+				tcellNewScreen = tcell.NewScreen
+			}()
+
+			term, err := newTerminal()
+			if err != nil {
+				t.Fatalf("newTerminal => unexpected error: %v", err)
+			}
+
+			var reporter terminalapi.CapabilitiesReporter = term
+			got := reporter.Capabilities()
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("Capabilities => unexpected diff (-want, +got):\n%s", diff)
+			}
+			if gotTrue := got.TrueColor(); gotTrue != tc.wantTrue {
+				t.Errorf("TrueColor => %v, want %v", gotTrue, tc.wantTrue)
+			}
+		})
+	}
+}
+
+// spyScreen is a simulated tcell screen that counts the cells sent to it.
+type spyScreen struct {
+	tcell.SimulationScreen
+
+	setContent int
+}
+
+// SetContent implements tcell.Screen.SetContent.
+func (ss *spyScreen) SetContent(x, y int, mainc rune, combc []rune, style tcell.Style) {
+	ss.setContent++
+	ss.SimulationScreen.SetContent(x, y, mainc, combc, style)
+}
+
+func TestDiffFrames(t *testing.T) {
+	tests := []struct {
+		desc string
+		opts []Option
+		// frames are the frames drawn, each is a list of cells.
+		frames [][]image.Point
+		// clear indicates whether the frames start by clearing the terminal.
+		clear bool
+		// resize when not zero resizes the terminal before the last frame.
+		resize image.Point
+		// want is the number of cells sent to the screen for each frame.
+		want []int
+	}{
+		{
+			desc:   "without the option every cell is sent",
+			frames: [][]image.Point{{{0, 0}, {1, 0}}, {{0, 0}, {1, 0}}},
+			want:   []int{2, 2},
+		},
+		{
+			desc:   "unchanged cells aren't re-sent",
+			opts:   []Option{DiffFrames()},
+			frames: [][]image.Point{{{0, 0}, {1, 0}}, {{0, 0}, {1, 0}}},
+			want:   []int{2, 0},
+		},
+		{
+			desc:   "only new cells are sent",
+			opts:   []Option{DiffFrames()},
+			frames: [][]image.Point{{{0, 0}, {1, 0}}, {{0, 0}, {1, 0}, {2, 0}}},
+			want:   []int{2, 1},
+		},
+		{
+			desc:   "cleared frames only send the changed cells",
+			opts:   []Option{DiffFrames()},
+			clear:  true,
+			frames: [][]image.Point{{{0, 0}}, {{1, 0}}},
+			// The first frame sends all 3x2 cells, the second one only
+			// blanks cell {0,0} and draws cell {1,0}.
+			want: []int{6, 2},
+		},
+		{
+			desc:   "all cells are re-sent after a resize",
+			opts:   []Option{DiffFrames()},
+			frames: [][]image.Point{{{0, 0}, {1, 0}}, {{0, 0}, {1, 0}}},
+			resize: image.Point{4, 2},
+			want:   []int{2, 2},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			screen := &spyScreen{
+				SimulationScreen: tcell.NewSimulationScreen("UTF-8"),
+			}
+			if err := screen.Init(); err != nil {
+				t.Fatalf("Init => unexpected error: %v", err)
+			}
+			screen.SetSize(3, 2)
+			tcellNewScreen = func() (tcell.Screen, error) { return screen, nil }
+			defer func() {
+				tcellNewScreen = tcell.NewScreen
+			}()
+
+			term, err := newTerminal(tc.opts...)
+			if err != nil {
+				t.Fatalf("newTerminal => unexpected error: %v", err)
+			}
+
+			var got []int
+			for i, frame := range tc.frames {
+				if i == len(tc.frames)-1 && !tc.resize.Eq(image.ZP) {
+					screen.SetSize(tc.resize.X, tc.resize.Y)
+				}
+				screen.setContent = 0
+				if tc.clear {
+					if err := term.Clear(); err != nil {
+						t.Fatalf("Clear => unexpected error: %v", err)
+					}
+				}
+				for _, p := range frame {
+					if err := term.SetCell(p, 'x'); err != nil {
+						t.Fatalf("SetCell => unexpected error: %v", err)
+					}
+				}
+
+				if err := term.Flush(); err != nil {
+					t.Fatalf("Flush => unexpected error: %v", err)
+				}
+				got = append(got, screen.setContent)
+			}
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("cells sent => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEscapeTimeout(t *testing.T) {
+	// step either injects the bytes into the screen or expects the event.
+	type step struct {
+		inject []byte
+		want   terminalapi.Event
+	}
+	const esc = 0x1b
+
+	tests := []struct {
+		desc  string
+		opts  []Option
+		steps []step
+	}{
+		{
+			desc: "lone Esc is reported after the timeout",
+			opts: []Option{EscapeTimeout(10 * time.Millisecond)},
+			steps: []step{
+				{inject: []byte{esc}},
+				{want: &terminalapi.Keyboard{Key: keyboard.KeyEsc}},
+			},
+		},
+		{
+			desc: "fast sequence of Esc and a rune is reported as Alt",
+			opts: []Option{EscapeTimeout(time.Minute)},
+			steps: []step{
+				{inject: []byte{esc}},
+				{inject: []byte("x")},
+				{want: &terminalapi.Keyboard{Key: 'x', Alt: true}},
+			},
+		},
+		{
+			desc: "rune after the timeout isn't Alt",
+			opts: []Option{EscapeTimeout(10 * time.Millisecond)},
+			steps: []step{
+				{inject: []byte{esc}},
+				{want: &terminalapi.Keyboard{Key: keyboard.KeyEsc}},
+				{inject: []byte("x")},
+				{want: &terminalapi.Keyboard{Key: 'x'}},
+			},
+		},
+		{
+			desc: "Esc followed by a key that isn't a rune",
+			opts: []Option{EscapeTimeout(time.Minute)},
+			steps: []step{
+				{inject: []byte{esc}},
+				{inject: []byte{'\r'}},
+				{want: &terminalapi.Keyboard{Key: keyboard.KeyEsc}},
+				{want: &terminalapi.Keyboard{Key: keyboard.KeyEnter}},
+			},
+		},
+		{
+			desc: "two Esc keys",
+			opts: []Option{EscapeTimeout(10 * time.Millisecond)},
+			steps: []step{
+				{inject: []byte{esc, esc}},
+				{want: &terminalapi.Keyboard{Key: keyboard.KeyEsc}},
+				{want: &terminalapi.Keyboard{Key: keyboard.KeyEsc}},
+			},
+		},
+		{
+			desc: "zero timeout reports Esc immediately",
+			opts: []Option{EscapeTimeout(0)},
+			steps: []step{
+				{inject: []byte{esc}},
+				{inject: []byte("x")},
+				{want: &terminalapi.Keyboard{Key: keyboard.KeyEsc}},
+				{want: &terminalapi.Keyboard{Key: 'x'}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			screen := tcell.NewSimulationScreen("UTF-8")
+			tcellNewScreen = func() (tcell.Screen, error) { return screen, nil }
+			defer func() {
+				tcellNewScreen = tcell.NewScreen
+			}()
+
+			term, err := New(tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			defer term.Close()
+
+			for _, s := range tc.steps {
+				if s.inject != nil {
+					screen.InjectKeyBytes(s.inject)
+					continue
+				}
+
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				got := term.Event(ctx)
+				cancel()
+				if diff := pretty.Compare(s.want, got); diff != "" {
+					t.Errorf("Event => unexpected diff (-want, +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestScreen(t *testing.T) {
+	screen := tcell.NewSimulationScreen("UTF-8")
+	tcellNewScreen = func() (tcell.Screen, error) {
+		t.Fatalf("tcell.NewScreen called, the provided screen should be used")
+		return nil, nil
+	}
+	defer func() {
+		tcellNewScreen = tcell.NewScreen
+	}()
+
+	term, err := New(Screen(screen))
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+	defer term.Close()
+
+	screen.SetSize(3, 2)
+	if err := term.SetCell(image.Point{1, 1}, 'x', cell.FgColor(cell.ColorRed)); err != nil {
+		t.Fatalf("SetCell => unexpected error: %v", err)
+	}
+	if err := term.Flush(); err != nil {
+		t.Fatalf("Flush => unexpected error: %v", err)
+	}
+
+	cells, width, height := screen.GetContents()
+	if width != 3 || height != 2 {
+		t.Fatalf("GetContents => size %dx%d, want 3x2", width, height)
+	}
+	got := cells[1*width+1]
+	if want := []rune{'x'}; string(got.Runes) != string(want) {
+		t.Errorf("GetContents => cell (1, 1) has runes %q, want %q", got.Runes, want)
+	}
+	if fg, _, _ := got.Style.Decompose(); fg != tcell.ColorRed {
+		t.Errorf("GetContents => cell (1, 1) has foreground %v, want %v", fg, tcell.ColorRed)
+	}
+	if got := cells[0].Runes; string(got) != " " {
+		t.Errorf("GetContents => cell (0, 0) has runes %q, want %q", got, " ")
+	}
+}
+
+func TestClearStyleColors(t *testing.T) {
+	tests := []struct {
+		desc string
+		opts []Option
+	}{
+		{
+			desc: "sends cells immediately",
+		},
+		{
+			desc: "sends changed cells on flush",
+			opts: []Option{DiffFrames()},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			screen := tcell.NewSimulationScreen("UTF-8")
+			opts := append([]Option{
+				Screen(screen),
+				ClearStyle(cell.ColorRed, cell.ColorBlue),
+			}, tc.opts...)
+			term, err := New(opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			defer term.Close()
+
+			screen.SetSize(3, 1)
+			if err := term.Clear(); err != nil {
+				t.Fatalf("Clear => unexpected error: %v", err)
+			}
+			if err := term.SetCell(image.Point{1, 0}, 'x', cell.Bold()); err != nil {
+				t.Fatalf("SetCell => unexpected error: %v", err)
+			}
+			if err := term.SetCell(image.Point{2, 0}, 'y', cell.BgColor(cell.ColorGreen)); err != nil {
+				t.Fatalf("SetCell => unexpected error: %v", err)
+			}
+			if err := term.Flush(); err != nil {
+				t.Fatalf("Flush => unexpected error: %v", err)
+			}
+
+			cells, _, _ := screen.GetContents()
+			for i, want := range []struct {
+				fg, bg tcell.Color
+			}{
+				{tcell.ColorRed, tcell.ColorBlue},  // Cleared.
+				{tcell.ColorRed, tcell.ColorBlue},  // Set with default colors.
+				{tcell.ColorRed, tcell.ColorGreen}, // Set with a background color.
+			} {
+				fg, bg, _ := cells[i].Style.Decompose()
+				if fg != want.fg || bg != want.bg {
+					t.Errorf("GetContents => cell (%d, 0) has colors fg:%v bg:%v, want fg:%v bg:%v", i, fg, bg, want.fg, want.bg)
+				}
+			}
+		})
+	}
+}
+
+// fakeTty is a tcell.Tty that is never read from or written to.
+type fakeTty struct {
+	tcell.Tty
+}
+
+func TestTty(t *testing.T) {
+	tty := &fakeTty{}
+	screen := tcell.NewSimulationScreen("UTF-8")
+
+	var gotTty tcell.Tty
+	tcellNewScreen = func() (tcell.Screen, error) {
+		t.Fatalf("tcell.NewScreen called, the screen should be created from the tty")
+		return nil, nil
+	}
+	tcellNewScreenFromTty = func(tty tcell.Tty) (tcell.Screen, error) {
+		gotTty = tty
+		return screen, nil
+	}
+	defer func() {
+		tcellNewScreen = tcell.NewScreen
+		tcellNewScreenFromTty = tcell.NewTerminfoScreenFromTty
+	}()
+
+	term, err := newTerminal(Tty(tty))
+	if err != nil {
+		t.Fatalf("newTerminal => unexpected error: %v", err)
+	}
+	if gotTty != tty {
+		t.Errorf("newTerminal => screen created from tty %v, want %v", gotTty, tty)
+	}
+	if term.screen != screen {
+		t.Errorf("newTerminal => uses screen %v, want the one created from the tty", term.screen)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terminalapi
+
+// capabilities.go defines the capabilities reported by terminals.
+
+// TrueColors is the number of colors supported by terminals that can display
+// 24-bit colors.
+const TrueColors = 1 << 24
+
+// Capabilities describe the features supported by a terminal.
+type Capabilities struct {
+	// Colors is the number of colors the terminal can display, e.g. 8, 256
+	// or TrueColors.
+	Colors int
+
+	// Mouse indicates whether the terminal reports mouse events.
+	Mouse bool
+
+	// BracketedPaste indicates whether pasted text is reported as a bracketed
+	// paste, i.e. distinguished from typed text.
+	BracketedPaste bool
+
+	// Unicode indicates whether the terminal uses a Unicode character set and
+	// can display characters outside of ASCII.
+	Unicode bool
+}
+
+// TrueColor asserts whether the terminal can display 24-bit colors.
+func (c Capabilities) TrueColor() bool {
+	return c.Colors >= TrueColors
+}
+
+// CapabilitiesReporter is implemented by terminals that are able to report
+// their capabilities, so that applications can adapt to them at startup.
+//
+// This is an optional interface, terminals don't have to implement it.
+type CapabilitiesReporter interface {
+	// Capabilities returns the features supported by the terminal.
+	Capabilities() Capabilities
+}
@@ -35,12 +35,18 @@ type Event interface {
 type Keyboard struct {
 	// Key is the pressed key.
 	Key keyboard.Key
+	// Alt indicates that the key was pressed together with the Alt key.
+	// Not all terminal implementations report this.
+	Alt bool
 }
 
 func (*Keyboard) isEvent() {}
 
 // String implements fmt.Stringer.
 func (k Keyboard) String() string {
+	if k.Alt {
+		return fmt.Sprintf("Keyboard{Key: %v, Alt}", k.Key)
+	}
 	return fmt.Sprintf("Keyboard{Key: %v}", k.Key)
 }
 
@@ -49,13 +55,20 @@ func (k Keyboard) String() string {
 type Resize struct {
 	// Size is the new size of the terminal.
 	Size image.Point
+	// Previous is the size of the terminal before the resize.
+	// Terminal implementations don't populate this, it is filled in by
+	// termdash before the event is forwarded to the ResizeSubscriber.
+	Previous image.Point
 }
 
 func (*Resize) isEvent() {}
 
 // String implements fmt.Stringer.
 func (r Resize) String() string {
-	return fmt.Sprintf("Resize{Size: %v}", r.Size)
+	if r.Previous == (image.Point{}) {
+		return fmt.Sprintf("Resize{Size: %v}", r.Size)
+	}
+	return fmt.Sprintf("Resize{Size: %v, Previous: %v}", r.Size, r.Previous)
 }
 
 // Mouse is the event used when the mouse is moved or a mouse button is
@@ -76,31 +89,46 @@ func (m Mouse) String() string {
 }
 
 // Error is an event indicating an error while processing input.
-type Error string
+// The event can wrap other errors, see NewErrorf.
+type Error struct {
+	// err is the error that occurred or nil if there is none.
+	err error
+}
 
 // NewError returns a new Error event.
 func NewError(e string) *Error {
-	err := Error(e)
-	return &err
+	if e == "" {
+		return &Error{}
+	}
+	return &Error{err: errors.New(e)}
 }
 
-// NewErrorf returns a new Error event, arguments are similar to fmt.Sprintf.
+// NewErrorf returns a new Error event, arguments are similar to fmt.Errorf.
+// Errors provided with the %w verb are wrapped, so that they can be inspected
+// using errors.Is and errors.As.
 func NewErrorf(format string, args ...interface{}) *Error {
-	err := Error(fmt.Sprintf(format, args...))
-	return &err
+	return &Error{err: fmt.Errorf(format, args...)}
 }
 
 func (*Error) isEvent() {}
 
 // Error returns the error that occurred.
 func (e *Error) Error() error {
-	if e == nil || *e == "" {
+	if e == nil {
 		return nil
 	}
-	return errors.New(string(*e))
+	return e.err
+}
+
+// Unwrap returns the error that occurred, same as Error.
+func (e *Error) Unwrap() error {
+	return e.Error()
 }
 
 // String implements fmt.Stringer.
 func (e Error) String() string {
-	return string(e)
+	if e.err == nil {
+		return ""
+	}
+	return e.err.Error()
 }
@@ -16,8 +16,10 @@
 package widgetapi
 
 import (
+	"fmt"
 	"image"
 
+	"github.com/mum4k/termdash/cell"
 	"github.com/mum4k/termdash/private/canvas"
 	"github.com/mum4k/termdash/terminal/terminalapi"
 )
@@ -112,10 +114,23 @@ type Options struct {
 	// canvas of any ratio.
 	Ratio image.Point
 
+	// RatioFill is the background color the container fills the part of
+	// its area that isn't covered by the widget's canvas with when the
+	// widget requests a Ratio, i.e. the letterbox around the canvas.
+	// The zero value i.e. cell.ColorDefault leaves that area empty.
+	// Has no effect if the widget doesn't request a Ratio.
+	RatioFill cell.Color
+
 	// MinimumSize allows a widget to specify the smallest allowed canvas size.
 	// If the terminal size and/or splits cause the assigned canvas to be
 	// smaller than this, the widget will be skipped. I.e. The Draw() method
 	// won't be called until a resize above the specified minimum.
+	// Each axis is honored independently, setting any of the two coordinates
+	// to zero indicates no minimum on that axis.
+	// While the widget is skipped, the container draws a resize indicator.
+	// If the container is too small on only one of the axes, the indicator
+	// is placed in a pane with the widget's minimum size on the other axis,
+	// positioned according to the container's alignment options.
 	MinimumSize image.Point
 
 	// MaximumSize allows a widget to specify the largest allowed canvas size.
@@ -123,6 +138,9 @@ type Options struct {
 	// than this, the widget will only receive a canvas of this size within its
 	// container. Setting any of the two coordinates to zero indicates
 	// unlimited.
+	// The canvas is positioned within the container according to the
+	// container's alignment options, it is centered by default and the rest
+	// of the container is left empty.
 	MaximumSize image.Point
 
 	// WantKeyboard allows a widget to request keyboard events and specify
@@ -144,12 +162,68 @@ type Options struct {
 	// if it falls onto its canvas. See the documentation next to individual
 	// MouseScope values for details.
 	WantMouse MouseScope
+
+	// WantMouseMove allows a widget to request mouse motion events, i.e.
+	// events generated when the mouse moves while no mouse button is pressed.
+	// These are delivered as mouse events with the mouse.ButtonRelease button
+	// and follow the same scope rules as other mouse events, see WantMouse.
+	// Widgets that don't set this only receive the mouse.ButtonRelease event
+	// that follows a press of a mouse button.
+	// Has no effect if WantMouse is set to MouseScopeNone.
+	WantMouseMove bool
+
+	// SuppressContainerFocusBorder allows a widget that draws its own focus
+	// indicator to request that its container doesn't indicate the focus on
+	// its border. When set to true, the container draws its border the same
+	// way regardless of whether it is focused, i.e. it doesn't use the
+	// focused border color or the line style provided via the BorderOnFocus
+	// container option. Doesn't affect the tracking of the focus.
+	SuppressContainerFocusBorder bool
 }
 
 // Meta provide additional metadata to widgets.
 type Meta struct {
 	// Focused asserts whether the widget's container is focused.
 	Focused bool
+
+	// Epoch identifies the layout the widget is drawn in. The infrastructure
+	// starts a new epoch, i.e. changes this value, whenever the canvas
+	// provided to Draw changes its size or position, e.g. when the terminal
+	// is resized, and after the container tree is updated.
+	//
+	// Widgets can use this to cache expensive computations that only depend
+	// on the canvas and on the widget's own data, e.g. the scales of axes.
+	// Such widgets should key their cache by the epoch together with a version
+	// of their data that they increment on every write and reuse the cache on
+	// subsequent calls to Draw while both stay the same. Widgets must still
+	// draw their entire content onto the provided canvas on every call.
+	//
+	// The zero value indicates that the epoch isn't known, e.g. when the
+	// widget is drawn outside of a container. Widgets must not reuse cached
+	// results in that case.
+	Epoch uint64
+
+	// cursor is the position of the terminal cursor requested by the widget.
+	cursor *image.Point
+}
+
+// SetCursor can be called by a widget from within Draw to request that the
+// terminal cursor is placed onto the specified point of the provided canvas,
+// e.g. at the edit position of a text input field. The request is only
+// honoured if the widget's container is focused and the point falls onto the
+// canvas. The terminal cursor is hidden if the focused widget doesn't request
+// its position.
+func (m *Meta) SetCursor(p image.Point) {
+	m.cursor = &p
+}
+
+// Cursor returns the position of the terminal cursor requested by the widget
+// by calling SetCursor and true if the position was requested.
+func (m *Meta) Cursor() (image.Point, bool) {
+	if m.cursor == nil {
+		return image.ZP, false
+	}
+	return *m.cursor, true
 }
 
 // EventMeta provides additional metadata about events to widgets.
@@ -200,3 +274,150 @@ type Widget interface {
 	// Draw.
 	Options() Options
 }
+
+// Closer is an optional interface that widgets can implement in order to
+// release resources they own (e.g. goroutines or timers) when they are
+// removed from the dashboard.
+type Closer interface {
+	// Close is called after the widget was detached from the container tree
+	// by a call to container.Update, i.e. when it was replaced by another
+	// widget or when its container was removed or split. It is called once
+	// the update including any resulting changes of the focused container
+	// completed and after the container released its lock, so the widget can
+	// safely call container methods.
+	//
+	// The infrastructure doesn't call any methods of the widget after Close
+	// unless the widget is placed into a container again.
+	//
+	// If Close returns an error, it is returned from container.Update.
+	Close() error
+}
+
+// ContentMeasurer is an optional interface that widgets can implement in
+// order to report the size of their content. Containers that display widgets
+// on a virtual canvas larger than the visible area, e.g. to allow scrolling,
+// can use it to size the virtual canvas.
+type ContentMeasurer interface {
+	// ContentSize returns the size in cells the widget needs to draw all of
+	// its current content on a canvas of the specified width. The returned
+	// width can exceed the provided width if the content cannot be narrowed
+	// down, e.g. when lines are trimmed instead of wrapped.
+	//
+	// The returned size can change each time the content of the widget
+	// changes.
+	ContentSize(width int) image.Point
+}
+
+// Focuser is an optional interface that widgets can implement in order to be
+// notified when their container gains or loses the keyboard focus. Widgets
+// can use it e.g. to start or stop blinking the cursor or to validate their
+// content when the user moves away.
+//
+// The methods are called after the focus moved, before the keyboard or mouse
+// event that moved the focus is delivered to any widgets. When the focus
+// moves from one widget to another, OnBlur of the widget losing the focus is
+// called before OnFocus of the widget gaining it. The container doesn't hold
+// its lock while calling these methods, so the widget can safely call
+// container methods. Widgets don't get notified about the focus set when the
+// container is created.
+//
+// The focus also moves when the container is drawn after a resize hid the
+// focused container, see container.HideBelowSize. Termdash holds its lock
+// while drawing, so the widget must not call termdash.Controller.Redraw from
+// these methods, that would deadlock.
+//
+// Implementations must be thread safe.
+type Focuser interface {
+	// OnFocus is called when the widget's container becomes focused.
+	OnFocus()
+	// OnBlur is called when the widget's container stops being focused.
+	OnBlur()
+}
+
+// FocusGuard is an optional interface that widgets can implement in order to
+// veto losing the keyboard focus, e.g. a text input in the middle of an
+// invalid entry might want to keep the focus until the entry is fixed.
+//
+// The container calls CanBlur before moving the focus away from the widget's
+// container in response to user input, i.e. on a mouse click into another
+// container or when a key that moves the focus is pressed. If CanBlur returns
+// false, the focus stays. The focus still moves when the container tree is
+// changed programmatically, e.g. when the focused container is removed by
+// container.Update.
+//
+// This can trap the focus, the user has no way of moving it away while
+// CanBlur returns false. Use carefully and give the user a way out, e.g. a
+// key that cancels the entry.
+//
+// CanBlur is called while the container holds its lock, so the widget must
+// not call container methods from it. Implementations must be thread safe.
+type FocusGuard interface {
+	// CanBlur returns true if the widget's container can lose the focus.
+	CanBlur() bool
+}
+
+// Ticker is an optional interface that widgets can implement in order to
+// receive steady ticks independent of input events, e.g. to advance an
+// animation or to blink a cursor without wiring their own timers.
+//
+// Tick is called on each periodic redraw of the dashboard, i.e. once every
+// RedrawInterval when using termdash.Run, or on each call to Redraw when
+// using the termdash.Controller. Redraws triggered by input events don't
+// cause ticks. Tick is called right before the widgets are drawn and never
+// concurrently with Draw, so the widget can prepare the next frame in Tick.
+// It can still be called concurrently with Keyboard, Mouse and with the
+// widget's own API, the widget must protect the state shared with these.
+// The container doesn't hold its lock while calling Tick, so the widget can
+// safely call container methods. Termdash does hold its own lock while
+// calling Tick to keep it from running concurrently with Draw, so the widget
+// must not call termdash.Controller.Redraw from Tick, that would deadlock.
+//
+// If Tick returns an error, the redraw fails and the error is reported like
+// any other error returned by Draw.
+type Ticker interface {
+	// Tick advances the state of the widget by one tick.
+	Tick() error
+}
+
+// Dirtier is an optional interface that widgets can implement in order to
+// avoid being redrawn when their content didn't change, e.g. on large
+// dashboards where most of the widgets are static between frames.
+//
+// Before drawing a widget that implements Dirtier, the container calls Dirty.
+// If it returns false and neither the area of the widget's canvas nor the
+// focus of its container changed since the last call to Draw, the container
+// doesn't call Draw and puts the content the widget drew last time onto the
+// terminal instead. The widget is always drawn the first time and after the
+// container layout was updated.
+type Dirtier interface {
+	// Dirty returns true if the content the widget would draw changed since
+	// the last call to Draw, e.g. because it received new data or an input
+	// event.
+	Dirty() bool
+}
+
+// Error is an error returned by a widget, annotated with the ID of the
+// container the widget is placed in. The container wraps the errors returned
+// from the widget's Draw, Keyboard, Mouse and Tick methods, so that the error
+// handler can tell which widget failed. The errors reported by termdash and
+// the terminalapi.Error events wrap it, use errors.As to retrieve it.
+type Error struct {
+	// ContainerID is the ID of the container set via the container.ID
+	// option. Empty if the container doesn't have an ID.
+	ContainerID string
+	// Err is the error returned by the widget.
+	Err error
+}
+
+// Error implements error.Error.
+func (e *Error) Error() string {
+	if e.ContainerID == "" {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("container %q: %v", e.ContainerID, e.Err)
+}
+
+// Unwrap returns the error returned by the widget.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
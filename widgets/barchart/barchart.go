@@ -22,6 +22,7 @@ import (
 	"image"
 	"math"
 	"sync"
+	"time"
 
 	"github.com/mum4k/termdash/align"
 	"github.com/mum4k/termdash/cell"
@@ -29,6 +30,7 @@ import (
 	"github.com/mum4k/termdash/private/area"
 	"github.com/mum4k/termdash/private/canvas"
 	"github.com/mum4k/termdash/private/draw"
+	"github.com/mum4k/termdash/private/runewidth"
 	"github.com/mum4k/termdash/terminal/terminalapi"
 	"github.com/mum4k/termdash/widgetapi"
 )
@@ -47,6 +49,13 @@ type BarChart struct {
 	// vertical space.
 	max int
 
+	// animFrom are the ratios of the bars to the maximum value as they were
+	// displayed when the values were last set. When animated, the bars
+	// ease from these towards the values.
+	animFrom []float32
+	// animStart is the time when the values were last set.
+	animStart time.Time
+
 	// lastWidth is the width of the canvas as of the last time when Draw was called.
 	lastWidth int
 
@@ -71,13 +80,17 @@ func New(opts ...Option) (*BarChart, error) {
 	}, nil
 }
 
+// timeNow is a function that returns the current time.
+// Exists so it can be replaced in tests.
+var timeNow = time.Now
+
 // Draw draws the BarChart widget onto the canvas.
 // Implements widgetapi.Widget.Draw.
 func (bc *BarChart) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 	bc.mu.Lock()
 	defer bc.mu.Unlock()
 
-	bc.lastWidth = cvs.Area().Dx()
+	bc.lastWidth = cvs.Area().Dx() - bc.axisWidth()
 	needAr, err := area.FromSize(bc.minSize())
 	if err != nil {
 		return err
@@ -86,8 +99,13 @@ func (bc *BarChart) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 		return draw.ResizeNeeded(cvs)
 	}
 
+	if err := bc.drawAxis(cvs); err != nil {
+		return err
+	}
+
+	now := timeNow()
 	for i, v := range bc.values {
-		r, err := bc.barRect(cvs, i, v)
+		r, err := bc.barRectRatio(cvs, i, bc.ratio(i, now))
 		if err != nil {
 			return err
 		}
@@ -102,7 +120,12 @@ func (bc *BarChart) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 		}
 
 		if bc.opts.showValues {
-			if err := bc.drawText(cvs, i, fmt.Sprint(bc.values[i]), bc.valColor(i), insideBar); err != nil {
+			text := fmt.Sprintf(bc.opts.valueFormat, v)
+			if bc.opts.valuePlace == ValuePlacementBottom {
+				if err := bc.drawText(cvs, i, text, bc.valColor(i), insideBar); err != nil {
+					return err
+				}
+			} else if err := bc.drawTopValue(cvs, i, r, text); err != nil {
 				return err
 			}
 		}
@@ -117,6 +140,72 @@ func (bc *BarChart) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 	return nil
 }
 
+// axisTicks returns the values of the ticks on the value axis in the
+// descending order. Returns nil if the value axis isn't displayed.
+func (bc *BarChart) axisTicks() []int {
+	if bc.opts.axisTicks == 0 || len(bc.values) == 0 {
+		return nil
+	}
+
+	var ticks []int
+	for i := bc.opts.axisTicks - 1; i >= 0; i-- {
+		ticks = append(ticks, bc.max*i/(bc.opts.axisTicks-1))
+	}
+	return ticks
+}
+
+// axisWidth returns the width of the value axis including the axis line.
+// Returns zero if the value axis isn't displayed.
+func (bc *BarChart) axisWidth() int {
+	ticks := bc.axisTicks()
+	if len(ticks) == 0 {
+		return 0
+	}
+
+	var labelW int
+	for _, t := range ticks {
+		if w := runewidth.StringWidth(fmt.Sprintf(bc.opts.valueFormat, t)); w > labelW {
+			labelW = w
+		}
+	}
+	return labelW + 1 // One cell for the axis line.
+}
+
+// drawAxis draws the value axis, if one was requested.
+func (bc *BarChart) drawAxis(cvs *canvas.Canvas) error {
+	ticks := bc.axisTicks()
+	if len(ticks) == 0 {
+		return nil
+	}
+
+	lineX := bc.axisWidth() - 1
+	maxY := bc.barsMaxY(cvs)
+	if err := draw.HVLines(cvs, []draw.HVLine{
+		{Start: image.Point{lineX, cvs.Area().Min.Y}, End: image.Point{lineX, maxY - 1}},
+	}); err != nil {
+		return err
+	}
+
+	lastY := -1
+	for _, t := range ticks {
+		y := maxY - bc.barHeight(cvs, float32(t)/float32(bc.max))
+		if y >= maxY {
+			y = maxY - 1 // The zero tick is on the bottom row.
+		}
+		if y == lastY {
+			continue
+		}
+		lastY = y
+
+		label := fmt.Sprintf(bc.opts.valueFormat, t)
+		start := image.Point{lineX - runewidth.StringWidth(label), y}
+		if err := draw.Text(cvs, label, start); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // textLoc represents the location of the drawn text.
 type textLoc int
 
@@ -157,6 +246,47 @@ func (bc *BarChart) drawText(cvs *canvas.Canvas, i int, text string, color cell.
 	)
 }
 
+// drawTopValue draws the provided text inside the top of or above the i-th bar
+// which occupies the rectangle r, according to the ValuePlacement option.
+func (bc *BarChart) drawTopValue(cvs *canvas.Canvas, i int, r image.Rectangle, text string) error {
+	var (
+		y      int
+		inside bool
+	)
+	switch bc.opts.valuePlace {
+	case ValuePlacementTop:
+		if r.Dy() > 0 {
+			y, inside = r.Min.Y, true
+		} else {
+			y = r.Min.Y - 1
+		}
+	case ValuePlacementAbove:
+		y = r.Min.Y - 1
+		if y < cvs.Area().Min.Y {
+			y, inside = r.Min.Y, true
+		}
+	}
+
+	color := DefaultValueColor
+	switch {
+	case len(bc.opts.valueColors) > i:
+		color = bc.opts.valueColors[i]
+	case inside:
+		color = cell.Contrast(bc.barColor(i))
+	}
+
+	row := image.Rect(r.Min.X, y, r.Max.X, y+1)
+	start, err := alignfor.Text(row, text, align.HorizontalCenter, align.VerticalTop)
+	if err != nil {
+		return err
+	}
+	return draw.Text(cvs, text, start,
+		draw.TextCellOpts(cell.FgColor(color)),
+		draw.TextMaxX(row.Max.X),
+		draw.TextOverrunMode(draw.OverrunModeThreeDot),
+	)
+}
+
 // barWidth determines the width of a single bar based on options and the canvas.
 func (bc *BarChart) barWidth(cvs *canvas.Canvas) int {
 	if len(bc.values) == 0 {
@@ -170,40 +300,71 @@ func (bc *BarChart) barWidth(cvs *canvas.Canvas) int {
 
 	gaps := len(bc.values) - 1
 	gapW := gaps * bc.opts.barGap
-	rem := cvs.Area().Dx() - gapW
+	rem := cvs.Area().Dx() - bc.axisWidth() - gapW
 	return rem / len(bc.values)
 }
 
-// barHeight determines the height of the i-th bar based on the value it is displaying.
-func (bc *BarChart) barHeight(cvs *canvas.Canvas, i, value int) int {
+// barHeight determines the height of a bar based on its ratio to the maximum
+// value.
+func (bc *BarChart) barHeight(cvs *canvas.Canvas, ratio float32) int {
 	available := cvs.Area().Dy()
 	if len(bc.opts.labels) > 0 {
 		// One line for the bar labels.
 		available--
 	}
-
-	ratio := float32(value) / float32(bc.max)
 	return int(float32(available) * ratio)
 }
 
+// ratio returns the ratio of the i-th bar to the maximum value as it should be
+// displayed at the specified time. This is the ratio of the value of the bar,
+// unless the bar is still being animated towards it.
+func (bc *BarChart) ratio(i int, now time.Time) float32 {
+	target := float32(bc.values[i]) / float32(bc.max)
+	if bc.opts.animateDur <= 0 || i >= len(bc.animFrom) {
+		return target
+	}
+
+	progress := float32(now.Sub(bc.animStart)) / float32(bc.opts.animateDur)
+	if progress >= 1 {
+		return target
+	}
+	if progress < 0 {
+		progress = 0
+	}
+	from := bc.animFrom[i]
+	return from + (target-from)*progress
+}
+
 // barRect returns a rectangle that represents the i-th bar on the canvas that
 // displays the specified value.
 func (bc *BarChart) barRect(cvs *canvas.Canvas, i, value int) (image.Rectangle, error) {
+	return bc.barRectRatio(cvs, i, float32(value)/float32(bc.max))
+}
+
+// barRectRatio returns a rectangle that represents the i-th bar on the canvas
+// that has the specified ratio to the maximum value.
+func (bc *BarChart) barRectRatio(cvs *canvas.Canvas, i int, ratio float32) (image.Rectangle, error) {
 	bw := bc.barWidth(cvs)
-	minX := bw * i
+	minX := bc.axisWidth() + bw*i
 	if i > 0 {
 		minX += bc.opts.barGap * i
 	}
 	maxX := minX + bw
 
-	bh := bc.barHeight(cvs, i, value)
+	bh := bc.barHeight(cvs, ratio)
+	maxY := bc.barsMaxY(cvs)
+	minY := maxY - bh
+	return image.Rect(minX, minY, maxX, maxY), nil
+}
+
+// barsMaxY returns the Y coordinate just under the bottom of the bars.
+func (bc *BarChart) barsMaxY(cvs *canvas.Canvas) int {
 	maxY := cvs.Area().Max.Y
 	if len(bc.opts.labels) > 0 {
 		// One line for the bar labels.
 		maxY--
 	}
-	minY := maxY - bh
-	return image.Rect(minX, minY, maxX, maxY), nil
+	return maxY
 }
 
 // barColor safely determines the color for the i-th bar.
@@ -260,6 +421,10 @@ func (bc *BarChart) ValueCapacity() int {
 // be less or equal the maximum value. A bar displaying the maximum value is a
 // full bar, taking all available vertical space.
 // Provided options override values set when New() was called.
+//
+// If the AnimateDuration option was provided, the bars ease from their
+// currently displayed heights towards the new values. Bars that weren't
+// displayed before start from zero.
 func (bc *BarChart) Values(values []int, max int, opts ...Option) error {
 	bc.mu.Lock()
 	defer bc.mu.Unlock()
@@ -271,9 +436,19 @@ func (bc *BarChart) Values(values []int, max int, opts ...Option) error {
 		return err
 	}
 
+	now := timeNow()
+	from := make([]float32, len(v))
+	for i := range bc.values {
+		if i < len(from) {
+			from[i] = bc.ratio(i, now)
+		}
+	}
+
 	for _, opt := range opts {
 		opt.set(bc.opts)
 	}
+	bc.animFrom = from
+	bc.animStart = now
 	bc.values = v
 	bc.max = max
 	return nil
@@ -300,7 +475,7 @@ func (bc *BarChart) Options() widgetapi.Options {
 	// never update bc.lastWidth and the result of ValueCapacity().
 	// Draw will stil refuse to draw if the canvas is too small, but the user
 	// will have an option to send less values.
-	min.X = bc.minBarWidth()
+	min.X = bc.axisWidth() + bc.minBarWidth()
 
 	return widgetapi.Options{
 		MinimumSize:  min,
@@ -333,7 +508,7 @@ func (bc *BarChart) minSize() image.Point {
 		minHeight++ // One line for the labels.
 	}
 
-	minWidth := bars*bc.minBarWidth() + (bars-1)*bc.opts.barGap
+	minWidth := bc.axisWidth() + bars*bc.minBarWidth() + (bars-1)*bc.opts.barGap
 	return image.Point{minWidth, minHeight}
 }
 
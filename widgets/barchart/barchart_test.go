@@ -17,6 +17,7 @@ package barchart
 import (
 	"image"
 	"testing"
+	"time"
 
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/mum4k/termdash/cell"
@@ -69,6 +70,20 @@ func TestBarChart(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			desc: "fails on negative animation duration",
+			opts: []Option{
+				AnimateDuration(-1 * time.Second),
+			},
+			update: func(bc *BarChart) error {
+				return nil
+			},
+			canvas: image.Rect(0, 0, 3, 10),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
 		{
 			desc: "draws empty for no values",
 			opts: []Option{
@@ -486,6 +501,76 @@ func TestBarChart(t *testing.T) {
 			},
 			wantCapacity: 2,
 		},
+		{
+			desc: "draws resize needed character when the set bar width and gap don't fit",
+			opts: []Option{
+				Char('o'),
+				BarGap(2),
+				BarWidth(2),
+			},
+			update: func(bc *BarChart) error {
+				return bc.Values([]int{5, 3}, 10)
+			},
+			canvas: image.Rect(0, 0, 5, 10),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustResizeNeeded(c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 1,
+		},
+		{
+			desc: "labels and values are centered on bars with set width and gap",
+			opts: []Option{
+				Char('o'),
+				BarGap(1),
+				BarWidth(3),
+				ShowValues(),
+				Labels([]string{
+					"a",
+					"b",
+				}),
+			},
+			update: func(bc *BarChart) error {
+				return bc.Values([]int{5, 9}, 10)
+			},
+			canvas: image.Rect(0, 0, 9, 11),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 5, 3, 10),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testdraw.MustRectangle(c, image.Rect(4, 1, 7, 10),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+
+				// Values.
+				testdraw.MustText(c, "5", image.Point{1, 9}, draw.TextCellOpts(
+					cell.FgColor(DefaultValueColor),
+				))
+				testdraw.MustText(c, "9", image.Point{5, 9}, draw.TextCellOpts(
+					cell.FgColor(DefaultValueColor),
+				))
+
+				// Labels.
+				testdraw.MustText(c, "a", image.Point{1, 10}, draw.TextCellOpts(
+					cell.FgColor(DefaultLabelColor),
+				))
+				testdraw.MustText(c, "b", image.Point{5, 10}, draw.TextCellOpts(
+					cell.FgColor(DefaultLabelColor),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 2,
+		},
 		{
 			desc: "respects bar and label colors",
 			opts: []Option{
@@ -635,6 +720,449 @@ func TestBarChart(t *testing.T) {
 			},
 			wantCapacity: 4,
 		},
+		{
+			desc: "fails on empty value format",
+			opts: []Option{
+				ValueFormat(""),
+			},
+			update: func(bc *BarChart) error {
+				return nil
+			},
+			canvas: image.Rect(0, 0, 3, 10),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc: "fails on unsupported value placement",
+			opts: []Option{
+				PlaceValues(ValuePlacement(-1)),
+			},
+			update: func(bc *BarChart) error {
+				return nil
+			},
+			canvas: image.Rect(0, 0, 3, 10),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc: "displays values inside the top of the bars",
+			opts: []Option{
+				Char('o'),
+				BarWidth(2),
+				ShowValues(),
+				PlaceValues(ValuePlacementTop),
+			},
+			update: func(bc *BarChart) error {
+				return bc.Values([]int{0, 2, 5}, 5)
+			},
+			canvas: image.Rect(0, 0, 8, 5),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(3, 3, 5, 5),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testdraw.MustRectangle(c, image.Rect(6, 0, 8, 5),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testdraw.MustText(c, "0", image.Point{0, 4}, draw.TextCellOpts(
+					cell.FgColor(DefaultValueColor),
+				))
+				testdraw.MustText(c, "2", image.Point{3, 3}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorWhite),
+				))
+				testdraw.MustText(c, "5", image.Point{6, 0}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorWhite),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 3,
+		},
+		{
+			desc: "displays values above the bars",
+			opts: []Option{
+				Char('o'),
+				BarWidth(2),
+				ShowValues(),
+				PlaceValues(ValuePlacementAbove),
+			},
+			update: func(bc *BarChart) error {
+				return bc.Values([]int{0, 2, 5}, 5)
+			},
+			canvas: image.Rect(0, 0, 8, 5),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(3, 3, 5, 5),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testdraw.MustRectangle(c, image.Rect(6, 0, 8, 5),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testdraw.MustText(c, "0", image.Point{0, 4}, draw.TextCellOpts(
+					cell.FgColor(DefaultValueColor),
+				))
+				testdraw.MustText(c, "2", image.Point{3, 2}, draw.TextCellOpts(
+					cell.FgColor(DefaultValueColor),
+				))
+				testdraw.MustText(c, "5", image.Point{6, 0}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorWhite),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 3,
+		},
+		{
+			desc: "displays values inside the top of the bars with labels",
+			opts: []Option{
+				Char('o'),
+				BarWidth(2),
+				ShowValues(),
+				PlaceValues(ValuePlacementTop),
+				Labels([]string{"a", "b", "c"}),
+			},
+			update: func(bc *BarChart) error {
+				return bc.Values([]int{0, 2, 5}, 5)
+			},
+			canvas: image.Rect(0, 0, 8, 6),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(3, 3, 5, 5),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testdraw.MustRectangle(c, image.Rect(6, 0, 8, 5),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testdraw.MustText(c, "0", image.Point{0, 4}, draw.TextCellOpts(
+					cell.FgColor(DefaultValueColor),
+				))
+				testdraw.MustText(c, "2", image.Point{3, 3}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorWhite),
+				))
+				testdraw.MustText(c, "5", image.Point{6, 0}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorWhite),
+				))
+				testdraw.MustText(c, "a", image.Point{0, 5}, draw.TextCellOpts(
+					cell.FgColor(DefaultLabelColor),
+				))
+				testdraw.MustText(c, "b", image.Point{3, 5}, draw.TextCellOpts(
+					cell.FgColor(DefaultLabelColor),
+				))
+				testdraw.MustText(c, "c", image.Point{6, 5}, draw.TextCellOpts(
+					cell.FgColor(DefaultLabelColor),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 3,
+		},
+		{
+			desc: "contrasting value color follows the bar color",
+			opts: []Option{
+				Char('o'),
+				BarWidth(2),
+				ShowValues(),
+				PlaceValues(ValuePlacementTop),
+				BarColors([]cell.Color{cell.ColorYellow, cell.ColorYellow, cell.ColorBlue}),
+			},
+			update: func(bc *BarChart) error {
+				return bc.Values([]int{0, 2, 5}, 5)
+			},
+			canvas: image.Rect(0, 0, 8, 5),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(3, 3, 5, 5),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorYellow)),
+				)
+				testdraw.MustRectangle(c, image.Rect(6, 0, 8, 5),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorBlue)),
+				)
+				testdraw.MustText(c, "0", image.Point{0, 4}, draw.TextCellOpts(
+					cell.FgColor(DefaultValueColor),
+				))
+				testdraw.MustText(c, "2", image.Point{3, 3}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorBlack),
+				))
+				testdraw.MustText(c, "5", image.Point{6, 0}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorWhite),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 3,
+		},
+		{
+			desc: "value colors override the contrasting color",
+			opts: []Option{
+				Char('o'),
+				BarWidth(2),
+				ShowValues(),
+				PlaceValues(ValuePlacementTop),
+				ValueColors([]cell.Color{cell.ColorGreen, cell.ColorGreen, cell.ColorGreen}),
+			},
+			update: func(bc *BarChart) error {
+				return bc.Values([]int{0, 2, 5}, 5)
+			},
+			canvas: image.Rect(0, 0, 8, 5),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(3, 3, 5, 5),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testdraw.MustRectangle(c, image.Rect(6, 0, 8, 5),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testdraw.MustText(c, "0", image.Point{0, 4}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorGreen),
+				))
+				testdraw.MustText(c, "2", image.Point{3, 3}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorGreen),
+				))
+				testdraw.MustText(c, "5", image.Point{6, 0}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorGreen),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 3,
+		},
+		{
+			desc: "formats the values",
+			opts: []Option{
+				Char('o'),
+				ShowValues(),
+				ValueFormat("%d%%"),
+				PlaceValues(ValuePlacementTop),
+			},
+			update: func(bc *BarChart) error {
+				return bc.Values([]int{5}, 5)
+			},
+			canvas: image.Rect(0, 0, 2, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 2, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testdraw.MustText(c, "5%", image.Point{0, 0}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorWhite),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 1,
+		},
+		{
+			desc: "formats the values at the bottom",
+			opts: []Option{
+				Char('o'),
+				ShowValues(),
+				ValueFormat("%d%%"),
+			},
+			update: func(bc *BarChart) error {
+				return bc.Values([]int{5}, 5)
+			},
+			canvas: image.Rect(0, 0, 2, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 2, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testdraw.MustText(c, "5%", image.Point{0, 2}, draw.TextCellOpts(
+					cell.FgColor(DefaultValueColor),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 1,
+		},
+		{
+			desc: "truncates values that don't fit the bar",
+			opts: []Option{
+				Char('o'),
+				ShowValues(),
+				PlaceValues(ValuePlacementTop),
+			},
+			update: func(bc *BarChart) error {
+				return bc.Values([]int{10}, 10)
+			},
+			canvas: image.Rect(0, 0, 1, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 1, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testdraw.MustText(c, "…", image.Point{0, 0}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorWhite),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 1,
+		},
+		{
+			desc: "fails on a single value axis tick",
+			opts: []Option{
+				ValueAxis(1),
+			},
+			update: func(bc *BarChart) error {
+				return nil
+			},
+			canvas: image.Rect(0, 0, 3, 10),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc: "displays the value axis",
+			opts: []Option{
+				Char('o'),
+				ValueAxis(3),
+			},
+			update: func(bc *BarChart) error {
+				return bc.Values([]int{5, 10}, 10)
+			},
+			canvas: image.Rect(0, 0, 6, 10),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Value axis.
+				testdraw.MustHVLines(c, []draw.HVLine{
+					{Start: image.Point{2, 0}, End: image.Point{2, 9}},
+				})
+				testdraw.MustText(c, "10", image.Point{0, 0})
+				testdraw.MustText(c, "5", image.Point{1, 5})
+				testdraw.MustText(c, "0", image.Point{1, 9})
+
+				testdraw.MustRectangle(c, image.Rect(3, 5, 4, 10),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testdraw.MustRectangle(c, image.Rect(5, 0, 6, 10),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 2,
+		},
+		{
+			desc: "value axis ends above the labels and uses the value format",
+			opts: []Option{
+				Char('o'),
+				ValueAxis(2),
+				ValueFormat("%d%%"),
+				Labels([]string{"a"}),
+			},
+			update: func(bc *BarChart) error {
+				return bc.Values([]int{50}, 100)
+			},
+			canvas: image.Rect(0, 0, 6, 5),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Value axis.
+				testdraw.MustHVLines(c, []draw.HVLine{
+					{Start: image.Point{4, 0}, End: image.Point{4, 3}},
+				})
+				testdraw.MustText(c, "100%", image.Point{0, 0})
+				testdraw.MustText(c, "0%", image.Point{2, 3})
+
+				testdraw.MustRectangle(c, image.Rect(5, 2, 6, 4),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testdraw.MustText(c, "a", image.Point{5, 4}, draw.TextCellOpts(
+					cell.FgColor(DefaultLabelColor),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 1,
+		},
+		{
+			desc: "value axis omits labels that share a row",
+			opts: []Option{
+				Char('o'),
+				ValueAxis(5),
+			},
+			update: func(bc *BarChart) error {
+				return bc.Values([]int{10}, 10)
+			},
+			canvas: image.Rect(0, 0, 4, 2),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Value axis.
+				testdraw.MustHVLines(c, []draw.HVLine{
+					{Start: image.Point{2, 0}, End: image.Point{2, 1}},
+				})
+				testdraw.MustText(c, "10", image.Point{0, 0})
+				testdraw.MustText(c, "7", image.Point{1, 1})
+
+				testdraw.MustRectangle(c, image.Rect(3, 0, 4, 2),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 1,
+		},
+		{
+			desc: "draws resize needed character when the value axis doesn't fit",
+			opts: []Option{
+				ValueAxis(2),
+			},
+			update: func(bc *BarChart) error {
+				return bc.Values([]int{10}, 10)
+			},
+			canvas: image.Rect(0, 0, 3, 10),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustResizeNeeded(c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -689,6 +1217,132 @@ func TestBarChart(t *testing.T) {
 	}
 }
 
+func TestAnimation(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// step is a call to Values if values is set, or a call to Draw.
+	type step struct {
+		elapsed time.Duration
+		values  []int
+		// wantHeights are the expected heights of the two bars.
+		wantHeights []int
+	}
+	tests := []struct {
+		desc  string
+		opts  []Option
+		steps []step
+	}{
+		{
+			desc: "bars jump to new values without animation",
+			steps: []step{
+				{values: []int{0, 10}},
+				{wantHeights: []int{0, 10}},
+				{values: []int{10, 0}},
+				{wantHeights: []int{10, 0}},
+			},
+		},
+		{
+			desc: "bars start from zero and ease towards the values",
+			opts: []Option{
+				AnimateDuration(100 * time.Millisecond),
+			},
+			steps: []step{
+				{values: []int{4, 10}},
+				{wantHeights: []int{0, 0}},
+				{elapsed: 50 * time.Millisecond, wantHeights: []int{2, 5}},
+				{elapsed: 100 * time.Millisecond, wantHeights: []int{4, 10}},
+				{elapsed: time.Second, wantHeights: []int{4, 10}},
+			},
+		},
+		{
+			desc: "bars ease from the previous values",
+			opts: []Option{
+				AnimateDuration(100 * time.Millisecond),
+			},
+			steps: []step{
+				{values: []int{0, 10}},
+				{elapsed: 100 * time.Millisecond, wantHeights: []int{0, 10}},
+				{elapsed: 100 * time.Millisecond, values: []int{10, 0}},
+				{elapsed: 125 * time.Millisecond, wantHeights: []int{2, 7}},
+				{elapsed: 200 * time.Millisecond, wantHeights: []int{10, 0}},
+			},
+		},
+		{
+			desc: "values set during the animation ease from the displayed heights",
+			opts: []Option{
+				AnimateDuration(100 * time.Millisecond),
+			},
+			steps: []step{
+				{values: []int{0, 10}},
+				{elapsed: 100 * time.Millisecond, values: []int{10, 0}},
+				{elapsed: 150 * time.Millisecond, values: []int{0, 0}},
+				{elapsed: 200 * time.Millisecond, wantHeights: []int{2, 2}},
+				{elapsed: 250 * time.Millisecond, wantHeights: []int{0, 0}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			var now time.Time
+			timeNow = func() time.Time {
+				return now
+			}
+			defer func() {
+				timeNow = time.Now
+			}()
+
+			opts := append([]Option{Char('o')}, tc.opts...)
+			bc, err := New(opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+
+			for i, s := range tc.steps {
+				now = start.Add(s.elapsed)
+				if s.values != nil {
+					if err := bc.Values(s.values, 10); err != nil {
+						t.Fatalf("step[%d]: Values => unexpected error: %v", i, err)
+					}
+					continue
+				}
+
+				c, err := canvas.New(image.Rect(0, 0, 3, 10))
+				if err != nil {
+					t.Fatalf("canvas.New => unexpected error: %v", err)
+				}
+				if err := bc.Draw(c, &widgetapi.Meta{}); err != nil {
+					t.Fatalf("step[%d]: Draw => unexpected error: %v", i, err)
+				}
+				got, err := faketerm.New(c.Size())
+				if err != nil {
+					t.Fatalf("faketerm.New => unexpected error: %v", err)
+				}
+				if err := c.Apply(got); err != nil {
+					t.Fatalf("Apply => unexpected error: %v", err)
+				}
+
+				ft := faketerm.MustNew(c.Size())
+				want := testcanvas.MustNew(ft.Area())
+				for bar, h := range s.wantHeights {
+					if h == 0 {
+						continue
+					}
+					testdraw.MustRectangle(want, image.Rect(bar*2, 10-h, bar*2+1, 10),
+						draw.RectChar('o'),
+						draw.RectCellOpts(cell.BgColor(DefaultBarColor)),
+					)
+				}
+				testcanvas.MustApply(want, ft)
+
+				if diff := faketerm.Diff(ft, got); diff != "" {
+					t.Errorf("step[%d]: Draw => %v", i, diff)
+				}
+			}
+		})
+	}
+}
+
 func TestOptions(t *testing.T) {
 	tests := []struct {
 		desc   string
@@ -775,6 +1429,26 @@ func TestOptions(t *testing.T) {
 				WantMouse:    widgetapi.MouseScopeNone,
 			},
 		},
+		{
+			desc: "minimum size accounts for the value axis",
+			create: func() (*BarChart, error) {
+				bc, err := New(
+					ValueAxis(2),
+				)
+				if err != nil {
+					return nil, err
+				}
+				if err := bc.Values([]int{1, 2}, 100); err != nil {
+					return nil, err
+				}
+				return bc, nil
+			},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{5, 1},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeNone,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -17,7 +17,9 @@ package barchart
 // options.go contains configurable options for BarChart.
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/mum4k/termdash/cell"
 	"github.com/mum4k/termdash/private/draw"
@@ -43,10 +45,14 @@ type options struct {
 	barWidth    int
 	barGap      int
 	showValues  bool
+	valueFormat string
+	valuePlace  ValuePlacement
 	barColors   []cell.Color
 	labelColors []cell.Color
 	valueColors []cell.Color
 	labels      []string
+	animateDur  time.Duration
+	axisTicks   int
 }
 
 // validate validates the provided options.
@@ -57,14 +63,27 @@ func (o *options) validate() error {
 	if got, min := o.barGap, 0; got < min {
 		return fmt.Errorf("invalid BarGap %d, must be %d <= BarGap", got, min)
 	}
+	if o.valueFormat == "" {
+		return errors.New("invalid ValueFormat, the format cannot be empty")
+	}
+	if _, ok := valuePlacementNames[o.valuePlace]; !ok {
+		return fmt.Errorf("invalid ValuePlacement %v", o.valuePlace)
+	}
+	if got, min := o.animateDur, time.Duration(0); got < min {
+		return fmt.Errorf("invalid AnimateDuration %v, must be %v <= AnimateDuration", got, min)
+	}
+	if got := o.axisTicks; got != 0 && got < 2 {
+		return fmt.Errorf("invalid ValueAxis ticks %d, must be zero or at least 2", got)
+	}
 	return nil
 }
 
 // newOptions returns options with the default values set.
 func newOptions() *options {
 	return &options{
-		barChar: DefaultChar,
-		barGap:  DefaultBarGap,
+		barChar:     DefaultChar,
+		barGap:      DefaultBarGap,
+		valueFormat: DefaultValueFormat,
 	}
 }
 
@@ -82,6 +101,9 @@ func Char(ch rune) Option {
 // BarWidth sets the width of the bars. If not set, or set to zero, the bars
 // use all the space available to the widget. Must be a positive or zero
 // integer.
+// The labels and values are centered on bars of the set width. If the bars
+// together with the gaps between them don't fit the canvas, the widget draws
+// the resize needed character instead.
 func BarWidth(width int) Option {
 	return option(func(opts *options) {
 		opts.barWidth = width
@@ -92,7 +114,8 @@ func BarWidth(width int) Option {
 const DefaultBarGap = 1
 
 // BarGap sets the width of the space between the bars.
-// Must be a positive or zero integer.
+// Must be a positive or zero integer. The gaps count towards the space the
+// widget requires, see BarWidth.
 // Defaults to DefaultBarGap.
 func BarGap(width int) Option {
 	return option(func(opts *options) {
@@ -101,12 +124,69 @@ func BarGap(width int) Option {
 }
 
 // ShowValues tells the bar chart to display the actual values inside each of the bars.
+// See also the ValueFormat and PlaceValues options.
 func ShowValues() Option {
 	return option(func(opts *options) {
 		opts.showValues = true
 	})
 }
 
+// DefaultValueFormat is the default value for the ValueFormat option.
+const DefaultValueFormat = "%d"
+
+// ValueFormat sets the format used when displaying the values inside the bars,
+// see ShowValues. The format is used with fmt.Sprintf and receives the value
+// of the bar as an int, e.g. "%d%%" displays the values as percentages.
+// Defaults to DefaultValueFormat.
+func ValueFormat(format string) Option {
+	return option(func(opts *options) {
+		opts.valueFormat = format
+	})
+}
+
+// ValuePlacement determines where the values are displayed, see ShowValues.
+type ValuePlacement int
+
+// String implements fmt.Stringer()
+func (vp ValuePlacement) String() string {
+	if n, ok := valuePlacementNames[vp]; ok {
+		return n
+	}
+	return "ValuePlacementUnknown"
+}
+
+// valuePlacementNames maps ValuePlacement values to human readable names.
+var valuePlacementNames = map[ValuePlacement]string{
+	ValuePlacementBottom: "ValuePlacementBottom",
+	ValuePlacementTop:    "ValuePlacementTop",
+	ValuePlacementAbove:  "ValuePlacementAbove",
+}
+
+const (
+	// ValuePlacementBottom displays the values inside the bottom of the bars.
+	ValuePlacementBottom ValuePlacement = iota
+
+	// ValuePlacementTop displays the values inside the top of the bars. If a
+	// bar is too short to contain the value, it is displayed just above it.
+	ValuePlacementTop
+
+	// ValuePlacementAbove displays the values just above the bars. If a bar
+	// takes all the vertical space, the value is displayed inside its top.
+	ValuePlacementAbove
+)
+
+// PlaceValues sets where the values are displayed when the ShowValues option
+// is provided. Values displayed inside a bar that don't have a color specified
+// via the ValueColors option use black or white, whichever contrasts better
+// with the color of the bar. Values that don't fit the width of a bar are
+// truncated.
+// Defaults to ValuePlacementBottom.
+func PlaceValues(vp ValuePlacement) Option {
+	return option(func(opts *options) {
+		opts.valuePlace = vp
+	})
+}
+
 // DefaultBarColor is the default color of a bar, unless specified otherwise
 // via the BarColors option.
 const DefaultBarColor = cell.ColorRed
@@ -162,3 +242,34 @@ func ValueColors(colors []cell.Color) Option {
 		opts.valueColors = colors
 	})
 }
+
+// AnimateDuration makes the bars ease towards new values provided on calls to
+// Values over the specified duration instead of jumping to them. The heights
+// of the bars are interpolated on each call to Draw, so the animation is only
+// as smooth as the redraw interval of termdash allows. The displayed values
+// are always the new values.
+//
+// Must be a positive or zero duration. If not set, or set to zero, the bars
+// aren't animated.
+func AnimateDuration(d time.Duration) Option {
+	return option(func(opts *options) {
+		opts.animateDur = d
+	})
+}
+
+// ValueAxis displays a numeric value axis on the left side of the bars with
+// the specified number of tick labels. The labels are evenly spaced values
+// from zero to the maximum provided on the call to Values, each placed on the
+// row where a bar with that value ends. Labels that would share a row with a
+// label of a larger value are omitted. The labels are formatted using the
+// ValueFormat option.
+//
+// The axis takes the width of the widest label plus one cell for the axis
+// line, this is added to the minimum size the widget requires.
+// Must be zero or at least two. If not set, or set to zero, no value axis is
+// displayed.
+func ValueAxis(ticks int) Option {
+	return option(func(opts *options) {
+		opts.axisTicks = ticks
+	})
+}
@@ -31,7 +31,10 @@ import (
 	"github.com/mum4k/termdash/private/attrrange"
 	"github.com/mum4k/termdash/private/button"
 	"github.com/mum4k/termdash/private/canvas"
+	"github.com/mum4k/termdash/private/canvas/buffer"
 	"github.com/mum4k/termdash/private/draw"
+	"github.com/mum4k/termdash/private/runewidth"
+	"github.com/mum4k/termdash/private/wrap"
 	"github.com/mum4k/termdash/terminal/terminalapi"
 	"github.com/mum4k/termdash/widgetapi"
 )
@@ -76,6 +79,13 @@ type Button struct {
 	// tOptsTracker tracks the positions in a text to which the givenTOpts apply.
 	tOptsTracker *attrrange.Tracker
 
+	// lines are the lines of the text wrapped to the width of the button.
+	// Only populated when the WrapText option was provided.
+	lines [][]*buffer.Cell
+	// cellPos maps the cells in lines to the positions of their runes in the
+	// text.
+	cellPos map[*buffer.Cell]int
+
 	// mouseFSM tracks left mouse clicks.
 	mouseFSM *button.FSM
 	// state is the current state of the button.
@@ -142,14 +152,46 @@ func NewFromChunks(chunks []*TextChunk, cFn CallbackFn, opts ...Option) (*Button
 	for _, tOpts := range givenTOpts {
 		tOpts.setDefaultFgColor(opt.textColor)
 	}
-	return &Button{
+	b := &Button{
 		text:         text,
 		givenTOpts:   givenTOpts,
 		tOptsTracker: tOptsTracker,
 		mouseFSM:     button.NewFSM(mouse.ButtonLeft, image.ZR),
 		callback:     cFn,
 		opts:         opt,
-	}, nil
+	}
+	if opt.wrapText {
+		if err := b.wrap(); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// wrap wraps the text at words to the width of the button.
+func (b *Button) wrap() error {
+	var cells []*buffer.Cell
+	b.cellPos = map[*buffer.Cell]int{}
+	for i, r := range b.text.String() {
+		c := buffer.NewCell(r)
+		cells = append(cells, c)
+		b.cellPos[c] = i
+	}
+
+	lines, err := wrap.Cells(cells, b.opts.width, wrap.AtWords)
+	if err != nil {
+		return fmt.Errorf("unable to wrap the text: %v", err)
+	}
+	b.lines = lines
+	return nil
+}
+
+// height returns the height of the button in cells, not including the shadow.
+func (b *Button) height() int {
+	if l := len(b.lines); l > b.opts.height {
+		return l
+	}
+	return b.opts.height
 }
 
 // SetCallback replaces the callback function of the button with the one provided.
@@ -221,12 +263,32 @@ func (b *Button) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 func (b *Button) drawText(cvs *canvas.Canvas, meta *widgetapi.Meta, buttonAr image.Rectangle) error {
 	pad := b.opts.textHorizontalPadding
 	textAr := image.Rect(buttonAr.Min.X+pad, buttonAr.Min.Y, buttonAr.Dx()-pad, buttonAr.Max.Y)
-	start, err := alignfor.Text(textAr, b.text.String(), align.HorizontalCenter, align.VerticalMiddle)
+	if b.opts.wrapText {
+		linesAr := image.Rect(buttonAr.Min.X+pad, buttonAr.Min.Y, buttonAr.Max.X-pad, buttonAr.Max.Y)
+		return b.drawLines(cvs, meta, linesAr)
+	}
+
+	// The icon and the text are aligned as a single block.
+	ic := b.opts.icon
+	block := b.text.String()
+	if ic != nil {
+		block = fmt.Sprintf("%c %s", ic.r, block)
+	}
+	start, err := alignfor.Text(textAr, block, align.HorizontalCenter, align.VerticalMiddle)
 	if err != nil {
 		return err
 	}
+	if ic != nil {
+		if err := b.drawIcon(cvs, start, buttonAr); err != nil {
+			return err
+		}
+		start.X += ic.cells()
+	}
 
 	maxCells := buttonAr.Max.X - start.X
+	if maxCells < 1 {
+		return nil
+	}
 	trimmed, err := draw.TrimText(b.text.String(), maxCells, draw.OverrunModeThreeDot)
 	if err != nil {
 		return err
@@ -247,17 +309,7 @@ func (b *Button) drawText(cvs *canvas.Canvas, meta *widgetapi.Meta, buttonAr ima
 			optRange = or
 		}
 
-		tOpts := b.givenTOpts[optRange.AttrIdx]
-		var cellOpts []cell.Option
-		switch {
-		case b.state == button.Down && len(tOpts.pressedCellOpts) > 0:
-			cellOpts = tOpts.pressedCellOpts
-		case meta.Focused && len(tOpts.focusedCellOpts) > 0:
-			cellOpts = tOpts.focusedCellOpts
-		default:
-			cellOpts = tOpts.cellOpts
-		}
-		cells, err := cvs.SetCell(cur, r, cellOpts...)
+		cells, err := cvs.SetCell(cur, r, b.cellOpts(meta, optRange.AttrIdx)...)
 		if err != nil {
 			return err
 		}
@@ -266,6 +318,81 @@ func (b *Button) drawText(cvs *canvas.Canvas, meta *widgetapi.Meta, buttonAr ima
 	return nil
 }
 
+// drawIcon draws the icon at the specified point if it fits into the button.
+func (b *Button) drawIcon(cvs *canvas.Canvas, p image.Point, buttonAr image.Rectangle) error {
+	ic := b.opts.icon
+	if p.X+runewidth.RuneWidth(ic.r) > buttonAr.Max.X {
+		return nil
+	}
+	opts := append([]cell.Option{cell.FgColor(b.opts.textColor)}, ic.cellOpts...)
+	_, err := cvs.SetCell(p, ic.r, opts...)
+	return err
+}
+
+// drawLines draws the wrapped lines of text centered inside the text area.
+// Lines that don't fit into the text area are trimmed.
+// The icon is drawn on the left of the first line, the lines are centered in
+// the remaining space.
+func (b *Button) drawLines(cvs *canvas.Canvas, meta *widgetapi.Meta, textAr image.Rectangle) error {
+	startY := textAr.Min.Y + (textAr.Dy()-len(b.lines))/2
+	if startY < textAr.Min.Y {
+		startY = textAr.Min.Y
+	}
+	if ic := b.opts.icon; ic != nil {
+		if startY < textAr.Max.Y {
+			if err := b.drawIcon(cvs, image.Point{textAr.Min.X, startY}, textAr); err != nil {
+				return err
+			}
+		}
+		textAr.Min.X += ic.cells()
+	}
+	for i, line := range b.lines {
+		y := startY + i
+		if y >= textAr.Max.Y {
+			break
+		}
+
+		var width int
+		for _, c := range line {
+			width += runewidth.RuneWidth(c.Rune)
+		}
+		x := textAr.Min.X + (textAr.Dx()-width)/2
+		if x < textAr.Min.X {
+			x = textAr.Min.X
+		}
+
+		for _, c := range line {
+			if x+runewidth.RuneWidth(c.Rune) > textAr.Max.X {
+				break
+			}
+			optRange, err := b.tOptsTracker.ForPosition(b.cellPos[c])
+			if err != nil {
+				return err
+			}
+			cells, err := cvs.SetCell(image.Point{x, y}, c.Rune, b.cellOpts(meta, optRange.AttrIdx)...)
+			if err != nil {
+				return err
+			}
+			x += cells
+		}
+	}
+	return nil
+}
+
+// cellOpts returns the cell options for text that uses the text options at
+// the specified index, given the state of the button.
+func (b *Button) cellOpts(meta *widgetapi.Meta, tOptsIdx int) []cell.Option {
+	tOpts := b.givenTOpts[tOptsIdx]
+	switch {
+	case b.state == button.Down && len(tOpts.pressedCellOpts) > 0:
+		return tOpts.pressedCellOpts
+	case meta.Focused && len(tOpts.focusedCellOpts) > 0:
+		return tOpts.focusedCellOpts
+	default:
+		return tOpts.cellOpts
+	}
+}
+
 // activated asserts whether the keyboard event activated the button.
 func (b *Button) keyActivated(k *terminalapi.Keyboard, meta *widgetapi.EventMeta) bool {
 	b.mu.Lock()
@@ -337,8 +464,8 @@ func (b *Button) shadowWidth() int {
 func (b *Button) Options() widgetapi.Options {
 	// No need to lock, as the height and width get fixed when New is called.
 
-	width := b.opts.width + b.shadowWidth() + 2*b.opts.textHorizontalPadding
-	height := b.opts.height + b.shadowWidth()
+	width := b.opts.width + b.opts.icon.cells() + b.shadowWidth() + 2*b.opts.textHorizontalPadding
+	height := b.height() + b.shadowWidth()
 
 	var keyScope widgetapi.KeyScope
 	if len(b.opts.focusedKeys) > 0 || len(b.opts.globalKeys) > 0 {
@@ -333,6 +333,164 @@ func TestButton(t *testing.T) {
 			},
 			wantCallback: &callbackTracker{},
 		},
+		{
+			desc:     "draws button with a two-line wrapped label",
+			callback: &callbackTracker{},
+			opts: []Option{
+				Width(5),
+				WrapText(),
+			},
+			text:   "hello world",
+			canvas: image.Rect(0, 0, 8, 4),
+			meta:   &widgetapi.Meta{Focused: false},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				// Shadow.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(1, 1, 8, 4), 's', cell.BgColor(cell.ColorNumber(240)))
+
+				// Button.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(0, 0, 7, 3), 'x', cell.BgColor(cell.ColorNumber(117)))
+
+				// Text.
+				for i, line := range []string{"hello", "world"} {
+					testdraw.MustText(cvs, line, image.Point{1, i},
+						draw.TextCellOpts(
+							cell.FgColor(cell.ColorBlack),
+							cell.BgColor(cell.ColorNumber(117))),
+					)
+				}
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCallback: &callbackTracker{},
+		},
+		{
+			desc:     "centers each line of a wrapped label",
+			callback: &callbackTracker{},
+			opts: []Option{
+				Width(5),
+				Height(4),
+				WrapText(),
+			},
+			text:   "hi there",
+			canvas: image.Rect(0, 0, 8, 5),
+			meta:   &widgetapi.Meta{Focused: false},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				// Shadow.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(1, 1, 8, 5), 's', cell.BgColor(cell.ColorNumber(240)))
+
+				// Button.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(0, 0, 7, 4), 'x', cell.BgColor(cell.ColorNumber(117)))
+
+				// Text.
+				testdraw.MustText(cvs, "hi", image.Point{2, 1},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorBlack),
+						cell.BgColor(cell.ColorNumber(117))),
+				)
+				testdraw.MustText(cvs, "there", image.Point{1, 2},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorBlack),
+						cell.BgColor(cell.ColorNumber(117))),
+				)
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCallback: &callbackTracker{},
+		},
+		{
+			desc:     "draws button with a wrapped label in down state",
+			callback: &callbackTracker{},
+			opts: []Option{
+				Width(5),
+				WrapText(),
+			},
+			text:   "hello world",
+			canvas: image.Rect(0, 0, 8, 4),
+			meta:   &widgetapi.Meta{Focused: false},
+			events: []*event{
+				{
+					ev:   &terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+					meta: &widgetapi.EventMeta{},
+				},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				// Button.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(1, 1, 8, 4), 'x', cell.BgColor(cell.ColorNumber(117)))
+
+				// Text.
+				for i, line := range []string{"hello", "world"} {
+					testdraw.MustText(cvs, line, image.Point{2, i + 1},
+						draw.TextCellOpts(
+							cell.FgColor(cell.ColorBlack),
+							cell.BgColor(cell.ColorNumber(117))),
+					)
+				}
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCallback: &callbackTracker{},
+		},
+		{
+			desc: "draws button with text chunks in a wrapped label",
+			opts: []Option{
+				Width(5),
+				WrapText(),
+				DisableShadow(),
+			},
+			callback: &callbackTracker{},
+			textChunks: []*TextChunk{
+				NewChunk(
+					"hello w",
+					TextCellOpts(cell.FgColor(cell.ColorRed)),
+				),
+				NewChunk(
+					"orld",
+					TextCellOpts(cell.FgColor(cell.ColorBlue)),
+				),
+			},
+			canvas: image.Rect(0, 0, 7, 3),
+			meta:   &widgetapi.Meta{Focused: false},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				// Button.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(0, 0, 7, 3), 'x', cell.BgColor(cell.ColorNumber(117)))
+
+				// Text.
+				testdraw.MustText(cvs, "hello", image.Point{1, 0},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorRed),
+						cell.BgColor(cell.ColorNumber(117))),
+				)
+				testdraw.MustText(cvs, "w", image.Point{1, 1},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorRed),
+						cell.BgColor(cell.ColorNumber(117))),
+				)
+				testdraw.MustText(cvs, "orld", image.Point{2, 1},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorBlue),
+						cell.BgColor(cell.ColorNumber(117))),
+				)
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCallback: &callbackTracker{},
+		},
 		{
 			desc:     "draws button in down state due to a mouse event",
 			callback: &callbackTracker{},
@@ -672,6 +830,47 @@ func TestButton(t *testing.T) {
 				count:  1,
 			},
 		},
+		{
+			desc:     "ignores keyboard events other than the global key when not focused",
+			callback: &callbackTracker{},
+			text:     "hello",
+			opts: []Option{
+				GlobalKey(keyboard.KeyTab),
+			},
+			canvas: image.Rect(0, 0, 8, 4),
+			meta:   &widgetapi.Meta{Focused: false},
+			events: []*event{
+				{
+					ev:   &terminalapi.Keyboard{Key: keyboard.KeyEnter},
+					meta: &widgetapi.EventMeta{},
+				},
+				{
+					ev:   &terminalapi.Keyboard{Key: 'a'},
+					meta: &widgetapi.EventMeta{},
+				},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				// Shadow.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(1, 1, 8, 4), 's', cell.BgColor(cell.ColorNumber(240)))
+
+				// Button.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(0, 0, 7, 3), 'x', cell.BgColor(cell.ColorNumber(117)))
+
+				// Text.
+				testdraw.MustText(cvs, "hello", image.Point{1, 1},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorBlack),
+						cell.BgColor(cell.ColorNumber(117))),
+				)
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCallback: &callbackTracker{},
+		},
 		{
 			desc:     "draws button in down state due to a keyboard event when multiple global keys are specified",
 			callback: &callbackTracker{},
@@ -1462,6 +1661,204 @@ func TestButton(t *testing.T) {
 			},
 			wantCallback: &callbackTracker{},
 		},
+		{
+			desc:     "New fails with an icon that isn't printable",
+			callback: &callbackTracker{},
+			opts: []Option{
+				Icon(0),
+			},
+			canvas:     image.Rect(0, 0, 1, 1),
+			text:       "hello",
+			meta:       &widgetapi.Meta{Focused: false},
+			wantNewErr: true,
+		},
+		{
+			desc:     "draws button with an icon in up state",
+			callback: &callbackTracker{},
+			text:     "hello",
+			opts: []Option{
+				Icon('✓', cell.FgColor(cell.ColorGreen)),
+			},
+			canvas: image.Rect(0, 0, 10, 4),
+			meta:   &widgetapi.Meta{Focused: false},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				// Shadow.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(1, 1, 10, 4), 's', cell.BgColor(cell.ColorNumber(240)))
+
+				// Button.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(0, 0, 9, 3), 'x', cell.BgColor(cell.ColorNumber(117)))
+
+				// Icon.
+				testcanvas.MustSetCell(cvs, image.Point{1, 1}, '✓',
+					cell.FgColor(cell.ColorGreen),
+					cell.BgColor(cell.ColorNumber(117)),
+				)
+
+				// Text.
+				testdraw.MustText(cvs, "hello", image.Point{3, 1},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorBlack),
+						cell.BgColor(cell.ColorNumber(117))),
+				)
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCallback: &callbackTracker{},
+		},
+		{
+			desc:     "draws button with an icon in down state",
+			callback: &callbackTracker{},
+			text:     "hello",
+			opts: []Option{
+				Icon('✓', cell.FgColor(cell.ColorGreen)),
+			},
+			events: []*event{
+				{
+					ev:   &terminalapi.Mouse{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+					meta: &widgetapi.EventMeta{},
+				},
+			},
+			canvas: image.Rect(0, 0, 10, 4),
+			meta:   &widgetapi.Meta{Focused: false},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				// Button.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(1, 1, 10, 4), 'x', cell.BgColor(cell.ColorNumber(117)))
+
+				// Icon.
+				testcanvas.MustSetCell(cvs, image.Point{2, 2}, '✓',
+					cell.FgColor(cell.ColorGreen),
+					cell.BgColor(cell.ColorNumber(117)),
+				)
+
+				// Text.
+				testdraw.MustText(cvs, "hello", image.Point{4, 2},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorBlack),
+						cell.BgColor(cell.ColorNumber(117))),
+				)
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCallback: &callbackTracker{},
+		},
+		{
+			desc:     "icon defaults to the text color",
+			callback: &callbackTracker{},
+			text:     "hello",
+			opts: []Option{
+				Icon('✓'),
+				TextColor(cell.ColorRed),
+				DisableShadow(),
+			},
+			canvas: image.Rect(0, 0, 9, 3),
+			meta:   &widgetapi.Meta{Focused: false},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				// Button.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(0, 0, 9, 3), 'x', cell.BgColor(cell.ColorNumber(117)))
+
+				// Icon and text.
+				testdraw.MustText(cvs, "✓", image.Point{1, 1},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorRed),
+						cell.BgColor(cell.ColorNumber(117))),
+				)
+				testdraw.MustText(cvs, "hello", image.Point{3, 1},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorRed),
+						cell.BgColor(cell.ColorNumber(117))),
+				)
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCallback: &callbackTracker{},
+		},
+		{
+			desc:     "centers the icon and the text as a block in a wider button",
+			callback: &callbackTracker{},
+			text:     "hi",
+			opts: []Option{
+				Icon('✓', cell.FgColor(cell.ColorGreen)),
+				Width(9),
+				DisableShadow(),
+			},
+			canvas: image.Rect(0, 0, 13, 3),
+			meta:   &widgetapi.Meta{Focused: false},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				// Button.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(0, 0, 13, 3), 'x', cell.BgColor(cell.ColorNumber(117)))
+
+				// Icon.
+				testcanvas.MustSetCell(cvs, image.Point{4, 1}, '✓',
+					cell.FgColor(cell.ColorGreen),
+					cell.BgColor(cell.ColorNumber(117)),
+				)
+
+				// Text.
+				testdraw.MustText(cvs, "hi", image.Point{6, 1},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorBlack),
+						cell.BgColor(cell.ColorNumber(117))),
+				)
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCallback: &callbackTracker{},
+		},
+		{
+			desc:     "draws the icon on the left of the first wrapped line",
+			callback: &callbackTracker{},
+			text:     "hello world",
+			opts: []Option{
+				Icon('✓', cell.FgColor(cell.ColorGreen)),
+				Width(5),
+				WrapText(),
+				DisableShadow(),
+			},
+			canvas: image.Rect(0, 0, 9, 3),
+			meta:   &widgetapi.Meta{Focused: false},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				// Button.
+				testcanvas.MustSetAreaCells(cvs, image.Rect(0, 0, 9, 3), 'x', cell.BgColor(cell.ColorNumber(117)))
+
+				// Icon.
+				testcanvas.MustSetCell(cvs, image.Point{1, 0}, '✓',
+					cell.FgColor(cell.ColorGreen),
+					cell.BgColor(cell.ColorNumber(117)),
+				)
+
+				// Text.
+				for i, line := range []string{"hello", "world"} {
+					testdraw.MustText(cvs, line, image.Point{3, i},
+						draw.TextCellOpts(
+							cell.FgColor(cell.ColorBlack),
+							cell.BgColor(cell.ColorNumber(117))),
+					)
+				}
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCallback: &callbackTracker{},
+		},
 	}
 
 	buttonRune = 'x'
@@ -1704,6 +2101,61 @@ func TestOptions(t *testing.T) {
 				WantMouse:    widgetapi.MouseScopeGlobal,
 			},
 		},
+		{
+			desc: "wrapped text that fits the height",
+			text: "hello world",
+			opts: []Option{
+				Width(5),
+				WrapText(),
+			},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{8, 4},
+				MaximumSize:  image.Point{8, 4},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeGlobal,
+			},
+		},
+		{
+			desc: "height grows to fit the wrapped text",
+			text: "one two three four",
+			opts: []Option{
+				Width(5),
+				WrapText(),
+			},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{8, 5},
+				MaximumSize:  image.Point{8, 5},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeGlobal,
+			},
+		},
+		{
+			desc: "width grows by the icon",
+			text: "hello",
+			opts: []Option{
+				Icon('✓'),
+			},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{10, 4},
+				MaximumSize:  image.Point{10, 4},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeGlobal,
+			},
+		},
+		{
+			desc: "width grows by a full-width icon",
+			text: "hello",
+			opts: []Option{
+				Icon('世'),
+				Width(10),
+			},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{16, 4},
+				MaximumSize:  image.Point{16, 4},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeGlobal,
+			},
+		},
 		{
 			desc: "doesn't want keyboard by default without any keys",
 			text: "hello",
@@ -54,6 +54,23 @@ type options struct {
 	focusedKeys           map[keyboard.Key]bool
 	globalKeys            map[keyboard.Key]bool
 	keyUpDelay            time.Duration
+	wrapText              bool
+	icon                  *icon
+}
+
+// icon is a glyph drawn before the text of the button.
+type icon struct {
+	r        rune
+	cellOpts []cell.Option
+}
+
+// cells returns the number of cells the icon occupies including the space
+// that separates it from the text, zero if there is no icon.
+func (i *icon) cells() int {
+	if i == nil {
+		return 0
+	}
+	return runewidth.RuneWidth(i.r) + 1
 }
 
 // validate validates the provided options.
@@ -71,6 +88,10 @@ func (o *options) validate() error {
 		return fmt.Errorf("invalid keyUpDelay %v, must be %v <= keyUpDelay", o.keyUpDelay, min)
 	}
 
+	if o.icon != nil && runewidth.RuneWidth(o.icon.r) < 1 {
+		return fmt.Errorf("invalid icon %q, must be a printable rune", o.icon.r)
+	}
+
 	for k := range o.globalKeys {
 		if o.focusedKeys[k] {
 			return fmt.Errorf("key %q cannot be configured as both a focused key (options Key or Keys) and a global key (options GlobalKey or GlobalKeys)", k)
@@ -171,7 +192,9 @@ func WidthFor(text string) Option {
 }
 
 // Key configures the keyboard key that presses the button.
-// The widget responds to this key only if its container is focused.
+// The widget responds to this key only if its container is focused, see
+// GlobalKey for a key that presses the button regardless of the focus, e.g.
+// a keyboard shortcut of a button in a toolbar.
 //
 // Clears all keys set by Key() or Keys() previously.
 func Key(k keyboard.Key) Option {
@@ -182,7 +205,8 @@ func Key(k keyboard.Key) Option {
 }
 
 // GlobalKey is like Key, but makes the widget respond to the key even if its
-// container isn't focused.
+// container isn't focused. The button is drawn pressed for the duration of
+// KeyUpDelay and its callback is called.
 //
 // Clears all keys set by GlobalKey() or GlobalKeys() previously.
 func GlobalKey(k keyboard.Key) Option {
@@ -251,6 +275,33 @@ func TextHorizontalPadding(p int) Option {
 	})
 }
 
+// WrapText wraps the text of the button at word boundaries into multiple lines
+// that fit the width of the button, see the Width option. Each line is
+// centered. The height of the button grows if the wrapped lines don't fit into
+// the height set by the Height option.
+// Newline characters in the text always start a new line when this option is
+// provided.
+func WrapText() Option {
+	return option(func(opts *options) {
+		opts.wrapText = true
+	})
+}
+
+// Icon draws the provided rune as an icon before the text of the button,
+// separated from the text by one space. The icon and the text are centered as
+// a single block. The icon is drawn with the provided cell options, which
+// default to the foreground color set by TextColor.
+// The width of the button grows by the cells the icon occupies, in addition
+// to the width set by Width or WidthFor.
+func Icon(r rune, cOpts ...cell.Option) Option {
+	return option(func(opts *options) {
+		opts.icon = &icon{
+			r:        r,
+			cellOpts: cOpts,
+		}
+	})
+}
+
 // widthFor returns the required width for the specified text.
 func widthFor(text string) int {
 	return runewidth.StringWidth(text)
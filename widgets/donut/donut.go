@@ -237,9 +237,8 @@ func (d *Donut) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 	}
 
 	mid, r := midAndRadius(bc.Area())
-	if err := draw.BrailleCircle(bc, mid, r,
+	if err := draw.BrailleArc(bc, mid, r, startA, endA,
 		draw.BrailleCircleFilled(),
-		draw.BrailleCircleArcOnly(startA, endA),
 		draw.BrailleCircleCellOpts(d.opts.cellOpts...),
 	); err != nil {
 		return fmt.Errorf("failed to draw the outer circle: %v", err)
@@ -577,6 +577,87 @@ func TestDonut(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:   "displays 25% progress, starting at the bottom, clockwise",
+			canvas: image.Rect(0, 0, 7, 7),
+			update: func(d *Donut) error {
+				return d.Percent(25, HolePercent(80), StartAngle(270), Clockwise())
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				bc := testbraille.MustNew(c.Area())
+
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 6,
+					draw.BrailleCircleFilled(),
+					draw.BrailleCircleArcOnly(180, 270),
+				)
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 5,
+					draw.BrailleCircleFilled(),
+					draw.BrailleCircleClearPixels(),
+				)
+				testbraille.MustCopyTo(bc, c)
+
+				testdraw.MustText(c, "25%", image.Point{2, 3})
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "displays 25% progress, starting at the bottom, counter-clockwise",
+			canvas: image.Rect(0, 0, 7, 7),
+			update: func(d *Donut) error {
+				return d.Percent(25, HolePercent(80), StartAngle(270), CounterClockwise())
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				bc := testbraille.MustNew(c.Area())
+
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 6,
+					draw.BrailleCircleFilled(),
+					draw.BrailleCircleArcOnly(270, 360),
+				)
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 5,
+					draw.BrailleCircleFilled(),
+					draw.BrailleCircleClearPixels(),
+				)
+				testbraille.MustCopyTo(bc, c)
+
+				testdraw.MustText(c, "25%", image.Point{2, 3})
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "displays 25% progress, starting on the right, clockwise",
+			canvas: image.Rect(0, 0, 7, 7),
+			update: func(d *Donut) error {
+				return d.Percent(25, HolePercent(80), StartAngle(0), Clockwise())
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				bc := testbraille.MustNew(c.Area())
+
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 6,
+					draw.BrailleCircleFilled(),
+					draw.BrailleCircleArcOnly(270, 360),
+				)
+				testdraw.MustBrailleCircle(bc, image.Point{6, 13}, 5,
+					draw.BrailleCircleFilled(),
+					draw.BrailleCircleClearPixels(),
+				)
+				testbraille.MustCopyTo(bc, c)
+
+				testdraw.MustText(c, "25%", image.Point{2, 3})
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc:   "displays 10/10 absolute progress",
 			canvas: image.Rect(0, 0, 8, 8),
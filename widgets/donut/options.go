@@ -141,7 +141,9 @@ const DefaultStartAngle = 90
 // StartAngle sets the starting angle in degrees, i.e. the point that will
 // represent both 0% and 100% of progress.
 // Valid values are in range 0 <= angle < 360.
-// Angles start at the X axis and grow counter-clockwise.
+// Angles start at the X axis and grow counter-clockwise, e.g. 90 is at the
+// top and 270 at the bottom of the donut. See also the Clockwise and
+// CounterClockwise options for the direction of the progress.
 func StartAngle(angle int) Option {
 	return option(func(opts *options) {
 		opts.startAngle = angle
@@ -169,6 +169,9 @@ func (g *Gauge) progressText() string {
 	if g.opts.hideTextProgress {
 		return ""
 	}
+	if g.opts.progressFmt != nil {
+		return g.opts.progressFmt(g.current, g.total)
+	}
 
 	if g.pt == progressTypePercent {
 		return fmt.Sprintf("%d%%", g.current)
@@ -190,8 +193,19 @@ func (g *Gauge) gaugeText() string {
 	return b.String()
 }
 
+// inProgress determines if the point falls within any of the filled up areas.
+func inProgress(p image.Point, progress []image.Rectangle) bool {
+	for _, ar := range progress {
+		if p.In(ar) {
+			return true
+		}
+	}
+	return false
+}
+
 // drawText draws the text enumerating the progress and the text label.
-func (g *Gauge) drawText(cvs *canvas.Canvas, progress image.Rectangle) error {
+// The progress are the areas filled up by the gauge.
+func (g *Gauge) drawText(cvs *canvas.Canvas, progress []image.Rectangle) error {
 	text := g.gaugeText()
 	if text == "" {
 		return nil
@@ -218,7 +232,7 @@ func (g *Gauge) drawText(cvs *canvas.Canvas, progress image.Rectangle) error {
 		// If the current rune is full-width and only one of its cells falls
 		// within the filled area of the gauge, extend the gauge by one cell to
 		// fully cover the full-width rune.
-		if rw == 2 && next.In(ar) && cur.In(progress) && !next.In(progress) {
+		if rw == 2 && next.In(ar) && inProgress(cur, progress) && !inProgress(next, progress) {
 			fixup := image.Rect(
 				next.X,
 				ar.Min.Y,
@@ -235,7 +249,7 @@ func (g *Gauge) drawText(cvs *canvas.Canvas, progress image.Rectangle) error {
 		}
 
 		var cellOpts []cell.Option
-		if cur.In(progress) {
+		if inProgress(cur, progress) {
 			cellOpts = append(cellOpts, cell.FgColor(g.opts.filledTextColor))
 		} else {
 			cellOpts = append(cellOpts, cell.FgColor(g.opts.emptyTextColor))
@@ -271,6 +285,42 @@ func (g *Gauge) drawThreshold(cvs *canvas.Canvas) error {
 	)
 }
 
+// progress returns the areas of the usable area that are filled up to
+// represent the current progress. This is a single area unless the gauge is
+// divided into segments, in which case each lit segment has its own area.
+func (g *Gauge) progress(usable image.Rectangle) []image.Rectangle {
+	if g.opts.segments == 0 {
+		return []image.Rectangle{
+			image.Rect(
+				usable.Min.X,
+				usable.Min.Y,
+				usable.Min.X+g.width(usable, g.current),
+				usable.Max.Y,
+			),
+		}
+	}
+
+	if g.total == 0 {
+		// No progress was set yet.
+		return nil
+	}
+	n := g.opts.segments
+	gap := g.opts.segmentGap
+	lit := n * g.current / g.total
+	// The width available to the segments after the gaps are accounted for.
+	avail := usable.Dx() - (n-1)*gap
+	var res []image.Rectangle
+	for i := 0; i < lit; i++ {
+		res = append(res, image.Rect(
+			usable.Min.X+i*avail/n+i*gap,
+			usable.Min.Y,
+			usable.Min.X+(i+1)*avail/n+i*gap,
+			usable.Max.Y,
+		))
+	}
+	return res
+}
+
 // Draw draws the Gauge widget onto the canvas.
 // Implements widgetapi.Widget.Draw.
 func (g *Gauge) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
@@ -296,15 +346,12 @@ func (g *Gauge) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 		}
 	}
 
-	usable := g.usable(cvs)
-	progress := image.Rect(
-		usable.Min.X,
-		usable.Min.Y,
-		usable.Min.X+g.width(usable, g.current),
-		usable.Max.Y,
-	)
-	if progress.Dx() > 0 {
-		if err := draw.Rectangle(cvs, progress,
+	progress := g.progress(g.usable(cvs))
+	for _, ar := range progress {
+		if ar.Dx() <= 0 {
+			continue
+		}
+		if err := draw.Rectangle(cvs, ar,
 			draw.RectChar(g.opts.gaugeChar),
 			draw.RectCellOpts(cell.BgColor(g.opts.color)),
 		); err != nil {
@@ -344,6 +391,10 @@ func (g *Gauge) maxSize() image.Point {
 func (g *Gauge) minSize() image.Point {
 	minWidth := 1  // Shorter gauge than this cannot display anything.
 	minHeight := 1 // At least one line for the gauge itself.
+	if n := g.opts.segments; n > 0 {
+		// At least one cell for each segment and the gaps between them.
+		minWidth = n + (n-1)*g.opts.segmentGap
+	}
 	if g.hasBorder() {
 		// Add the required space for the border.
 		minWidth += 2
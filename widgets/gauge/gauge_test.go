@@ -80,6 +80,227 @@ func TestGauge(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			desc: "fails on negative segment count",
+			opts: []Option{
+				Segments(-1, 0),
+			},
+			canvas: image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc: "fails on negative segment gap",
+			opts: []Option{
+				Segments(2, -1),
+			},
+			canvas: image.Rect(0, 0, 10, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc: "segmented gauge lights no segments before any progress is set",
+			opts: []Option{
+				Char('o'),
+				HideTextProgress(),
+				Segments(4, 1),
+			},
+			canvas: image.Rect(0, 0, 11, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "segmented gauge lights no segments at zero percent",
+			opts: []Option{
+				Char('o'),
+				HideTextProgress(),
+				Segments(4, 1),
+			},
+			percent: &percentCall{p: 0},
+			canvas:  image.Rect(0, 0, 11, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "segmented gauge lights no segments below the first step",
+			opts: []Option{
+				Char('o'),
+				HideTextProgress(),
+				Segments(4, 1),
+			},
+			percent: &percentCall{p: 24},
+			canvas:  image.Rect(0, 0, 11, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "segmented gauge lights one segment",
+			opts: []Option{
+				Char('o'),
+				HideTextProgress(),
+				Segments(4, 1),
+			},
+			percent: &percentCall{p: 25},
+			canvas:  image.Rect(0, 0, 11, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				for _, ar := range []image.Rectangle{
+					image.Rect(0, 0, 2, 3),
+				} {
+					testdraw.MustRectangle(c, ar,
+						draw.RectChar('o'),
+						draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+					)
+				}
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "segmented gauge rounds the lit segments down",
+			opts: []Option{
+				Char('o'),
+				HideTextProgress(),
+				Segments(4, 1),
+			},
+			percent: &percentCall{p: 60},
+			canvas:  image.Rect(0, 0, 11, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				for _, ar := range []image.Rectangle{
+					image.Rect(0, 0, 2, 3),
+					image.Rect(3, 0, 5, 3),
+				} {
+					testdraw.MustRectangle(c, ar,
+						draw.RectChar('o'),
+						draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+					)
+				}
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "segmented gauge lights all segments at full progress",
+			opts: []Option{
+				Char('o'),
+				HideTextProgress(),
+				Segments(4, 1),
+			},
+			percent: &percentCall{p: 100},
+			canvas:  image.Rect(0, 0, 11, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				for _, ar := range []image.Rectangle{
+					image.Rect(0, 0, 2, 3),
+					image.Rect(3, 0, 5, 3),
+					image.Rect(6, 0, 8, 3),
+					image.Rect(9, 0, 11, 3),
+				} {
+					testdraw.MustRectangle(c, ar,
+						draw.RectChar('o'),
+						draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+					)
+				}
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "segmented gauge without gaps",
+			opts: []Option{
+				Char('o'),
+				HideTextProgress(),
+				Segments(4, 0),
+			},
+			percent: &percentCall{p: 50},
+			canvas:  image.Rect(0, 0, 11, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				for _, ar := range []image.Rectangle{
+					image.Rect(0, 0, 2, 3),
+					image.Rect(2, 0, 5, 3),
+				} {
+					testdraw.MustRectangle(c, ar,
+						draw.RectChar('o'),
+						draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+					)
+				}
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "segmented gauge colors text by the lit segments",
+			opts: []Option{
+				Char('o'),
+				Segments(4, 1),
+			},
+			percent: &percentCall{p: 50},
+			canvas:  image.Rect(0, 0, 11, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				for _, ar := range []image.Rectangle{
+					image.Rect(0, 0, 2, 3),
+					image.Rect(3, 0, 5, 3),
+				} {
+					testdraw.MustRectangle(c, ar,
+						draw.RectChar('o'),
+						draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+					)
+				}
+				testdraw.MustText(c, "5", image.Point{4, 1},
+					draw.TextCellOpts(cell.FgColor(cell.ColorBlack)),
+				)
+				testdraw.MustText(c, "0%", image.Point{5, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "segmented gauge asks for resize when segments don't fit",
+			opts: []Option{
+				Segments(4, 1),
+			},
+			percent: &percentCall{p: 50},
+			canvas:  image.Rect(0, 0, 6, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustResizeNeeded(c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc: "gauge without progress text",
 			opts: []Option{
@@ -476,6 +697,99 @@ func TestGauge(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc: "formats absolute progress",
+			opts: []Option{
+				Char('o'),
+				TextProgressFormatter(func(current, total int) string {
+					return fmt.Sprintf("%d GB / %d GB", current, total)
+				}),
+			},
+			absolute: &absoluteCall{done: 2, total: 20},
+			canvas:   image.Rect(0, 0, 20, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 2, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testdraw.MustText(c, "2 GB / 20 GB", image.Point{4, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "formats percent progress",
+			opts: []Option{
+				Char('o'),
+				TextProgressFormatter(func(current, total int) string {
+					return fmt.Sprintf("%d of %d", current, total)
+				}),
+			},
+			percent: &percentCall{p: 10},
+			canvas:  image.Rect(0, 0, 20, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 2, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testdraw.MustText(c, "10 of 100", image.Point{5, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "formatted progress with a text label",
+			opts: []Option{
+				Char('o'),
+				TextLabel("disk"),
+				TextProgressFormatter(func(current, total int) string {
+					return fmt.Sprintf("%d GB", current)
+				}),
+			},
+			absolute: &absoluteCall{done: 2, total: 20},
+			canvas:   image.Rect(0, 0, 20, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 2, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testdraw.MustText(c, "2 GB (disk)", image.Point{4, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "formatter ignored when text progress is hidden",
+			opts: []Option{
+				Char('o'),
+				HideTextProgress(),
+				TextProgressFormatter(func(current, total int) string {
+					return "hidden"
+				}),
+			},
+			absolute: &absoluteCall{done: 2, total: 20},
+			canvas:   image.Rect(0, 0, 20, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustRectangle(c, image.Rect(0, 0, 2, 3),
+					draw.RectChar('o'),
+					draw.RectCellOpts(cell.BgColor(cell.ColorGreen)),
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc: "gauge takes full size of the canvas",
 			opts: []Option{
@@ -1121,6 +1435,18 @@ func TestOptions(t *testing.T) {
 				WantMouse:    widgetapi.MouseScopeNone,
 			},
 		},
+		{
+			desc: "segments are accounted for in minimum size",
+			opts: []Option{
+				Segments(4, 1),
+			},
+			want: widgetapi.Options{
+				MaximumSize:  image.Point{0, 0}, // Unlimited.
+				MinimumSize:  image.Point{7, 1},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeNone,
+			},
+		},
 		{
 			desc: "border is accounted for in maximum and minimum size",
 			opts: []Option{
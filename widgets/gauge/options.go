@@ -35,6 +35,7 @@ type Option interface {
 type options struct {
 	gaugeChar        rune
 	hideTextProgress bool
+	progressFmt      ProgressFormatter
 	height           int
 	textLabel        string
 	hTextAlign       align.Horizontal
@@ -51,6 +52,9 @@ type options struct {
 	threshold          int
 	thresholdCellOpts  []cell.Option
 	thresholdLineStyle linestyle.LineStyle
+	// If set, divides the gauge into segments separated by gaps.
+	segments   int
+	segmentGap int
 }
 
 // newOptions returns options with the default values set.
@@ -73,6 +77,12 @@ func (o *options) validate() error {
 	if got, min := o.threshold, 0; got < min {
 		return fmt.Errorf("invalid Threshold %d, must be %d <= Threshold", got, min)
 	}
+	if got, min := o.segments, 0; got < min {
+		return fmt.Errorf("invalid Segments count %d, must be %d <= count", got, min)
+	}
+	if got, min := o.segmentGap, 0; got < min {
+		return fmt.Errorf("invalid Segments gap %d, must be %d <= gap", got, min)
+	}
 	return nil
 }
 
@@ -113,6 +123,24 @@ func HideTextProgress() Option {
 	})
 }
 
+// ProgressFormatter formats the current progress into the text displayed by
+// the gauge. The current and total values are the ones provided on the last
+// call to Absolute() or Percent(), for Percent() the total is always 100.
+type ProgressFormatter func(current, total int) string
+
+// TextProgressFormatter configures the Gauge to use the provided formatter
+// when displaying the text enumerating the progress, e.g. to display absolute
+// values with units like "3 GB / 8 GB". The progress bar itself still
+// represents the ratio of current to total.
+// Has no effect if the HideTextProgress() option is provided.
+// If not provided, the text shows the percentage or the absolute numbers, see
+// ShowTextProgress().
+func TextProgressFormatter(pf ProgressFormatter) Option {
+	return option(func(opts *options) {
+		opts.progressFmt = pf
+	})
+}
+
 // Height sets the height of the drawn Gauge. Must be a positive number.
 // Defaults to zero which means the height of the container.
 func Height(height int) Option {
@@ -222,3 +250,19 @@ func Threshold(t int, ls linestyle.LineStyle, cOpts ...cell.Option) Option {
 		opts.thresholdCellOpts = cOpts
 	})
 }
+
+// Segments configures the Gauge to be drawn as n discrete segments separated
+// by gaps of the specified width in cells, similar to a battery meter. The
+// segments share the width of the gauge evenly and the number of segments lit
+// up is proportional to the current progress, rounded down. The lit segments
+// are drawn with the color set via the Color option.
+// The Gauge requires at least one cell per segment plus the gaps, it asks the
+// user to resize the terminal otherwise.
+// The count and the gap must be zero or positive. Defaults to zero segments,
+// i.e. a continuous gauge.
+func Segments(n int, gap int) Option {
+	return option(func(opts *options) {
+		opts.segments = n
+		opts.segmentGap = gap
+	})
+}
@@ -0,0 +1,163 @@
+// Copyright 2019 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linechart
+
+// aggregate.go contains code that aggregates dense series into pixel columns.
+
+import (
+	"fmt"
+	"image"
+	"math"
+
+	"github.com/mum4k/termdash/private/canvas/braille"
+	"github.com/mum4k/termdash/private/draw"
+	"github.com/mum4k/termdash/widgets/linechart/internal/axes"
+)
+
+// column is an aggregate of consecutive values of a series that fall onto the
+// same pixel column of the braille canvas.
+type column struct {
+	// x is the pixel column.
+	x int
+	// first and last are the pixels on the Y axis of the first and the last
+	// value in the column.
+	first, last int
+	// min and max are the smallest and the largest pixel on the Y axis of all
+	// the values in the column.
+	min, max int
+	// points is the number of values in the column.
+	points int
+	// connected indicates that a line connects the last value of the
+	// previous column to the first value of this column.
+	connected bool
+}
+
+// aggregateColumns projects the visible values of the series onto the pixels
+// of the braille canvas and aggregates them per pixel column.
+//
+// Drawing a vertical line between the minimum and the maximum of each column
+// and connecting the neighbouring columns results in exactly the same pixels
+// as drawing a line between every pair of values, since all the lines between
+// values in the same column are vertical. This keeps the number of drawn lines
+// proportional to the width of the graph instead of the number of values.
+//
+// Missing (NaN) values break the line, the columns on both sides of them
+// aren't connected unless connectNulls is true.
+func aggregateColumns(xd *axes.XDetails, yd *axes.YDetails, name string, values []float64, connectNulls bool) ([]*column, error) {
+	// Values outside of these indexes aren't supposed to be visible. These are
+	// either values outside of the current zoom or values at the beginning of
+	// a series that falls before the start of an unscaled X axis when the
+	// XAxisUnscaled option is provided.
+	minIdx := int(xd.Scale.Min.Value)
+	if minIdx < 0 {
+		minIdx = 0
+	}
+	maxIdx := int(xd.Scale.Max.Value)
+	if maxIdx > len(values)-1 {
+		maxIdx = len(values) - 1
+	}
+
+	var (
+		cols []*column
+		cur  *column
+		// broken indicates that the previous value was missing or not
+		// visible.
+		broken = true
+	)
+	for i := minIdx; i <= maxIdx; i++ {
+		v := values[i]
+		if math.IsNaN(v) {
+			if !connectNulls {
+				broken = true
+			}
+			continue
+		}
+
+		x, err := xd.Scale.ValueToPixel(i)
+		if err != nil {
+			return nil, fmt.Errorf("failure for series %v[%d] on scale %v, xd.Scale.ValueToPixel(%v) => %v", name, i, xd.Scale, i, err)
+		}
+		y, err := yd.Scale.ValueToPixel(v)
+		if err != nil {
+			return nil, fmt.Errorf("failure for series %v[%d] on scale %v, yd.Scale.ValueToPixel(%v) => %v", name, i, yd.Scale, v, err)
+		}
+
+		if cur != nil && !broken && cur.x == x {
+			cur.last = y
+			if y < cur.min {
+				cur.min = y
+			}
+			if y > cur.max {
+				cur.max = y
+			}
+			cur.points++
+			continue
+		}
+
+		cur = &column{
+			x:         x,
+			first:     y,
+			last:      y,
+			min:       y,
+			max:       y,
+			points:    1,
+			connected: !broken,
+		}
+		cols = append(cols, cur)
+		broken = false
+	}
+	return cols, nil
+}
+
+// drawSeriesLines draws the lines that represent the values of the series
+// onto the braille canvas.
+func drawSeriesLines(bc *braille.Canvas, xd *axes.XDetails, yd *axes.YDetails, name string, sv *seriesValues, connectNulls bool) error {
+	cols, err := aggregateColumns(xd, yd, name, sv.values, connectNulls)
+	if err != nil {
+		return err
+	}
+
+	for i, col := range cols {
+		if col.connected {
+			prev := cols[i-1]
+			start := image.Point{prev.x, prev.last}
+			end := image.Point{col.x, col.first}
+			path := []image.Point{start}
+			if sv.stepped {
+				// Continue horizontally at the previous value, then step
+				// vertically to the next one.
+				path = append(path, image.Point{end.X, start.Y})
+			}
+			path = append(path, end)
+			if err := draw.BraillePolyline(bc, path,
+				draw.BrailleLineCellOpts(sv.seriesCellOpts...),
+			); err != nil {
+				return fmt.Errorf("draw.BraillePolyline => %v", err)
+			}
+		}
+
+		// A single value can't be drawn as a line.
+		if col.points > 1 {
+			if err := draw.BrailleLine(bc,
+				image.Point{col.x, col.min},
+				image.Point{col.x, col.max},
+				draw.BrailleLineCellOpts(sv.seriesCellOpts...),
+			); err != nil {
+				return fmt.Errorf("draw.BrailleLine => %v", err)
+			}
+		}
+	}
+	return nil
+}
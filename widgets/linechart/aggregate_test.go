@@ -0,0 +1,251 @@
+// Copyright 2019 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linechart
+
+import (
+	"image"
+	"math"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/mum4k/termdash/private/canvas/braille"
+	"github.com/mum4k/termdash/private/draw"
+	"github.com/mum4k/termdash/private/faketerm"
+	"github.com/mum4k/termdash/widgets/linechart/internal/axes"
+)
+
+// mustDetails returns details of axes with scales that fit the specified
+// number of values and the range of values into a braille canvas of the
+// specified size in cells.
+func mustDetails(t *testing.T, values int, minV, maxV float64, cells image.Point) (*axes.XDetails, *axes.YDetails) {
+	t.Helper()
+	xs, err := axes.NewXScale(0, values-1, cells.X, 0)
+	if err != nil {
+		t.Fatalf("axes.NewXScale => unexpected error: %v", err)
+	}
+	ys, err := axes.NewYScale(minV, maxV, cells.Y, 0, axes.YScaleModeAnchored, nil)
+	if err != nil {
+		t.Fatalf("axes.NewYScale => unexpected error: %v", err)
+	}
+	return &axes.XDetails{Scale: xs}, &axes.YDetails{Scale: ys}
+}
+
+// denseValues returns a series of values much longer than the width of the
+// graph with spikes that must remain visible.
+func denseValues(n int) []float64 {
+	var values []float64
+	for i := 0; i < n; i++ {
+		v := 50 + 10*math.Sin(float64(i)/10)
+		switch {
+		case i%97 == 0:
+			v = 100
+		case i%89 == 0:
+			v = 0
+		case i%151 == 0:
+			v = math.NaN()
+		}
+		values = append(values, v)
+	}
+	return values
+}
+
+func TestAggregateColumns(t *testing.T) {
+	tests := []struct {
+		desc         string
+		values       []float64
+		connectNulls bool
+		want         []*column
+	}{
+		{
+			desc:   "no values",
+			values: nil,
+		},
+		{
+			desc:   "every value in its own column",
+			values: []float64{0, 100, 50, 100},
+			want: []*column{
+				{x: 0, first: 3, last: 3, min: 3, max: 3, points: 1},
+				{x: 1, first: 0, last: 0, min: 0, max: 0, points: 1, connected: true},
+				{x: 2, first: 1, last: 1, min: 1, max: 1, points: 1, connected: true},
+				{x: 3, first: 0, last: 0, min: 0, max: 0, points: 1, connected: true},
+			},
+		},
+		{
+			desc:   "missing values break the line",
+			values: []float64{0, math.NaN(), 50, 100},
+			want: []*column{
+				{x: 0, first: 3, last: 3, min: 3, max: 3, points: 1},
+				{x: 2, first: 1, last: 1, min: 1, max: 1, points: 1},
+				{x: 3, first: 0, last: 0, min: 0, max: 0, points: 1, connected: true},
+			},
+		},
+		{
+			desc:         "missing values are bridged with connectNulls",
+			values:       []float64{0, math.NaN(), math.NaN(), 100},
+			connectNulls: true,
+			want: []*column{
+				{x: 0, first: 3, last: 3, min: 3, max: 3, points: 1},
+				{x: 3, first: 0, last: 0, min: 0, max: 0, points: 1, connected: true},
+			},
+		},
+		{
+			desc:         "leading missing values with connectNulls",
+			values:       []float64{math.NaN(), 0, 50, math.NaN()},
+			connectNulls: true,
+			want: []*column{
+				{x: 1, first: 3, last: 3, min: 3, max: 3, points: 1},
+				{x: 2, first: 1, last: 1, min: 1, max: 1, points: 1, connected: true},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			xd, yd := mustDetails(t, 4, 0, 100, image.Point{2, 1})
+			got, err := aggregateColumns(xd, yd, "series", tc.values, tc.connectNulls)
+			if err != nil {
+				t.Fatalf("aggregateColumns => unexpected error: %v", err)
+			}
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("aggregateColumns => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAggregateColumnsPreservesExtremes(t *testing.T) {
+	values := denseValues(10000)
+	xd, yd := mustDetails(t, len(values), 0, 100, image.Point{40, 10})
+
+	wantMin := map[int]int{}
+	wantMax := map[int]int{}
+	for i, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+		x, err := xd.Scale.ValueToPixel(i)
+		if err != nil {
+			t.Fatalf("ValueToPixel => unexpected error: %v", err)
+		}
+		y, err := yd.Scale.ValueToPixel(v)
+		if err != nil {
+			t.Fatalf("ValueToPixel => unexpected error: %v", err)
+		}
+		if m, ok := wantMin[x]; !ok || y < m {
+			wantMin[x] = y
+		}
+		if m, ok := wantMax[x]; !ok || y > m {
+			wantMax[x] = y
+		}
+	}
+
+	cols, err := aggregateColumns(xd, yd, "series", values, false)
+	if err != nil {
+		t.Fatalf("aggregateColumns => unexpected error: %v", err)
+	}
+	gotMin := map[int]int{}
+	gotMax := map[int]int{}
+	for _, col := range cols {
+		// A column is split in two by a missing value.
+		if m, ok := gotMin[col.x]; !ok || col.min < m {
+			gotMin[col.x] = col.min
+		}
+		if m, ok := gotMax[col.x]; !ok || col.max > m {
+			gotMax[col.x] = col.max
+		}
+	}
+
+	if diff := pretty.Compare(wantMin, gotMin); diff != "" {
+		t.Errorf("aggregateColumns => unexpected minimums per column (-want, +got):\n%s", diff)
+	}
+	if diff := pretty.Compare(wantMax, gotMax); diff != "" {
+		t.Errorf("aggregateColumns => unexpected maximums per column (-want, +got):\n%s", diff)
+	}
+}
+
+func TestDrawSeriesLinesMatchesAllLines(t *testing.T) {
+	values := denseValues(2000)
+	cells := image.Point{20, 5}
+	xd, yd := mustDetails(t, len(values), 0, 100, cells)
+
+	got, err := braille.New(image.Rect(0, 0, cells.X, cells.Y))
+	if err != nil {
+		t.Fatalf("braille.New => unexpected error: %v", err)
+	}
+	if err := drawSeriesLines(got, xd, yd, "series", &seriesValues{values: values}, false); err != nil {
+		t.Fatalf("drawSeriesLines => unexpected error: %v", err)
+	}
+
+	// Draw a line between every pair of values.
+	want, err := braille.New(image.Rect(0, 0, cells.X, cells.Y))
+	if err != nil {
+		t.Fatalf("braille.New => unexpected error: %v", err)
+	}
+	for i := 1; i < len(values); i++ {
+		if math.IsNaN(values[i-1]) || math.IsNaN(values[i]) {
+			continue
+		}
+		start, err := valuePixel(xd, yd, i-1, values[i-1])
+		if err != nil {
+			t.Fatalf("valuePixel => unexpected error: %v", err)
+		}
+		end, err := valuePixel(xd, yd, i, values[i])
+		if err != nil {
+			t.Fatalf("valuePixel => unexpected error: %v", err)
+		}
+		if err := draw.BrailleLine(want, start, end); err != nil {
+			t.Fatalf("draw.BrailleLine => unexpected error: %v", err)
+		}
+	}
+
+	gotFt := faketerm.MustNew(cells)
+	if err := got.Apply(gotFt); err != nil {
+		t.Fatalf("Apply => unexpected error: %v", err)
+	}
+	wantFt := faketerm.MustNew(cells)
+	if err := want.Apply(wantFt); err != nil {
+		t.Fatalf("Apply => unexpected error: %v", err)
+	}
+	if diff := faketerm.Diff(wantFt, gotFt); diff != "" {
+		t.Errorf("drawSeriesLines => %v", diff)
+	}
+}
+
+func BenchmarkDrawSeriesLines(b *testing.B) {
+	values := denseValues(50000)
+	cells := image.Point{80, 20}
+	xs, err := axes.NewXScale(0, len(values)-1, cells.X, 0)
+	if err != nil {
+		b.Fatalf("axes.NewXScale => unexpected error: %v", err)
+	}
+	ys, err := axes.NewYScale(0, 100, cells.Y, 0, axes.YScaleModeAnchored, nil)
+	if err != nil {
+		b.Fatalf("axes.NewYScale => unexpected error: %v", err)
+	}
+	xd := &axes.XDetails{Scale: xs}
+	yd := &axes.YDetails{Scale: ys}
+	sv := &seriesValues{values: values}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bc, err := braille.New(image.Rect(0, 0, cells.X, cells.Y))
+		if err != nil {
+			b.Fatalf("braille.New => unexpected error: %v", err)
+		}
+		if err := drawSeriesLines(bc, xd, yd, "series", sv, false); err != nil {
+			b.Fatalf("drawSeriesLines => unexpected error: %v", err)
+		}
+	}
+}
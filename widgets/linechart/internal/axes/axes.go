@@ -76,6 +76,9 @@ type YProperties struct {
 	ScaleMode YScaleMode
 	// ValueFormatter is the formatter used to format numeric values to string representation.
 	ValueFormatter func(float64) string
+	// Ticks is the requested number of labels on the axis or zero to place
+	// the labels at the default spacing.
+	Ticks int
 }
 
 // NewYDetails retrieves details about the Y axis required to draw it on a
@@ -96,7 +99,7 @@ func NewYDetails(cvsAr image.Rectangle, yp *YProperties) (*YDetails, error) {
 
 	// See how the labels would look like on the entire maxWidth.
 	maxLabelWidth := maxWidth - axisWidth
-	labels, err := yLabels(scale, maxLabelWidth)
+	labels, err := yLabels(scale, maxLabelWidth, yp.Ticks)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +110,7 @@ func NewYDetails(cvsAr image.Rectangle, yp *YProperties) (*YDetails, error) {
 	widest := longestLabel(labels)
 	if widest < maxLabelWidth {
 		// Save the space and recalculate the labels, since they need to be realigned.
-		l, err := yLabels(scale, widest)
+		l, err := yLabels(scale, widest, yp.Ticks)
 		if err != nil {
 			return nil, err
 		}
@@ -126,6 +129,48 @@ func NewYDetails(cvsAr image.Rectangle, yp *YProperties) (*YDetails, error) {
 	}, nil
 }
 
+// NewSecondaryYDetails retrieves details about a secondary Y axis drawn along
+// the right side of a canvas of the provided area. The labels are placed
+// right of the axis and aligned to the left.
+// The reqYWidth is the width required for the primary Y axis and its labels on
+// the left side of the canvas.
+func NewSecondaryYDetails(cvsAr image.Rectangle, reqYWidth int, yp *YProperties) (*YDetails, error) {
+	maxWidth := cvsAr.Dx() - reqYWidth - 1 // Reserve one column for the line chart itself.
+	if req := RequiredWidth(yp.Min, yp.Max); maxWidth < req {
+		return nil, fmt.Errorf("the available maxWidth %d is smaller than the reported required width %d", maxWidth, req)
+	}
+
+	graphHeight := cvsAr.Dy() - yp.ReqXHeight
+	scale, err := NewYScale(yp.Min, yp.Max, graphHeight, nonZeroDecimals, yp.ScaleMode, yp.ValueFormatter)
+	if err != nil {
+		return nil, err
+	}
+
+	maxLabelWidth := maxWidth - axisWidth
+	labels, err := yLabels(scale, maxLabelWidth, yp.Ticks)
+	if err != nil {
+		return nil, err
+	}
+
+	width := maxWidth
+	if widest := longestLabel(labels); widest < maxLabelWidth {
+		// Save the space for the line chart itself.
+		width = widest + axisWidth
+	}
+
+	axisX := cvsAr.Dx() - width
+	for _, l := range labels {
+		l.Pos.X = axisX + axisWidth
+	}
+	return &YDetails{
+		Width:  width,
+		Start:  image.Point{axisX, 0},
+		End:    image.Point{axisX, graphHeight},
+		Scale:  scale,
+		Labels: labels,
+	}, nil
+}
+
 // longestLabel returns the width of the widest label.
 func longestLabel(labels []*Label) int {
 	var widest int
@@ -176,6 +221,9 @@ type XProperties struct {
 	CustomLabels map[int]string
 	// LO is the desired orientation of labels under the X axis.
 	LO LabelOrientation
+	// LabelSkip when larger than one places labels only on every LabelSkip
+	// value starting at Min.
+	LabelSkip int
 }
 
 // NewXDetails retrieves details about the X axis required to draw it on a canvas
@@ -205,7 +253,7 @@ func NewXDetails(cvsAr image.Rectangle, xp *XProperties) (*XDetails, error) {
 		xp.ReqYWidth + 1,
 		cvsAr.Dy() - reqHeight - 1,
 	}
-	labels, err := xLabels(scale, graphZero, xp.CustomLabels, xp.LO)
+	labels, err := xLabels(scale, graphZero, xp.CustomLabels, xp.LO, xp.LabelSkip)
 	if err != nil {
 		return nil, err
 	}
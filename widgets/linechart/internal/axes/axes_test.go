@@ -241,6 +241,94 @@ func TestY(t *testing.T) {
 	}
 }
 
+func TestSecondaryY(t *testing.T) {
+	tests := []struct {
+		desc      string
+		yp        *YProperties
+		cvsAr     image.Rectangle
+		reqYWidth int
+		want      *YDetails
+		wantErr   bool
+	}{
+		{
+			desc: "fails when the primary axis leaves no space",
+			yp: &YProperties{
+				Min:        0,
+				Max:        3,
+				ReqXHeight: 2,
+			},
+			cvsAr:     image.Rect(0, 0, 4, 4),
+			reqYWidth: 2,
+			wantErr:   true,
+		},
+		{
+			desc: "fails when max is less than min",
+			yp: &YProperties{
+				Min:        0,
+				Max:        -1,
+				ReqXHeight: 2,
+			},
+			cvsAr:   image.Rect(0, 0, 10, 4),
+			wantErr: true,
+		},
+		{
+			desc: "canvas width equals required width",
+			yp: &YProperties{
+				Min:        0,
+				Max:        3,
+				ReqXHeight: 2,
+			},
+			cvsAr:     image.Rect(0, 0, 5, 4),
+			reqYWidth: 2,
+			want: &YDetails{
+				Width: 2,
+				Start: image.Point{3, 0},
+				End:   image.Point{3, 2},
+				Scale: mustNewYScale(0, 3, 2, nonZeroDecimals, YScaleModeAnchored, nil),
+				Labels: []*Label{
+					{NewValue(0, nonZeroDecimals), image.Point{4, 1}},
+					{NewValue(1.72, nonZeroDecimals), image.Point{4, 0}},
+				},
+			},
+		},
+		{
+			desc: "axis is placed on the right edge of a wider canvas",
+			yp: &YProperties{
+				Min:        0,
+				Max:        3,
+				ReqXHeight: 2,
+			},
+			cvsAr:     image.Rect(0, 0, 20, 4),
+			reqYWidth: 2,
+			want: &YDetails{
+				Width: 5,
+				Start: image.Point{15, 0},
+				End:   image.Point{15, 2},
+				Scale: mustNewYScale(0, 3, 2, nonZeroDecimals, YScaleModeAnchored, nil),
+				Labels: []*Label{
+					{NewValue(0, nonZeroDecimals), image.Point{16, 1}},
+					{NewValue(1.72, nonZeroDecimals), image.Point{16, 0}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := NewSecondaryYDetails(tc.cvsAr, tc.reqYWidth, tc.yp)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("NewSecondaryYDetails => unexpected error: %v, wantErr: %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("NewSecondaryYDetails => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestNewXDetails(t *testing.T) {
 	tests := []struct {
 		desc    string
@@ -19,9 +19,11 @@ package axes
 import (
 	"fmt"
 	"image"
+	"math"
 
 	"github.com/mum4k/termdash/align"
 	"github.com/mum4k/termdash/private/alignfor"
+	"github.com/mum4k/termdash/private/runewidth"
 )
 
 // LabelOrientation represents the orientation of text labels.
@@ -67,7 +69,8 @@ type Label struct {
 // Label value is not trimmed to the provided labelWidth, the label width is
 // only used to align the labels. Alignment is done with the assumption that
 // longer labels will be trimmed.
-func yLabels(scale *YScale, labelWidth int) ([]*Label, error) {
+// The ticks is the requested number of labels, see labelRows.
+func yLabels(scale *YScale, labelWidth, ticks int) ([]*Label, error) {
 	if min := 2; scale.GraphHeight < min {
 		return nil, fmt.Errorf("cannot place labels on a canvas with height %d, minimum is %d", scale.GraphHeight, min)
 	}
@@ -76,9 +79,8 @@ func yLabels(scale *YScale, labelWidth int) ([]*Label, error) {
 	}
 
 	var labels []*Label
-	const labelSpacing = 4
 	seen := map[string]bool{}
-	for y := scale.GraphHeight - 1; y >= 0; y -= labelSpacing {
+	for _, y := range labelRows(scale.GraphHeight, ticks) {
 		label, err := rowLabel(scale, y, labelWidth)
 		if err != nil {
 			return nil, err
@@ -102,6 +104,34 @@ func yLabels(scale *YScale, labelWidth int) ([]*Label, error) {
 	return labels, nil
 }
 
+// labelSpacing is the number of rows between the Y labels when the number of
+// labels isn't specified.
+const labelSpacing = 4
+
+// labelRows returns the rows on which the Y labels are placed, starting with
+// the bottom row. If ticks is zero, the labels are placed every labelSpacing
+// rows. Otherwise the ticks labels are evenly spaced between the bottom and
+// the top row, if the graph doesn't have enough rows, a label is placed on
+// every row.
+func labelRows(graphHeight, ticks int) []int {
+	var rows []int
+	if ticks <= 0 {
+		for y := graphHeight - 1; y >= 0; y -= labelSpacing {
+			rows = append(rows, y)
+		}
+		return rows
+	}
+
+	if ticks > graphHeight {
+		ticks = graphHeight
+	}
+	last := graphHeight - 1
+	for i := 0; i < ticks; i++ {
+		rows = append(rows, last-int(math.Round(float64(i*last)/float64(ticks-1))))
+	}
+	return rows
+}
+
 // rowLabelArea determines the area available for labels on the specified row.
 // The row is the Y coordinate of the row, Y coordinates grow down.
 func rowLabelArea(row int, labelWidth int) image.Rectangle {
@@ -189,10 +219,15 @@ func (xs *xSpace) Sub(size int) error {
 // fit under the width of the axis.
 // The customLabels map value positions in the series to the desired custom
 // label. These are preferred if present.
-func xLabels(scale *XScale, graphZero image.Point, customLabels map[int]string, lo LabelOrientation) ([]*Label, error) {
+// If labelSkip is larger than one, labels are only placed on the minimum
+// value and then on every labelSkip value after it.
+func xLabels(scale *XScale, graphZero image.Point, customLabels map[int]string, lo LabelOrientation, labelSkip int) ([]*Label, error) {
 	space := newXSpace(graphZero, scale.GraphWidth)
 	const minSpacing = 3
 	var res []*Label
+	if labelSkip < 1 {
+		labelSkip = 1
+	}
 
 	next := int(scale.Min.Value)
 	for haveLabels := 0; haveLabels <= int(scale.Max.Value); haveLabels = len(res) {
@@ -205,7 +240,7 @@ func xLabels(scale *XScale, graphZero image.Point, customLabels map[int]string,
 		}
 		res = append(res, label)
 
-		next++
+		next += labelSkip
 		if next > int(scale.Max.Value) {
 			break
 		}
@@ -215,6 +250,20 @@ func xLabels(scale *XScale, graphZero image.Point, customLabels map[int]string,
 		}
 
 		skip := nextCell - space.Relative().X
+		// When skipping labels, keep them on every labelSkip value counted
+		// from the minimum by skipping more of them if they would be too
+		// close.
+		for labelSkip > 1 && skip < minSpacing {
+			next += labelSkip
+			if next > int(scale.Max.Value) {
+				return res, nil
+			}
+			nextCell, err := scale.ValueToCell(next)
+			if err != nil {
+				return nil, err
+			}
+			skip = nextCell - space.Relative().X
+		}
 		if skip < minSpacing {
 			skip = minSpacing
 		}
@@ -246,7 +295,7 @@ func colLabel(scale *XScale, space *xSpace, customLabels map[int]string, lo Labe
 	var labelLen int
 	switch lo {
 	case LabelOrientationHorizontal:
-		labelLen = len(label.Text())
+		labelLen = runewidth.StringWidth(label.Text())
 	case LabelOrientationVertical:
 		labelLen = 1
 	}
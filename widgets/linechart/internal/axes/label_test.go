@@ -29,6 +29,7 @@ func TestYLabels(t *testing.T) {
 		max         float64
 		graphHeight int
 		labelWidth  int
+		ticks       int
 		want        []*Label
 		wantErr     bool
 	}{
@@ -125,6 +126,48 @@ func TestYLabels(t *testing.T) {
 				{NewValue(4.16, nonZeroDecimals), image.Point{0, 1}},
 			},
 		},
+		{
+			desc:        "requested number of labels on a tall canvas",
+			min:         0,
+			max:         5,
+			graphHeight: 20,
+			labelWidth:  1,
+			ticks:       5,
+			want: []*Label{
+				{NewValue(0, nonZeroDecimals), image.Point{0, 19}},
+				{NewValue(1.28, nonZeroDecimals), image.Point{0, 14}},
+				{NewValue(2.56, nonZeroDecimals), image.Point{0, 9}},
+				{NewValue(3.584, nonZeroDecimals), image.Point{0, 5}},
+				{NewValue(4.864, nonZeroDecimals), image.Point{0, 0}},
+			},
+		},
+		{
+			desc:        "requested number of labels, last on the top",
+			min:         0,
+			max:         10,
+			graphHeight: 12,
+			labelWidth:  1,
+			ticks:       4,
+			want: []*Label{
+				{NewValue(0, nonZeroDecimals), image.Point{0, 11}},
+				{NewValue(3.52, nonZeroDecimals), image.Point{0, 7}},
+				{NewValue(6.16, nonZeroDecimals), image.Point{0, 4}},
+				{NewValue(9.68, nonZeroDecimals), image.Point{0, 0}},
+			},
+		},
+		{
+			desc:        "falls back to a label on every row when there isn't enough rows",
+			min:         0,
+			max:         5,
+			graphHeight: 3,
+			labelWidth:  1,
+			ticks:       5,
+			want: []*Label{
+				{NewValue(0, nonZeroDecimals), image.Point{0, 2}},
+				{NewValue(1.84, nonZeroDecimals), image.Point{0, 1}},
+				{NewValue(3.68, nonZeroDecimals), image.Point{0, 0}},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -134,7 +177,7 @@ func TestYLabels(t *testing.T) {
 				t.Fatalf("NewYScale => unexpected error: %v", err)
 			}
 			t.Logf("scale step: %v", scale.Step.Rounded)
-			got, err := yLabels(scale, tc.labelWidth)
+			got, err := yLabels(scale, tc.labelWidth, tc.ticks)
 			if (err != nil) != tc.wantErr {
 				t.Errorf("yLabels => unexpected error: %v, wantErr: %v", err, tc.wantErr)
 			}
@@ -158,6 +201,7 @@ func TestXLabels(t *testing.T) {
 		graphZero        image.Point
 		customLabels     map[int]string
 		labelOrientation LabelOrientation
+		labelSkip        int
 		want             []*Label
 		wantErr          bool
 	}{
@@ -449,6 +493,75 @@ func TestXLabels(t *testing.T) {
 				{NewTextValue("this label just keeps on going"), image.Point{8, 3}},
 			},
 		},
+		{
+			desc:       "label skip places labels on every nth value",
+			min:        0,
+			max:        10,
+			graphWidth: 100,
+			graphZero:  image.Point{0, 1},
+			labelSkip:  5,
+			want: []*Label{
+				{NewValue(0, nonZeroDecimals), image.Point{0, 3}},
+				{NewValue(5, nonZeroDecimals), image.Point{49, 3}},
+				{NewValue(10, nonZeroDecimals), image.Point{98, 3}},
+			},
+		},
+		{
+			desc:       "label skip counts from min",
+			min:        3,
+			max:        13,
+			graphWidth: 100,
+			graphZero:  image.Point{0, 1},
+			labelSkip:  5,
+			want: []*Label{
+				{NewValue(3, nonZeroDecimals), image.Point{0, 3}},
+				{NewValue(8, nonZeroDecimals), image.Point{49, 3}},
+				{NewValue(13, nonZeroDecimals), image.Point{98, 3}},
+			},
+		},
+		{
+			desc:       "dense labels are thinned to fit the width",
+			min:        0,
+			max:        99,
+			graphWidth: 20,
+			graphZero:  image.Point{0, 1},
+			want: []*Label{
+				{NewValue(0, nonZeroDecimals), image.Point{0, 3}},
+				{NewValue(20, nonZeroDecimals), image.Point{4, 3}},
+				{NewValue(46, nonZeroDecimals), image.Point{9, 3}},
+				{NewValue(71, nonZeroDecimals), image.Point{14, 3}},
+			},
+		},
+		{
+			desc:       "dense labels with label skip are thinned to every nth value that fits",
+			min:        0,
+			max:        99,
+			graphWidth: 20,
+			graphZero:  image.Point{0, 1},
+			labelSkip:  10,
+			want: []*Label{
+				{NewValue(0, nonZeroDecimals), image.Point{0, 3}},
+				{NewValue(20, nonZeroDecimals), image.Point{4, 3}},
+				{NewValue(51, nonZeroDecimals), image.Point{10, 3}},
+				{NewValue(76, nonZeroDecimals), image.Point{15, 3}},
+			},
+		},
+		{
+			desc:       "spacing accounts for the width of full-width runes",
+			min:        0,
+			max:        2,
+			graphWidth: 10,
+			graphZero:  image.Point{0, 1},
+			customLabels: map[int]string{
+				0: "世界",
+				1: "ab",
+				2: "c",
+			},
+			want: []*Label{
+				{NewTextValue("世界"), image.Point{0, 3}},
+				{NewTextValue("c"), image.Point{7, 3}},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -458,7 +571,7 @@ func TestXLabels(t *testing.T) {
 				t.Fatalf("NewXScale => unexpected error: %v", err)
 			}
 			t.Logf("scale step: %v, label orientation: %v", scale.Step.Rounded, tc.labelOrientation)
-			got, err := xLabels(scale, tc.graphZero, tc.customLabels, tc.labelOrientation)
+			got, err := xLabels(scale, tc.graphZero, tc.customLabels, tc.labelOrientation, tc.labelSkip)
 			if (err != nil) != tc.wantErr {
 				t.Errorf("xLabels => unexpected error: %v, wantErr: %v", err, tc.wantErr)
 			}
@@ -18,10 +18,12 @@ package zoom
 import (
 	"fmt"
 	"image"
+	"math"
 	"reflect"
 
 	"github.com/mum4k/termdash/mouse"
 	"github.com/mum4k/termdash/private/button"
+	"github.com/mum4k/termdash/private/canvas/braille"
 	"github.com/mum4k/termdash/private/numbers"
 	"github.com/mum4k/termdash/terminal/terminalapi"
 	"github.com/mum4k/termdash/widgets/linechart/internal/axes"
@@ -100,6 +102,10 @@ type Tracker struct {
 	// highlight is the currently highlighted area.
 	highlight *Range
 
+	// pan is the state of an ongoing drag with the right mouse button or nil
+	// if the user isn't currently panning.
+	pan *panState
+
 	// opts are the provided options.
 	opts *options
 }
@@ -139,6 +145,7 @@ func (t *Tracker) Update(baseX *axes.XDetails, cvsAr, graphAr image.Rectangle) e
 		t.fsm.UpdateArea(graphAr)
 	}
 	if ac || sc {
+		t.pan = nil
 		if t.zoomX != nil {
 			// Input data changed and we have an existing zoom in place.
 			// We need to normalize it again, since it might be outside of the
@@ -202,6 +209,10 @@ func (t *Tracker) Mouse(m *terminalapi.Mouse) error {
 		}
 	}
 
+	if err := t.trackPan(m); err != nil {
+		return err
+	}
+
 	clicked, bs := t.fsm.Event(m)
 	switch {
 	case bs == button.Down:
@@ -224,6 +235,60 @@ func (t *Tracker) Mouse(m *terminalapi.Mouse) error {
 	return nil
 }
 
+// panState stores the state of an ongoing pan.
+type panState struct {
+	// startX is the X coordinate of the cell where the drag started.
+	startX int
+	// min and max are the values of the zoomed X axis when the drag started.
+	min, max int
+}
+
+// trackPan tracks the right mouse button being dragged across the graph area
+// and shifts the zoomed X axis accordingly. Panning is only possible when
+// zoom is applied and is clamped at the bounds of the base X axis.
+func (t *Tracker) trackPan(m *terminalapi.Mouse) error {
+	if m.Button != mouse.ButtonRight || t.zoomX == nil {
+		t.pan = nil
+		return nil
+	}
+
+	if t.pan == nil {
+		if !m.Position.In(t.graphAr) {
+			return nil
+		}
+		t.pan = &panState{
+			startX: m.Position.X,
+			min:    int(t.zoomX.Scale.Min.Value),
+			max:    int(t.zoomX.Scale.Max.Value),
+		}
+		return nil
+	}
+
+	// Dragging to the right reveals the earlier values and vice versa.
+	pixels := (t.pan.startX - m.Position.X) * braille.ColMult
+	shift := int(math.Round(float64(pixels) * t.zoomX.Scale.Step.Value))
+
+	baseMin := int(t.baseX.Scale.Min.Value)
+	baseMax := int(t.baseX.Scale.Max.Value)
+	if min := t.pan.min + shift; min < baseMin {
+		shift = baseMin - t.pan.min
+	}
+	if max := t.pan.max + shift; max > baseMax {
+		shift = baseMax - t.pan.max
+	}
+
+	min, max := t.pan.min+shift, t.pan.max+shift
+	if min == int(t.zoomX.Scale.Min.Value) && max == int(t.zoomX.Scale.Max.Value) {
+		return nil
+	}
+	zoom, err := newZoomedFromBase(min, max, t.baseX, t.cvsAr)
+	if err != nil {
+		return err
+	}
+	t.zoomX = zoom
+	return nil
+}
+
 // Range represents a range of values.
 // The range includes all values x such that Start <= x < End.
 type Range struct {
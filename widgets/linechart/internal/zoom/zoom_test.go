@@ -1186,6 +1186,292 @@ func TestTracker(t *testing.T) {
 				},
 			),
 		},
+		{
+			desc: "dragging with right button pans the zoomed view to later values",
+			xp: &axes.XProperties{
+				Min:       0,
+				Max:       100,
+				ReqYWidth: 2,
+			},
+			cvsAr:   image.Rect(0, 0, 14, 8),
+			graphAr: image.Rect(3, 0, 14, 8),
+			mutate: func(tr *Tracker) error {
+				zoom, err := newZoomedFromBase(40, 61, tr.baseX, tr.cvsAr)
+				if err != nil {
+					return err
+				}
+				tr.zoomX = zoom
+
+				for _, m := range []*terminalapi.Mouse{
+					{Position: image.Point{10, 0}, Button: mouse.ButtonRight},
+					{Position: image.Point{7, 0}, Button: mouse.ButtonRight},
+				} {
+					if err := tr.Mouse(m); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			wantHighlight: false,
+			wantZoom: mustNewXDetails(
+				image.Rect(0, 0, 14, 8),
+				&axes.XProperties{
+					Min:       46,
+					Max:       67,
+					ReqYWidth: 2,
+				},
+			),
+		},
+		{
+			desc: "dragging with right button pans the zoomed view to earlier values",
+			xp: &axes.XProperties{
+				Min:       0,
+				Max:       100,
+				ReqYWidth: 2,
+			},
+			cvsAr:   image.Rect(0, 0, 14, 8),
+			graphAr: image.Rect(3, 0, 14, 8),
+			mutate: func(tr *Tracker) error {
+				zoom, err := newZoomedFromBase(40, 61, tr.baseX, tr.cvsAr)
+				if err != nil {
+					return err
+				}
+				tr.zoomX = zoom
+
+				for _, m := range []*terminalapi.Mouse{
+					{Position: image.Point{7, 0}, Button: mouse.ButtonRight},
+					{Position: image.Point{10, 0}, Button: mouse.ButtonRight},
+				} {
+					if err := tr.Mouse(m); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			wantHighlight: false,
+			wantZoom: mustNewXDetails(
+				image.Rect(0, 0, 14, 8),
+				&axes.XProperties{
+					Min:       34,
+					Max:       55,
+					ReqYWidth: 2,
+				},
+			),
+		},
+		{
+			desc: "panning tracks the drag relative to where it started",
+			xp: &axes.XProperties{
+				Min:       0,
+				Max:       100,
+				ReqYWidth: 2,
+			},
+			cvsAr:   image.Rect(0, 0, 14, 8),
+			graphAr: image.Rect(3, 0, 14, 8),
+			mutate: func(tr *Tracker) error {
+				zoom, err := newZoomedFromBase(40, 61, tr.baseX, tr.cvsAr)
+				if err != nil {
+					return err
+				}
+				tr.zoomX = zoom
+
+				for _, m := range []*terminalapi.Mouse{
+					{Position: image.Point{10, 0}, Button: mouse.ButtonRight},
+					{Position: image.Point{7, 0}, Button: mouse.ButtonRight},
+					{Position: image.Point{8, 0}, Button: mouse.ButtonRight},
+				} {
+					if err := tr.Mouse(m); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			wantHighlight: false,
+			wantZoom: mustNewXDetails(
+				image.Rect(0, 0, 14, 8),
+				&axes.XProperties{
+					Min:       44,
+					Max:       65,
+					ReqYWidth: 2,
+				},
+			),
+		},
+		{
+			desc: "panning clamps at the minimum of the base axis",
+			xp: &axes.XProperties{
+				Min:       0,
+				Max:       100,
+				ReqYWidth: 2,
+			},
+			cvsAr:   image.Rect(0, 0, 14, 8),
+			graphAr: image.Rect(3, 0, 14, 8),
+			mutate: func(tr *Tracker) error {
+				zoom, err := newZoomedFromBase(10, 31, tr.baseX, tr.cvsAr)
+				if err != nil {
+					return err
+				}
+				tr.zoomX = zoom
+
+				for _, m := range []*terminalapi.Mouse{
+					{Position: image.Point{3, 0}, Button: mouse.ButtonRight},
+					{Position: image.Point{13, 0}, Button: mouse.ButtonRight},
+				} {
+					if err := tr.Mouse(m); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			wantHighlight: false,
+			wantZoom: mustNewXDetails(
+				image.Rect(0, 0, 14, 8),
+				&axes.XProperties{
+					Min:       0,
+					Max:       21,
+					ReqYWidth: 2,
+				},
+			),
+		},
+		{
+			desc: "panning clamps at the maximum of the base axis",
+			xp: &axes.XProperties{
+				Min:       0,
+				Max:       100,
+				ReqYWidth: 2,
+			},
+			cvsAr:   image.Rect(0, 0, 14, 8),
+			graphAr: image.Rect(3, 0, 14, 8),
+			mutate: func(tr *Tracker) error {
+				zoom, err := newZoomedFromBase(70, 91, tr.baseX, tr.cvsAr)
+				if err != nil {
+					return err
+				}
+				tr.zoomX = zoom
+
+				for _, m := range []*terminalapi.Mouse{
+					{Position: image.Point{13, 0}, Button: mouse.ButtonRight},
+					{Position: image.Point{3, 0}, Button: mouse.ButtonRight},
+				} {
+					if err := tr.Mouse(m); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			wantHighlight: false,
+			wantZoom: mustNewXDetails(
+				image.Rect(0, 0, 14, 8),
+				&axes.XProperties{
+					Min:       79,
+					Max:       100,
+					ReqYWidth: 2,
+				},
+			),
+		},
+		{
+			desc: "releasing the button ends the pan",
+			xp: &axes.XProperties{
+				Min:       0,
+				Max:       100,
+				ReqYWidth: 2,
+			},
+			cvsAr:   image.Rect(0, 0, 14, 8),
+			graphAr: image.Rect(3, 0, 14, 8),
+			mutate: func(tr *Tracker) error {
+				zoom, err := newZoomedFromBase(40, 61, tr.baseX, tr.cvsAr)
+				if err != nil {
+					return err
+				}
+				tr.zoomX = zoom
+
+				for _, m := range []*terminalapi.Mouse{
+					{Position: image.Point{10, 0}, Button: mouse.ButtonRight},
+					{Position: image.Point{7, 0}, Button: mouse.ButtonRight},
+					{Position: image.Point{7, 0}, Button: mouse.ButtonRelease},
+					{Position: image.Point{5, 0}, Button: mouse.ButtonRight},
+					{Position: image.Point{6, 0}, Button: mouse.ButtonRight},
+				} {
+					if err := tr.Mouse(m); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			wantHighlight: false,
+			wantZoom: mustNewXDetails(
+				image.Rect(0, 0, 14, 8),
+				&axes.XProperties{
+					Min:       44,
+					Max:       65,
+					ReqYWidth: 2,
+				},
+			),
+		},
+		{
+			desc: "pan doesn't start outside of the graph area",
+			xp: &axes.XProperties{
+				Min:       0,
+				Max:       100,
+				ReqYWidth: 2,
+			},
+			cvsAr:   image.Rect(0, 0, 14, 8),
+			graphAr: image.Rect(3, 0, 14, 8),
+			mutate: func(tr *Tracker) error {
+				zoom, err := newZoomedFromBase(40, 61, tr.baseX, tr.cvsAr)
+				if err != nil {
+					return err
+				}
+				tr.zoomX = zoom
+
+				for _, m := range []*terminalapi.Mouse{
+					{Position: image.Point{1, 0}, Button: mouse.ButtonRight},
+					{Position: image.Point{7, 0}, Button: mouse.ButtonRight},
+				} {
+					if err := tr.Mouse(m); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			wantHighlight: false,
+			wantZoom: mustNewXDetails(
+				image.Rect(0, 0, 14, 8),
+				&axes.XProperties{
+					Min:       40,
+					Max:       61,
+					ReqYWidth: 2,
+				},
+			),
+		},
+		{
+			desc: "doesn't pan without zoom",
+			xp: &axes.XProperties{
+				Min:       0,
+				Max:       100,
+				ReqYWidth: 2,
+			},
+			cvsAr:   image.Rect(0, 0, 14, 8),
+			graphAr: image.Rect(3, 0, 14, 8),
+			mutate: func(tr *Tracker) error {
+				for _, m := range []*terminalapi.Mouse{
+					{Position: image.Point{10, 0}, Button: mouse.ButtonRight},
+					{Position: image.Point{7, 0}, Button: mouse.ButtonRight},
+				} {
+					if err := tr.Mouse(m); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			wantHighlight: false,
+			wantZoom: mustNewXDetails(
+				image.Rect(0, 0, 14, 8),
+				&axes.XProperties{
+					Min:       0,
+					Max:       100,
+					ReqYWidth: 2,
+				},
+			),
+		},
 	}
 
 	for _, tc := range tests {
@@ -21,14 +21,18 @@ import (
 	"image"
 	"math"
 	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/mouse"
 	"github.com/mum4k/termdash/private/area"
 	"github.com/mum4k/termdash/private/canvas"
 	"github.com/mum4k/termdash/private/canvas/braille"
 	"github.com/mum4k/termdash/private/draw"
 	"github.com/mum4k/termdash/private/numbers"
+	"github.com/mum4k/termdash/private/runewidth"
 	"github.com/mum4k/termdash/terminal/terminalapi"
 	"github.com/mum4k/termdash/widgetapi"
 	"github.com/mum4k/termdash/widgets/linechart/internal/axes"
@@ -49,6 +53,25 @@ type seriesValues struct {
 	// the labels were provided. This allows resetting them to nil.
 	xLabelsSet bool
 	xLabels    map[int]string
+	// The timestamps provided on a call to Series and a bool indicating if
+	// they were provided.
+	timestampsSet bool
+	timestamps    []time.Time
+	// marker is the point marker provided on a call to Series, nil if markers
+	// shouldn't be drawn.
+	marker *pointMarker
+	// secondary indicates that the series is projected against the secondary
+	// Y axis.
+	secondary bool
+	// stepped indicates that consecutive values are connected by steps
+	// instead of direct lines.
+	stepped bool
+}
+
+// pointMarker is a marker drawn at the position of each value in a series.
+type pointMarker struct {
+	r        rune
+	cellOpts []cell.Option
 }
 
 // newSeriesValues returns a new seriesValues instance.
@@ -78,7 +101,8 @@ func newSeriesValues(values []float64) *seriesValues {
 //
 // LineChart supports mouse based zoom, zooming is achieved by either
 // highlighting an area on the graph (left mouse clicking and dragging) or by
-// using the mouse scroll button.
+// using the mouse scroll button. A zoomed view can be panned left or right by
+// dragging with the right mouse button held.
 //
 // Implements widgetapi.Widget. This object is thread-safe.
 type LineChart struct {
@@ -91,6 +115,10 @@ type LineChart struct {
 
 	// yMin are the min and max values for the Y axis.
 	yMin, yMax float64
+	// y2Min are the min and max values for the secondary Y axis.
+	y2Min, y2Max float64
+	// hasSecondary indicates if any of the series uses the secondary Y axis.
+	hasSecondary bool
 
 	// capacity is the last observed value capacity in pixels when Draw was
 	// called.
@@ -104,6 +132,23 @@ type LineChart struct {
 
 	// zoom tracks the zooming of the X axis.
 	zoom *zoom.Tracker
+
+	// lastGraphAr is the area of the graph as observed on the last call to
+	// Draw.
+	lastGraphAr image.Rectangle
+	// lastXD are the details of the X axis, including any zoom, as observed
+	// on the last call to Draw.
+	lastXD *axes.XDetails
+	// version is incremented on every change of the series, so that the
+	// cache can detect that the data changed.
+	version uint64
+	// cache holds results computed during the last call to Draw that can be
+	// reused, nil if there is nothing to reuse.
+	cache *drawCache
+	// hover is the position of the mouse cursor over the graph when the
+	// HoverTooltip or the Crosshair option is provided, nil if the mouse isn't
+	// over the graph.
+	hover *image.Point
 }
 
 // New returns a new line chart widget.
@@ -160,6 +205,69 @@ func SeriesXLabels(labels map[int]string) SeriesOption {
 	})
 }
 
+// SeriesTimestamps associates each value in the series with a timestamp.
+// The timestamps are formatted into labels under the X axis according to the
+// XAxisTimeFormat option. The values are still evenly distributed on the X
+// axis, the timestamps only determine the labels.
+// The number of timestamps must match the number of values in the series.
+// Since there is only one X axis, providing timestamps overwrites any
+// previously provided custom labels or timestamps. Cannot be combined with
+// SeriesXLabels.
+func SeriesTimestamps(timestamps []time.Time) SeriesOption {
+	return seriesOption(func(opts *seriesValues) {
+		opts.timestampsSet = true
+
+		// Copy to avoid external modifications. See #174.
+		opts.timestamps = make([]time.Time, len(timestamps))
+		copy(opts.timestamps, timestamps)
+	})
+}
+
+// SeriesPointMarker draws the provided rune at the position of each value in
+// the series in addition to the line connecting the values. The marker
+// replaces the braille pattern in the cell that contains the pixel of the
+// value.
+// The cell options are applied to the marker cells, if none are provided the
+// marker uses the cell options provided via SeriesCellOpts.
+func SeriesPointMarker(r rune, co ...cell.Option) SeriesOption {
+	return seriesOption(func(opts *seriesValues) {
+		opts.marker = &pointMarker{
+			r:        r,
+			cellOpts: co,
+		}
+	})
+}
+
+// SeriesSecondaryAxis assigns the series to a secondary Y axis drawn on the
+// right side of the graph. The secondary axis has its own scale and labels
+// determined from the values of the series assigned to it, which allows
+// comparing series with different units. The YAxisCustomScale option only
+// applies to the primary Y axis.
+func SeriesSecondaryAxis() SeriesOption {
+	return seriesOption(func(opts *seriesValues) {
+		opts.secondary = true
+	})
+}
+
+// SeriesStepped connects consecutive values of the series by steps instead of
+// direct lines, i.e. the line continues horizontally at the previous value and
+// then moves vertically to the next value. This is useful for discrete or
+// state data where a sloped line between the values would be misleading.
+func SeriesStepped() SeriesOption {
+	return seriesOption(func(opts *seriesValues) {
+		opts.stepped = true
+	})
+}
+
+// timeLabels formats the timestamps into custom labels for the X axis.
+func timeLabels(timestamps []time.Time, layout string) map[int]string {
+	labels := make(map[int]string, len(timestamps))
+	for i, ts := range timestamps {
+		labels[i] = ts.Format(layout)
+	}
+	return labels
+}
+
 // yMinMax determines the min and max values for the Y axis.
 func (lc *LineChart) yMinMax() (float64, float64) {
 	var (
@@ -167,13 +275,19 @@ func (lc *LineChart) yMinMax() (float64, float64) {
 		maximums []float64
 	)
 	for _, sv := range lc.series {
+		if sv.secondary {
+			continue
+		}
 		minimums = append(minimums, sv.min)
 		maximums = append(maximums, sv.max)
 	}
 
-	if lc.opts.yAxisCustomScale != nil {
-		minimums = append(minimums, lc.opts.yAxisCustomScale.min)
-		maximums = append(maximums, lc.opts.yAxisCustomScale.max)
+	if cs := lc.opts.yAxisCustomScale; cs != nil {
+		if lc.opts.clampToScale {
+			return cs.min, cs.max
+		}
+		minimums = append(minimums, cs.min)
+		maximums = append(maximums, cs.max)
 	}
 
 	min, _ := minMax(minimums)
@@ -182,6 +296,29 @@ func (lc *LineChart) yMinMax() (float64, float64) {
 	return min, max
 }
 
+// y2MinMax determines the min and max values for the secondary Y axis.
+// Returns false if none of the series uses the secondary Y axis.
+func (lc *LineChart) y2MinMax() (float64, float64, bool) {
+	var (
+		minimums []float64
+		maximums []float64
+	)
+	for _, sv := range lc.series {
+		if !sv.secondary {
+			continue
+		}
+		minimums = append(minimums, sv.min)
+		maximums = append(maximums, sv.max)
+	}
+	if len(minimums) == 0 {
+		return 0, 0, false
+	}
+
+	min, _ := minMax(minimums)
+	_, max := minMax(maximums)
+	return min, max, true
+}
+
 // ValueCapacity returns the number of values that could be fit onto the X axis
 // without a need to rescale the X axis. This is essentially the number of
 // available pixels on the braille canvas based on the width of the LineChart
@@ -213,6 +350,14 @@ func (lc *LineChart) Series(label string, values []float64, opts ...SeriesOption
 	for _, opt := range opts {
 		opt.set(series)
 	}
+	if series.marker != nil {
+		if got := runewidth.RuneWidth(series.marker.r); got != 1 {
+			return fmt.Errorf("invalid rune %q provided in SeriesPointMarker, must be a half-width rune, got width %d", series.marker.r, got)
+		}
+	}
+	if series.xLabelsSet && series.timestampsSet {
+		return errors.New("SeriesTimestamps cannot be combined with SeriesXLabels")
+	}
 	if series.xLabelsSet {
 		for i, t := range series.xLabels {
 			if i < 0 {
@@ -224,25 +369,35 @@ func (lc *LineChart) Series(label string, values []float64, opts ...SeriesOption
 		}
 		lc.xLabels = series.xLabels
 	}
+	if series.timestampsSet {
+		if got, want := len(series.timestamps), len(series.values); got != want {
+			return fmt.Errorf("got %d timestamps provided in SeriesTimestamps, must match the number of values %d", got, want)
+		}
+		lc.xLabels = timeLabels(series.timestamps, lc.opts.xAxisTimeFormat)
+	}
 
 	lc.series[label] = series
+	lc.version++
 	yMin, yMax := lc.yMinMax()
 	lc.yMin = yMin
 	lc.yMax = yMax
+	lc.y2Min, lc.y2Max, lc.hasSecondary = lc.y2MinMax()
 	return nil
 }
 
 // xDetails returns the details for the X axis given the specified minimum and
-// maximum value to display.
-func (lc *LineChart) xDetails(cvs *canvas.Canvas, reqYWidth, min, max int) (*axes.XDetails, error) {
+// maximum value to display. The xAr is the area available for the X axis,
+// i.e. the canvas without the secondary Y axis.
+func (lc *LineChart) xDetails(xAr image.Rectangle, reqYWidth, min, max int) (*axes.XDetails, error) {
 	xp := &axes.XProperties{
 		Min:          min,
 		Max:          max,
 		ReqYWidth:    reqYWidth,
 		CustomLabels: lc.xLabels,
 		LO:           lc.opts.xLabelOrientation,
+		LabelSkip:    lc.opts.xLabelSkip,
 	}
-	xd, err := axes.NewXDetails(cvs.Area(), xp)
+	xd, err := axes.NewXDetails(xAr, xp)
 	if err != nil {
 		return nil, fmt.Errorf("NewXDetails => %v", err)
 	}
@@ -254,7 +409,7 @@ func (lc *LineChart) xDetails(cvs *canvas.Canvas, reqYWidth, min, max int) (*axe
 // If the capacity cannot accommodate all the values, the starting value of the
 // X axis is adjusted so that it displays the last n values that fit.
 // Returns unadjusted xd if all the values fit.
-func (lc *LineChart) xDetailsForCap(cvs *canvas.Canvas, bc *braille.Canvas, xd *axes.XDetails, yd *axes.YDetails) (*axes.XDetails, error) {
+func (lc *LineChart) xDetailsForCap(xAr image.Rectangle, bc *braille.Canvas, xd *axes.XDetails, yd *axes.YDetails) (*axes.XDetails, error) {
 	lc.capacity = bc.Area().Dx()
 	values := int(xd.Scale.Max.Value) - int(xd.Scale.Min.Value) + 1
 	if !lc.opts.xAxisUnscaled || values <= lc.capacity {
@@ -264,7 +419,7 @@ func (lc *LineChart) xDetailsForCap(cvs *canvas.Canvas, bc *braille.Canvas, xd *
 	diff := values - lc.capacity
 	xMin := int(xd.Scale.Min.Value) + diff
 	xMax := int(xd.Scale.Max.Value)
-	unscaledXD, err := lc.xDetails(cvs, yd.Start.X, xMin, xMax)
+	unscaledXD, err := lc.xDetails(xAr, yd.Start.X, xMin, xMax)
 	if err != nil {
 		return nil, err
 	}
@@ -272,7 +427,9 @@ func (lc *LineChart) xDetailsForCap(cvs *canvas.Canvas, bc *braille.Canvas, xd *
 }
 
 // axesDetails determines the details about the X and Y axes.
-func (lc *LineChart) axesDetails(cvs *canvas.Canvas) (*axes.XDetails, *axes.YDetails, error) {
+// The returned details of the secondary Y axis are nil if none of the series
+// uses it.
+func (lc *LineChart) axesDetails(cvs *canvas.Canvas) (xd *axes.XDetails, yd, y2d *axes.YDetails, err error) {
 	reqXHeight := axes.RequiredHeight(lc.maxXValue(), lc.xLabels, lc.opts.xLabelOrientation)
 	yp := &axes.YProperties{
 		Min:            lc.yMin,
@@ -280,19 +437,161 @@ func (lc *LineChart) axesDetails(cvs *canvas.Canvas) (*axes.XDetails, *axes.YDet
 		ReqXHeight:     reqXHeight,
 		ScaleMode:      lc.opts.yAxisMode,
 		ValueFormatter: lc.opts.yAxisValueFormatter,
+		Ticks:          lc.opts.yTicks,
 	}
-	yd, err := axes.NewYDetails(cvs.Area(), yp)
+
+	// Leave space for the secondary Y axis on the right.
+	yAr := cvs.Area()
+	if lc.hasSecondary {
+		yAr.Max.X -= axes.RequiredWidth(lc.y2Min, lc.y2Max)
+	}
+	yd, err = axes.NewYDetails(yAr, yp)
 	if err != nil {
-		return nil, nil, fmt.Errorf("NewYDetails => %v", err)
+		return nil, nil, nil, fmt.Errorf("NewYDetails => %v", err)
+	}
+
+	if lc.hasSecondary {
+		y2p := &axes.YProperties{
+			Min:            lc.y2Min,
+			Max:            lc.y2Max,
+			ReqXHeight:     reqXHeight,
+			ScaleMode:      lc.opts.yAxisMode,
+			ValueFormatter: lc.opts.yAxisValueFormatter,
+			Ticks:          lc.opts.yTicks,
+		}
+		y2d, err = axes.NewSecondaryYDetails(cvs.Area(), yd.Width, y2p)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("NewSecondaryYDetails => %v", err)
+		}
 	}
 
 	const xMin = 0
 	xMax := lc.maxXValue()
-	xd, err := lc.xDetails(cvs, yd.Start.X, xMin, xMax)
+	xd, err = lc.xDetails(xAxisAr(cvs, y2d), yd.Start.X, xMin, xMax)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return xd, yd, y2d, nil
+}
+
+// drawCache holds results of computations performed during Draw that only
+// depend on the canvas and on the series. They are reused on subsequent calls
+// to Draw while neither the epoch of the canvas nor the series change, see
+// widgetapi.Meta.Epoch.
+type drawCache struct {
+	// epoch is the epoch of the canvas and version the version of the series
+	// the results were computed for.
+	epoch   uint64
+	version uint64
+
+	// xd, yd and y2d are the details of the axes.
+	xd      *axes.XDetails
+	yd, y2d *axes.YDetails
+
+	// lines is the braille canvas with the lines of all the series drawn on
+	// it for the X axis in linesXD, which can differ from xd due to zooming.
+	linesXD *axes.XDetails
+	lines   *braille.Canvas
+}
+
+// cachedAxesDetails is like axesDetails, but reuses the details computed
+// on the last call to Draw if the cache is still valid for the epoch.
+// Starts a new cache when the details are computed again.
+func (lc *LineChart) cachedAxesDetails(cvs *canvas.Canvas, epoch uint64) (*axes.XDetails, *axes.YDetails, *axes.YDetails, error) {
+	if c := lc.cache; c != nil && epoch != 0 && c.epoch == epoch && c.version == lc.version {
+		return c.xd, c.yd, c.y2d, nil
+	}
+
+	lc.cache = nil
+	xd, yd, y2d, err := lc.axesDetails(cvs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if epoch != 0 {
+		lc.cache = &drawCache{
+			epoch:   epoch,
+			version: lc.version,
+			xd:      xd,
+			yd:      yd,
+			y2d:     y2d,
+		}
+	}
+	return xd, yd, y2d, nil
+}
+
+// drawLines draws the lines of the named series onto the braille canvas.
+// Reuses the lines drawn on the last call to Draw if the cache is valid for
+// the X axis. The returned braille canvas must not be modified unless
+// noCache is true, in which case it is also not stored in the cache.
+func (lc *LineChart) drawLines(bc *braille.Canvas, xd *axes.XDetails, yd, y2d *axes.YDetails, names []string, noCache bool) (*braille.Canvas, error) {
+	c := lc.cache
+	if c != nil && !noCache && c.linesXD == xd && c.lines != nil {
+		return c.lines, nil
+	}
+
+	if err := lc.drawZeroLine(bc, yd); err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		sv := lc.series[name]
+		// Skip over series that don't have at least two points since we can't
+		// draw a line for just one point.
+		// Skip over series that fall under the minimum value on the X axis.
+		if got := len(sv.values); got <= 1 {
+			continue
+		}
+
+		if err := drawSeriesLines(bc, xd, seriesYDetails(sv, yd, y2d), name, lc.clamped(sv), lc.opts.connectNulls); err != nil {
+			return nil, err
+		}
+	}
+	if c != nil && !noCache {
+		c.linesXD = xd
+		c.lines = bc
+	}
+	return bc, nil
+}
+
+// drawZeroLine draws the line at the value zero of the Y axis onto the braille
+// canvas if the ZeroLine option was provided and zero is within the scale.
+func (lc *LineChart) drawZeroLine(bc *braille.Canvas, yd *axes.YDetails) error {
+	if !lc.opts.zeroLine || yd.Scale.Min.Value > 0 || yd.Scale.Max.Value < 0 {
+		return nil
+	}
+
+	y, err := yd.Scale.ValueToPixel(0)
 	if err != nil {
-		return nil, nil, err
+		return fmt.Errorf("yd.Scale.ValueToPixel(0) on scale %v => %v", yd.Scale, err)
+	}
+	cOpts := lc.opts.zeroLineOpts
+	if len(cOpts) == 0 {
+		cOpts = lc.opts.axesCellOpts
+	}
+	ar := bc.Area()
+	return draw.BrailleLine(bc,
+		image.Point{ar.Min.X, y},
+		image.Point{ar.Max.X - 1, y},
+		draw.BrailleLineCellOpts(cOpts...),
+	)
+}
+
+// xAxisAr returns the area of the canvas available for the X axis and the
+// graph, i.e. the canvas without the secondary Y axis if there is one.
+func xAxisAr(cvs *canvas.Canvas, y2d *axes.YDetails) image.Rectangle {
+	ar := cvs.Area()
+	if y2d != nil {
+		ar.Max.X = y2d.Start.X
 	}
-	return xd, yd, nil
+	return ar
+}
+
+// seriesYDetails returns the details of the Y axis the series is projected
+// against.
+func seriesYDetails(sv *seriesValues, yd, y2d *axes.YDetails) *axes.YDetails {
+	if sv.secondary && y2d != nil {
+		return y2d
+	}
+	return yd
 }
 
 // Draw draws the values as line charts.
@@ -309,24 +608,43 @@ func (lc *LineChart) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 		return draw.ResizeNeeded(cvs)
 	}
 
-	xd, yd, err := lc.axesDetails(cvs)
+	var epoch uint64
+	if meta != nil {
+		epoch = meta.Epoch
+	}
+	xd, yd, y2d, err := lc.cachedAxesDetails(cvs, epoch)
 	if err != nil {
 		return err
 	}
 
-	adjXD, err := lc.drawSeries(cvs, xd, yd)
+	adjXD, err := lc.drawSeries(cvs, xd, yd, y2d)
 	if err != nil {
 		return err
 	}
-	return lc.drawAxes(cvs, adjXD, yd)
+	lc.lastXD = adjXD
+	if err := lc.drawAxes(cvs, adjXD, yd, y2d); err != nil {
+		return err
+	}
+	if err := lc.drawCrosshair(cvs, adjXD, yd, y2d); err != nil {
+		return err
+	}
+	return lc.drawTooltip(cvs, adjXD, yd, y2d)
 }
 
 // drawAxes draws the X,Y axes and their labels.
-func (lc *LineChart) drawAxes(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.YDetails) error {
+// The y2d are the details of the secondary Y axis, nil if it isn't used.
+func (lc *LineChart) drawAxes(cvs *canvas.Canvas, xd *axes.XDetails, yd, y2d *axes.YDetails) error {
 	lines := []draw.HVLine{
 		{Start: yd.Start, End: yd.End},
 		{Start: xd.Start, End: xd.End},
 	}
+	if y2d != nil {
+		lines = append(lines,
+			draw.HVLine{Start: y2d.Start, End: y2d.End},
+			// Connect the X axis to the secondary Y axis.
+			draw.HVLine{Start: xd.End, End: image.Point{y2d.End.X, xd.End.Y}},
+		)
+	}
 	if err := draw.HVLines(cvs, lines, draw.HVLineCellOpts(lc.opts.axesCellOpts...)); err != nil {
 		return fmt.Errorf("failed to draw the axes: %v", err)
 	}
@@ -340,6 +658,17 @@ func (lc *LineChart) drawAxes(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.YD
 			return fmt.Errorf("failed to draw the Y labels: %v", err)
 		}
 	}
+	if y2d != nil {
+		for _, l := range y2d.Labels {
+			if err := draw.Text(cvs, l.Value.Text(), l.Pos,
+				draw.TextMaxX(cvs.Area().Max.X),
+				draw.TextOverrunMode(draw.OverrunModeThreeDot),
+				draw.TextCellOpts(lc.opts.yLabelCellOpts...),
+			); err != nil {
+				return fmt.Errorf("failed to draw the secondary Y labels: %v", err)
+			}
+		}
+	}
 
 	for _, l := range xd.Labels {
 		switch lc.opts.xLabelOrientation {
@@ -362,34 +691,37 @@ func (lc *LineChart) drawAxes(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.YD
 
 // graphAr returns the area available for the graph itself sized so that it
 // fits between the axes and the canvas borders.
-func (lc *LineChart) graphAr(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.YDetails) image.Rectangle {
-	return image.Rect(yd.Start.X+1, yd.Start.Y, cvs.Area().Max.X, xd.End.Y)
+func (lc *LineChart) graphAr(cvs *canvas.Canvas, xd *axes.XDetails, yd, y2d *axes.YDetails) image.Rectangle {
+	return image.Rect(yd.Start.X+1, yd.Start.Y, xAxisAr(cvs, y2d).Max.X, xd.End.Y)
 }
 
 // drawSeries draws the graph representing the stored series.
 // Returns XDetails that might be adjusted to not start at zero value if some
 // of the series didn't fit the graphs and XAxisUnscaled was provided.
-// If the series has NaN values they will be ignored and not draw on the graph.
-func (lc *LineChart) drawSeries(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.YDetails) (*axes.XDetails, error) {
-	graphAr := lc.graphAr(cvs, xd, yd)
+// If the series has NaN values they will be ignored and not draw on the graph,
+// the line is broken at them unless the ConnectNulls option was provided.
+func (lc *LineChart) drawSeries(cvs *canvas.Canvas, xd *axes.XDetails, yd, y2d *axes.YDetails) (*axes.XDetails, error) {
+	graphAr := lc.graphAr(cvs, xd, yd, y2d)
+	xAr := xAxisAr(cvs, y2d)
+	lc.lastGraphAr = graphAr
 	bc, err := braille.New(graphAr)
 	if err != nil {
 		return nil, err
 	}
 
-	xdForCap, err := lc.xDetailsForCap(cvs, bc, xd, yd)
+	xdForCap, err := lc.xDetailsForCap(xAr, bc, xd, yd)
 	if err != nil {
 		return nil, err
 	}
 
 	if lc.zoom == nil {
-		z, err := zoom.New(xdForCap, cvs.Area(), graphAr, zoom.ScrollStep(lc.opts.zoomStepPercent))
+		z, err := zoom.New(xdForCap, xAr, graphAr, zoom.ScrollStep(lc.opts.zoomStepPercent))
 		if err != nil {
 			return nil, err
 		}
 		lc.zoom = z
 	} else {
-		if err := lc.zoom.Update(xdForCap, cvs.Area(), graphAr); err != nil {
+		if err := lc.zoom.Update(xdForCap, xAr, graphAr); err != nil {
 			return nil, err
 		}
 	}
@@ -401,73 +733,353 @@ func (lc *LineChart) drawSeries(cvs *canvas.Canvas, xd *axes.XDetails, yd *axes.
 	}
 	sort.Strings(names)
 
+	highlight, hRange := lc.zoom.Highlight()
+	bc, err = lc.drawLines(bc, xdZoomed, yd, y2d, names, highlight)
+	if err != nil {
+		return nil, err
+	}
+	if highlight {
+		if err := lc.highlightRange(bc, hRange); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := bc.CopyTo(cvs); err != nil {
+		return nil, fmt.Errorf("bc.Apply => %v", err)
+	}
+
 	for _, name := range names {
 		sv := lc.series[name]
-		// Skip over series that don't have at least two points since we can't
-		// draw a line for just one point.
-		// Skip over series that fall under the minimum value on the X axis.
-		if got := len(sv.values); got <= 1 {
+		if err := lc.drawMarkers(cvs, graphAr, xdZoomed, seriesYDetails(sv, yd, y2d), name, lc.clamped(sv)); err != nil {
+			return nil, err
+		}
+		if err := lc.drawClampMarkers(cvs, graphAr, xdZoomed, seriesYDetails(sv, yd, y2d), name, sv); err != nil {
+			return nil, err
+		}
+		if err := lc.drawHighlightLast(cvs, graphAr, xdZoomed, seriesYDetails(sv, yd, y2d), name, lc.clamped(sv)); err != nil {
+			return nil, err
+		}
+	}
+	return xdZoomed, nil
+}
+
+// valuePixel returns the pixel on the braille canvas that represents the value
+// at the specified position in a series.
+func valuePixel(xd *axes.XDetails, yd *axes.YDetails, pos int, v float64) (image.Point, error) {
+	x, err := xd.Scale.ValueToPixel(pos)
+	if err != nil {
+		return image.ZP, fmt.Errorf("xd.Scale.ValueToPixel(%v) on scale %v => %v", pos, xd.Scale, err)
+	}
+	y, err := yd.Scale.ValueToPixel(v)
+	if err != nil {
+		return image.ZP, fmt.Errorf("yd.Scale.ValueToPixel(%v) on scale %v => %v", v, yd.Scale, err)
+	}
+	return image.Point{x, y}, nil
+}
+
+// drawMarkers draws the point markers of the series if requested.
+// The graphAr is the area of the braille canvas the series were drawn on.
+func (lc *LineChart) drawMarkers(cvs *canvas.Canvas, graphAr image.Rectangle, xd *axes.XDetails, yd *axes.YDetails, name string, sv *seriesValues) error {
+	if sv.marker == nil {
+		return nil
+	}
+
+	cellOpts := sv.marker.cellOpts
+	if len(cellOpts) == 0 {
+		cellOpts = sv.seriesCellOpts
+	}
+	for i, v := range sv.values {
+		if math.IsNaN(v) || i < int(xd.Scale.Min.Value) || i > int(xd.Scale.Max.Value) {
 			continue
 		}
 
-		var prev float64
-		for i := 1; i < len(sv.values); i++ {
-			v := sv.values[i]
-			prev = sv.values[i-1]
+		px, err := valuePixel(xd, yd, i, v)
+		if err != nil {
+			return fmt.Errorf("failure for series %v[%d]: %v", name, i, err)
+		}
+		cp := image.Point{px.X / braille.ColMult, px.Y / braille.RowMult}.Add(graphAr.Min)
+		if _, err := cvs.SetCell(cp, sv.marker.r, cellOpts...); err != nil {
+			return fmt.Errorf("failed to draw marker for series %v[%d]: %v", name, i, err)
+		}
+	}
+	return nil
+}
 
-			// Skip the values that are missing.
-			if math.IsNaN(v) || math.IsNaN(prev) {
-				continue
-			}
+// clamp returns the value clamped to the custom scale of the Y axis if the
+// ClampToScale option applies to the series, otherwise returns the value
+// unchanged.
+func (lc *LineChart) clamp(sv *seriesValues, v float64) float64 {
+	cs := lc.opts.yAxisCustomScale
+	if !lc.opts.clampToScale || cs == nil || sv.secondary || math.IsNaN(v) {
+		return v
+	}
+	return math.Max(cs.min, math.Min(cs.max, v))
+}
+
+// clamped returns the series with its values clamped to the custom scale of
+// the Y axis if the ClampToScale option applies to the series, otherwise
+// returns the series unchanged.
+func (lc *LineChart) clamped(sv *seriesValues) *seriesValues {
+	if !lc.opts.clampToScale || lc.opts.yAxisCustomScale == nil || sv.secondary {
+		return sv
+	}
+	c := *sv
+	c.values = make([]float64, len(sv.values))
+	for i, v := range sv.values {
+		c.values[i] = lc.clamp(sv, v)
+	}
+	return &c
+}
+
+// drawClampMarkers marks the visible values of the series that were clamped
+// to the custom scale of the Y axis due to the ClampToScale option.
+// The graphAr is the area of the braille canvas the series were drawn on.
+func (lc *LineChart) drawClampMarkers(cvs *canvas.Canvas, graphAr image.Rectangle, xd *axes.XDetails, yd *axes.YDetails, name string, sv *seriesValues) error {
+	for i, v := range sv.values {
+		if math.IsNaN(v) || i < int(xd.Scale.Min.Value) || i > int(xd.Scale.Max.Value) {
+			continue
+		}
 
-			if i < int(xdZoomed.Scale.Min.Value)+1 || i > int(xdZoomed.Scale.Max.Value) {
-				// Don't draw lines for values that aren't supposed to be visible.
-				// These are either values outside of the current zoom or
-				// values at the beginning of a series that falls before athe
-				// start of an unscaled X axis when the XAxisUnscaled option is
-				// provided.
+		var (
+			r   rune
+			row int
+		)
+		switch cv := lc.clamp(sv, v); {
+		case v > cv:
+			r, row = DefaultClampAboveRune, graphAr.Min.Y
+		case v < cv:
+			r, row = DefaultClampBelowRune, graphAr.Max.Y-1
+		default:
+			continue
+		}
+
+		x, err := xd.Scale.ValueToPixel(i)
+		if err != nil {
+			return fmt.Errorf("failure for series %v[%d], xd.Scale.ValueToPixel(%v) on scale %v => %v", name, i, i, xd.Scale, err)
+		}
+		cp := image.Point{x/braille.ColMult + graphAr.Min.X, row}
+		if _, err := cvs.SetCell(cp, r, sv.seriesCellOpts...); err != nil {
+			return fmt.Errorf("failed to draw clamp marker for series %v[%d]: %v", name, i, err)
+		}
+	}
+	return nil
+}
+
+// drawHighlightLast marks the last value of the series if the HighlightLast
+// option was provided and the value is visible.
+// The graphAr is the area of the braille canvas the series were drawn on.
+func (lc *LineChart) drawHighlightLast(cvs *canvas.Canvas, graphAr image.Rectangle, xd *axes.XDetails, yd *axes.YDetails, name string, sv *seriesValues) error {
+	hl := lc.opts.highlightLast
+	if hl == nil {
+		return nil
+	}
+
+	last := len(sv.values) - 1
+	for last >= 0 && math.IsNaN(sv.values[last]) {
+		last--
+	}
+	if last < 0 || last < int(xd.Scale.Min.Value) || last > int(xd.Scale.Max.Value) {
+		return nil
+	}
+
+	cellOpts := hl.cellOpts
+	if len(cellOpts) == 0 {
+		cellOpts = sv.seriesCellOpts
+	}
+	px, err := valuePixel(xd, yd, last, sv.values[last])
+	if err != nil {
+		return fmt.Errorf("failure for series %v[%d]: %v", name, last, err)
+	}
+	cp := image.Point{px.X / braille.ColMult, px.Y / braille.RowMult}.Add(graphAr.Min)
+	if _, err := cvs.SetCell(cp, hl.r, cellOpts...); err != nil {
+		return fmt.Errorf("failed to highlight the last value of series %v[%d]: %v", name, last, err)
+	}
+	return nil
+}
+
+// nearestPoint returns the position of the value nearest to the specified
+// cell among all the visible values in all the series, the details of the Y
+// axis the value is projected against and the cell the value is drawn in.
+// Returns false if there are no visible values.
+func (lc *LineChart) nearestPoint(cellPoint image.Point, xd *axes.XDetails, yd, y2d *axes.YDetails) (int, float64, *axes.YDetails, image.Point, bool, error) {
+	var names []string
+	for name := range lc.series {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var (
+		found   bool
+		pos     int
+		value   float64
+		valueYD *axes.YDetails
+		valueCP image.Point
+		minDist int
+	)
+	for _, name := range names {
+		sv := lc.series[name]
+		svYD := seriesYDetails(sv, yd, y2d)
+		for i, v := range sv.values {
+			if math.IsNaN(v) || i < int(xd.Scale.Min.Value) || i > int(xd.Scale.Max.Value) {
 				continue
 			}
 
-			startX, err := xdZoomed.Scale.ValueToPixel(i - 1)
+			px, err := valuePixel(xd, svYD, i, lc.clamp(sv, v))
 			if err != nil {
-				return nil, fmt.Errorf("failure for series %v[%d] on scale %v, xdZoomed.Scale.ValueToPixel(%v) => %v", name, i-1, xdZoomed.Scale, i-1, err)
+				return 0, 0, nil, image.ZP, false, fmt.Errorf("failure for series %v[%d]: %v", name, i, err)
 			}
-			endX, err := xdZoomed.Scale.ValueToPixel(i)
-			if err != nil {
-				return nil, fmt.Errorf("failure for series %v[%d] on scale %v, xdZoomed.Scale.ValueToPixel(%v) => %v", name, i, xdZoomed.Scale, i, err)
+			cp := image.Point{px.X / braille.ColMult, px.Y / braille.RowMult}.Add(lc.lastGraphAr.Min)
+			dx, dy := cp.X-cellPoint.X, cp.Y-cellPoint.Y
+			if dist := dx*dx + dy*dy; !found || dist < minDist {
+				found = true
+				pos = i
+				value = v
+				valueYD = svYD
+				valueCP = cp
+				minDist = dist
 			}
+		}
+	}
+	return pos, value, valueYD, valueCP, found, nil
+}
 
-			startY, err := yd.Scale.ValueToPixel(prev)
-			if err != nil {
-				return nil, fmt.Errorf("failure for series %v[%d] on scale %v, yd.Scale.ValueToPixel(%v) => %v", name, i-1, yd.Scale, prev, err)
-			}
+// tooltipText returns the text of the tooltip for the value at the specified
+// position in a series.
+func (lc *LineChart) tooltipText(pos int, v float64, yd *axes.YDetails) string {
+	xText := fmt.Sprintf("%d", pos)
+	if l, ok := lc.xLabels[pos]; ok {
+		xText = l
+	}
+	yv := axes.NewValue(v, yd.Scale.Min.NonZeroDecimals, axes.ValueFormatter(lc.opts.yAxisValueFormatter))
+	return fmt.Sprintf("%s: %s", xText, yv.Text())
+}
 
-			endY, err := yd.Scale.ValueToPixel(v)
-			if err != nil {
-				return nil, fmt.Errorf("failure for series %v[%d] on scale %v, yd.Scale.ValueToPixel(%v) => %v", name, i, yd.Scale, v, err)
-			}
+// drawTooltip draws the tooltip with the value nearest to the mouse cursor if
+// the HoverTooltip option was provided and the mouse hovers over the graph.
+func (lc *LineChart) drawTooltip(cvs *canvas.Canvas, xd *axes.XDetails, yd, y2d *axes.YDetails) error {
+	if !lc.opts.hoverTooltip || lc.hover == nil || !lc.hover.In(lc.lastGraphAr) {
+		return nil
+	}
 
-			if err := draw.BrailleLine(bc,
-				image.Point{startX, startY},
-				image.Point{endX, endY},
-				draw.BrailleLineCellOpts(sv.seriesCellOpts...),
-			); err != nil {
-				return nil, fmt.Errorf("draw.BrailleLine => %v", err)
+	pos, v, valueYD, _, ok, err := lc.nearestPoint(*lc.hover, xd, yd, y2d)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	text := lc.tooltipText(pos, v, valueYD)
+	// Place the tooltip on the row above the cursor, starting on the column
+	// right of it. Shift it left if it doesn't fit on the canvas.
+	start := image.Point{lc.hover.X + 1, lc.hover.Y - 1}
+	if start.Y < lc.lastGraphAr.Min.Y {
+		start.Y = lc.hover.Y
+	}
+	if overrun := start.X + runewidth.StringWidth(text) - cvs.Area().Max.X; overrun > 0 {
+		start.X -= overrun
+	}
+	if start.X < lc.lastGraphAr.Min.X {
+		start.X = lc.lastGraphAr.Min.X
+	}
+	if err := draw.Text(cvs, text, start,
+		draw.TextMaxX(cvs.Area().Max.X),
+		draw.TextOverrunMode(draw.OverrunModeThreeDot),
+		draw.TextCellOpts(lc.opts.hoverTooltipOpts...),
+	); err != nil {
+		return fmt.Errorf("failed to draw the tooltip: %v", err)
+	}
+	return nil
+}
+
+// Runes used to draw the crosshair.
+const (
+	crosshairVRune     = '│'
+	crosshairHRune     = '─'
+	crosshairCrossRune = '┼'
+)
+
+// drawCrosshair draws the crosshair at the mouse cursor and labels the value
+// nearest to it on the Y axis if the Crosshair option was provided and the
+// mouse hovers over the graph.
+func (lc *LineChart) drawCrosshair(cvs *canvas.Canvas, xd *axes.XDetails, yd, y2d *axes.YDetails) error {
+	if !lc.opts.crosshair || lc.hover == nil || !lc.hover.In(lc.lastGraphAr) {
+		return nil
+	}
+
+	graphAr := lc.lastGraphAr
+	for row := graphAr.Min.Y; row < graphAr.Max.Y; row++ {
+		r := crosshairVRune
+		if lc.opts.crosshairHorizontal && row == lc.hover.Y {
+			r = crosshairCrossRune
+		}
+		if err := lc.setCrosshairCell(cvs, image.Point{lc.hover.X, row}, r); err != nil {
+			return err
+		}
+	}
+	if lc.opts.crosshairHorizontal {
+		for col := graphAr.Min.X; col < graphAr.Max.X; col++ {
+			if col == lc.hover.X {
+				continue
+			}
+			if err := lc.setCrosshairCell(cvs, image.Point{col, lc.hover.Y}, crosshairHRune); err != nil {
+				return err
 			}
 		}
 	}
 
-	if highlight, hRange := lc.zoom.Highlight(); highlight {
-		if err := lc.highlightRange(bc, hRange); err != nil {
-			return nil, err
+	_, v, valueYD, cp, ok, err := lc.nearestPoint(*lc.hover, xd, yd, y2d)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	// The label covers the labels area of the Y axis on the row of the value,
+	// aligned towards the axis.
+	yv := axes.NewValue(v, valueYD.Scale.Min.NonZeroDecimals, axes.ValueFormatter(lc.opts.yAxisValueFormatter))
+	text := yv.Text()
+	labelAr := image.Rect(cvs.Area().Min.X, cp.Y, valueYD.Start.X, cp.Y+1)
+	if valueYD == y2d {
+		labelAr = image.Rect(valueYD.Start.X+1, cp.Y, cvs.Area().Max.X, cp.Y+1)
+	}
+	if labelAr.Dx() <= 0 {
+		return nil
+	}
+	if pad := labelAr.Dx() - runewidth.StringWidth(text); pad > 0 {
+		if valueYD == y2d {
+			text += strings.Repeat(" ", pad)
+		} else {
+			text = strings.Repeat(" ", pad) + text
 		}
 	}
+	if err := draw.Text(cvs, text, labelAr.Min,
+		draw.TextMaxX(labelAr.Max.X),
+		draw.TextOverrunMode(draw.OverrunModeThreeDot),
+		draw.TextCellOpts(lc.opts.crosshairLabelOpts...),
+	); err != nil {
+		return fmt.Errorf("failed to draw the crosshair label: %v", err)
+	}
+	return nil
+}
 
-	if err := bc.CopyTo(cvs); err != nil {
-		return nil, fmt.Errorf("bc.Apply => %v", err)
+// setCrosshairCell draws the rune of the crosshair in the cell unless the
+// cell already contains a part of the series.
+func (lc *LineChart) setCrosshairCell(cvs *canvas.Canvas, p image.Point, r rune) error {
+	c, err := cvs.Cell(p)
+	if err != nil {
+		return err
 	}
-	return xdZoomed, nil
+	// The braille canvas leaves the blank braille pattern in cells whose
+	// pixels were all cleared.
+	if c.Rune != 0 && c.Rune != ' ' && c.Rune != '\u2800' {
+		return nil
+	}
+	if _, err := cvs.SetCell(p, r, lc.opts.crosshairOpts...); err != nil {
+		return fmt.Errorf("failed to draw the crosshair: %v", err)
+	}
+	return nil
 }
 
 // highlightRange highlights the range of X columns on the braille canvas.
@@ -487,6 +1099,15 @@ func (lc *LineChart) Mouse(m *terminalapi.Mouse, meta *widgetapi.EventMeta) erro
 	lc.mu.Lock()
 	defer lc.mu.Unlock()
 
+	if lc.opts.hoverTooltip || lc.opts.crosshair {
+		if m.Button == mouse.ButtonRelease && m.Position.In(lc.lastGraphAr) {
+			p := m.Position
+			lc.hover = &p
+		} else {
+			lc.hover = nil
+		}
+	}
+
 	if lc.zoom == nil {
 		return nil
 	}
@@ -498,7 +1119,11 @@ func (lc *LineChart) minSize() image.Point {
 	// At the very least we need:
 	// - n cells width for the Y axis and its labels as reported by it.
 	// - at least 1 cell width for the graph.
+	// - n cells width for the secondary Y axis and its labels if used.
 	reqWidth := axes.RequiredWidth(lc.yMin, lc.yMax) + 1
+	if lc.hasSecondary {
+		reqWidth += axes.RequiredWidth(lc.y2Min, lc.y2Max)
+	}
 
 	// And for the height:
 	// - n cells width for the X axis and its labels as reported by it.
@@ -513,8 +1138,9 @@ func (lc *LineChart) Options() widgetapi.Options {
 	defer lc.mu.RUnlock()
 
 	return widgetapi.Options{
-		MinimumSize: lc.minSize(),
-		WantMouse:   widgetapi.MouseScopeGlobal,
+		MinimumSize:   lc.minSize(),
+		WantMouse:     widgetapi.MouseScopeGlobal,
+		WantMouseMove: lc.opts.hoverTooltip || lc.opts.crosshair,
 	}
 }
 
@@ -19,6 +19,7 @@ import (
 	"image"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/mum4k/termdash/cell"
@@ -31,6 +32,7 @@ import (
 	"github.com/mum4k/termdash/private/faketerm"
 	"github.com/mum4k/termdash/terminal/terminalapi"
 	"github.com/mum4k/termdash/widgetapi"
+	"github.com/mum4k/termdash/widgets/linechart/internal/axes"
 )
 
 func TestLineChartDraws(t *testing.T) {
@@ -118,6 +120,37 @@ func TestLineChartDraws(t *testing.T) {
 			},
 			wantWriteErr: true,
 		},
+		{
+			desc: "fails on empty XAxisTimeFormat",
+			opts: []Option{
+				XAxisTimeFormat(""),
+			},
+			canvas:  image.Rect(0, 0, 3, 4),
+			wantErr: true,
+		},
+		{
+			desc:   "series fails when timestamps don't match the values",
+			canvas: image.Rect(0, 0, 3, 4),
+			writes: func(lc *LineChart) error {
+				return lc.Series("series", []float64{0, 1}, SeriesTimestamps([]time.Time{
+					time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+				}))
+			},
+			wantWriteErr: true,
+		},
+		{
+			desc:   "series fails when timestamps are combined with custom labels",
+			canvas: image.Rect(0, 0, 3, 4),
+			writes: func(lc *LineChart) error {
+				return lc.Series("series", []float64{0},
+					SeriesTimestamps([]time.Time{
+						time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+					}),
+					SeriesXLabels(map[int]string{0: "text"}),
+				)
+			},
+			wantWriteErr: true,
+		},
 		{
 			desc:   "draws resize needed character when canvas is smaller than requested",
 			canvas: image.Rect(0, 0, 1, 1),
@@ -277,36 +310,35 @@ func TestLineChartDraws(t *testing.T) {
 			},
 		},
 		{
-			desc: "custom Y scale, zero based positive, values fit",
-			opts: []Option{
-				YAxisCustomScale(0, 200),
-			},
+			desc:   "stepped series draws a step instead of a direct line",
 			canvas: image.Rect(0, 0, 20, 10),
 			writes: func(lc *LineChart) error {
-				return lc.Series("first", []float64{0, 100})
+				return lc.Series("first", []float64{0, 100}, SeriesStepped())
 			},
-			wantCapacity: 26,
+			wantCapacity: 28,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{6, 0}, End: image.Point{6, 8}},
-					{Start: image.Point{6, 8}, End: image.Point{19, 8}},
+					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
+					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
 				}
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "0", image.Point{5, 7})
-				testdraw.MustText(c, "103.36", image.Point{0, 3})
-				testdraw.MustText(c, "0", image.Point{7, 9})
+				testdraw.MustText(c, "0", image.Point{4, 7})
+				testdraw.MustText(c, "51.68", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{6, 9})
 				testdraw.MustText(c, "1", image.Point{19, 9})
 
-				// Braille line.
-				graphAr := image.Rect(7, 0, 20, 8)
+				// Braille step, the linear rendering is a single line from
+				// {0, 31} to {26, 0}.
+				graphAr := image.Rect(6, 0, 20, 8)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{25, 16})
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{26, 31})
+				testdraw.MustBrailleLine(bc, image.Point{26, 31}, image.Point{26, 0})
 				testbraille.MustCopyTo(bc, c)
 
 				testcanvas.MustApply(c, ft)
@@ -314,13 +346,13 @@ func TestLineChartDraws(t *testing.T) {
 			},
 		},
 		{
-			desc: "custom Y scale, zero based negative, values fit",
+			desc: "stepped series with negative values",
 			opts: []Option{
 				YAxisCustomScale(-200, 0),
 			},
 			canvas: image.Rect(0, 0, 20, 10),
 			writes: func(lc *LineChart) error {
-				return lc.Series("first", []float64{0, -200})
+				return lc.Series("first", []float64{0, -200}, SeriesStepped())
 			},
 			wantCapacity: 26,
 			want: func(size image.Point) *faketerm.Terminal {
@@ -340,10 +372,12 @@ func TestLineChartDraws(t *testing.T) {
 				testdraw.MustText(c, "0", image.Point{7, 9})
 				testdraw.MustText(c, "1", image.Point{19, 9})
 
-				// Braille line.
+				// Braille step, the linear rendering is a single line from
+				// {0, 0} to {25, 31}.
 				graphAr := image.Rect(7, 0, 20, 8)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 0}, image.Point{25, 31})
+				testdraw.MustBrailleLine(bc, image.Point{0, 0}, image.Point{25, 0})
+				testdraw.MustBrailleLine(bc, image.Point{25, 0}, image.Point{25, 31})
 				testbraille.MustCopyTo(bc, c)
 
 				testcanvas.MustApply(c, ft)
@@ -351,36 +385,39 @@ func TestLineChartDraws(t *testing.T) {
 			},
 		},
 		{
-			desc: "custom Y scale, negative and positive, values fit",
-			opts: []Option{
-				YAxisCustomScale(-200, 200),
-			},
-			canvas: image.Rect(0, 0, 20, 10),
+			desc:   "stepped series with multiple values",
+			canvas: image.Rect(0, 0, 20, 11),
 			writes: func(lc *LineChart) error {
-				return lc.Series("first", []float64{0, 100})
+				return lc.Series("first", []float64{0, 50, 100}, SeriesStepped())
 			},
-			wantCapacity: 30,
+			wantCapacity: 28,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{4, 0}, End: image.Point{4, 8}},
-					{Start: image.Point{4, 8}, End: image.Point{19, 8}},
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
 				}
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "-200", image.Point{0, 7})
-				testdraw.MustText(c, "6.57", image.Point{0, 3})
-				testdraw.MustText(c, "0", image.Point{5, 9})
-				testdraw.MustText(c, "1", image.Point{19, 9})
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{6, 10})
+				testdraw.MustText(c, "1", image.Point{12, 10})
+				testdraw.MustText(c, "2", image.Point{19, 10})
 
-				// Braille line.
-				graphAr := image.Rect(5, 0, 20, 8)
+				// Braille steps, the linear rendering are lines from {0, 35}
+				// to {13, 18} and from {13, 18} to {27, 0}.
+				graphAr := image.Rect(6, 0, 20, 9)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 16}, image.Point{29, 8})
+				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 35})
+				testdraw.MustBrailleLine(bc, image.Point{13, 35}, image.Point{13, 18})
+				testdraw.MustBrailleLine(bc, image.Point{13, 18}, image.Point{27, 18})
+				testdraw.MustBrailleLine(bc, image.Point{27, 18}, image.Point{27, 0})
 				testbraille.MustCopyTo(bc, c)
 
 				testcanvas.MustApply(c, ft)
@@ -388,36 +425,55 @@ func TestLineChartDraws(t *testing.T) {
 			},
 		},
 		{
-			desc: "custom Y scale, negative only, values fit",
-			opts: []Option{
-				YAxisCustomScale(-200, -100),
-			},
+			desc:   "stepped series in a zoomed view",
 			canvas: image.Rect(0, 0, 20, 10),
 			writes: func(lc *LineChart) error {
-				return lc.Series("first", []float64{-200, -100})
+				if err := lc.Series("first", []float64{0, 100, 0, 100, 0}, SeriesStepped()); err != nil {
+					return err
+				}
+				// Draw once so zoom tracker is initialized.
+				cvs := testcanvas.MustNew(image.Rect(0, 0, 20, 10))
+				if err := lc.Draw(cvs, &widgetapi.Meta{}); err != nil {
+					return err
+				}
+				// Zoom to the first three values.
+				for _, m := range []*terminalapi.Mouse{
+					{Position: image.Point{6, 5}, Button: mouse.ButtonLeft},
+					{Position: image.Point{13, 5}, Button: mouse.ButtonLeft},
+					{Position: image.Point{13, 5}, Button: mouse.ButtonRelease},
+				} {
+					if err := lc.Mouse(m, &widgetapi.EventMeta{}); err != nil {
+						return err
+					}
+				}
+				return nil
 			},
-			wantCapacity: 24,
+			wantCapacity: 28,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{7, 0}, End: image.Point{7, 8}},
-					{Start: image.Point{7, 8}, End: image.Point{19, 8}},
+					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
+					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
 				}
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "-200", image.Point{3, 7})
-				testdraw.MustText(c, "-148.32", image.Point{0, 3})
-				testdraw.MustText(c, "0", image.Point{8, 9})
-				testdraw.MustText(c, "1", image.Point{19, 9})
+				testdraw.MustText(c, "0", image.Point{4, 7})
+				testdraw.MustText(c, "51.68", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{6, 9})
+				testdraw.MustText(c, "1", image.Point{12, 9})
+				testdraw.MustText(c, "2", image.Point{19, 9})
 
-				// Braille line.
-				graphAr := image.Rect(8, 0, 20, 8)
+				// Braille steps.
+				graphAr := image.Rect(6, 0, 20, 8)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{23, 0})
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{13, 31})
+				testdraw.MustBrailleLine(bc, image.Point{13, 31}, image.Point{13, 0})
+				testdraw.MustBrailleLine(bc, image.Point{13, 0}, image.Point{27, 0})
+				testdraw.MustBrailleLine(bc, image.Point{27, 0}, image.Point{27, 31})
 				testbraille.MustCopyTo(bc, c)
 
 				testcanvas.MustApply(c, ft)
@@ -425,36 +481,38 @@ func TestLineChartDraws(t *testing.T) {
 			},
 		},
 		{
-			desc: "custom Y scale, negative and positive, values don't fit so adjusted",
+			desc: "places the requested number of Y labels on a tall canvas",
 			opts: []Option{
-				YAxisCustomScale(-200, 200),
+				YAxisCustomScale(0, 200),
+				YTicks(3),
 			},
-			canvas: image.Rect(0, 0, 20, 10),
+			canvas: image.Rect(0, 0, 20, 14),
 			writes: func(lc *LineChart) error {
-				return lc.Series("first", []float64{-400, 400})
+				return lc.Series("first", []float64{0, 100})
 			},
-			wantCapacity: 28,
+			wantCapacity: 26,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
-					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+					{Start: image.Point{6, 0}, End: image.Point{6, 12}},
+					{Start: image.Point{6, 12}, End: image.Point{19, 12}},
 				}
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "-400", image.Point{1, 7})
-				testdraw.MustText(c, "12.96", image.Point{0, 3})
-				testdraw.MustText(c, "0", image.Point{6, 9})
-				testdraw.MustText(c, "1", image.Point{19, 9})
+				testdraw.MustText(c, "0", image.Point{5, 11})
+				testdraw.MustText(c, "102.24", image.Point{0, 5})
+				testdraw.MustText(c, "187.44", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{7, 13})
+				testdraw.MustText(c, "1", image.Point{19, 13})
 
 				// Braille line.
-				graphAr := image.Rect(6, 0, 20, 8)
+				graphAr := image.Rect(7, 0, 20, 12)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{26, 0})
+				testdraw.MustBrailleLine(bc, image.Point{0, 47}, image.Point{25, 24})
 				testbraille.MustCopyTo(bc, c)
 
 				testcanvas.MustApply(c, ft)
@@ -462,33 +520,51 @@ func TestLineChartDraws(t *testing.T) {
 			},
 		},
 		{
-			desc:   "draws anchored Y axis",
+			desc:   "fails with a single Y tick",
+			canvas: image.Rect(0, 0, 3, 4),
+			opts: []Option{
+				YTicks(1),
+			},
+			wantErr: true,
+		},
+		{
+			desc: "XLabelSkip places X labels on every nth value",
+			opts: []Option{
+				XLabelSkip(2),
+			},
 			canvas: image.Rect(0, 0, 20, 10),
 			writes: func(lc *LineChart) error {
-				return lc.Series("first", []float64{1600, 1900})
+				return lc.Series("first", []float64{0, 1, 2, 3, 4, 5, 6, 7, 8})
 			},
-			wantCapacity: 26,
+			wantCapacity: 30,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{6, 0}, End: image.Point{6, 8}},
-					{Start: image.Point{6, 8}, End: image.Point{19, 8}},
+					{Start: image.Point{4, 0}, End: image.Point{4, 8}},
+					{Start: image.Point{4, 8}, End: image.Point{19, 8}},
 				}
 				testdraw.MustHVLines(c, lines)
 
-				// Value labels.
-				testdraw.MustText(c, "0", image.Point{5, 7})
-				testdraw.MustText(c, "980.80", image.Point{0, 3})
-				testdraw.MustText(c, "0", image.Point{7, 9})
-				testdraw.MustText(c, "1", image.Point{19, 9})
+				// Value labels, the label for the value two doesn't fit.
+				testdraw.MustText(c, "0", image.Point{3, 7})
+				testdraw.MustText(c, "4.16", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{5, 9})
+				testdraw.MustText(c, "4", image.Point{12, 9})
+				testdraw.MustText(c, "8", image.Point{19, 9})
 
 				// Braille line.
-				graphAr := image.Rect(7, 0, 20, 8)
+				graphAr := image.Rect(5, 0, 20, 8)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 5}, image.Point{25, 0})
+				points := []image.Point{
+					{0, 31}, {4, 27}, {7, 23}, {11, 19}, {14, 16},
+					{18, 12}, {21, 8}, {25, 4}, {29, 0},
+				}
+				for i := 1; i < len(points); i++ {
+					testdraw.MustBrailleLine(bc, points[i-1], points[i])
+				}
 				testbraille.MustCopyTo(bc, c)
 
 				testcanvas.MustApply(c, ft)
@@ -496,36 +572,44 @@ func TestLineChartDraws(t *testing.T) {
 			},
 		},
 		{
-			desc: "draws adaptive Y axis",
+			desc:   "fails with a negative XLabelSkip",
+			canvas: image.Rect(0, 0, 3, 4),
 			opts: []Option{
-				YAxisAdaptive(),
+				XLabelSkip(-1),
+			},
+			wantErr: true,
+		},
+		{
+			desc: "custom Y scale, zero based positive, values fit",
+			opts: []Option{
+				YAxisCustomScale(0, 200),
 			},
 			canvas: image.Rect(0, 0, 20, 10),
 			writes: func(lc *LineChart) error {
-				return lc.Series("first", []float64{1600, 1900})
+				return lc.Series("first", []float64{0, 100})
 			},
-			wantCapacity: 24,
+			wantCapacity: 26,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{7, 0}, End: image.Point{7, 8}},
-					{Start: image.Point{7, 8}, End: image.Point{19, 8}},
+					{Start: image.Point{6, 0}, End: image.Point{6, 8}},
+					{Start: image.Point{6, 8}, End: image.Point{19, 8}},
 				}
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "1600", image.Point{3, 7})
-				testdraw.MustText(c, "1754.88", image.Point{0, 3})
-				testdraw.MustText(c, "0", image.Point{8, 9})
+				testdraw.MustText(c, "0", image.Point{5, 7})
+				testdraw.MustText(c, "103.36", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{7, 9})
 				testdraw.MustText(c, "1", image.Point{19, 9})
 
 				// Braille line.
-				graphAr := image.Rect(8, 0, 20, 8)
+				graphAr := image.Rect(7, 0, 20, 8)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{23, 0})
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{25, 16})
 				testbraille.MustCopyTo(bc, c)
 
 				testcanvas.MustApply(c, ft)
@@ -533,91 +617,97 @@ func TestLineChartDraws(t *testing.T) {
 			},
 		},
 		{
-			desc:   "custom X labels, horizontal by default",
+			desc: "ClampToScale keeps the custom Y scale and marks values above it",
+			opts: []Option{
+				YAxisCustomScale(0, 200),
+				ClampToScale(),
+			},
 			canvas: image.Rect(0, 0, 20, 10),
 			writes: func(lc *LineChart) error {
-				return lc.Series("first", []float64{0, 100}, SeriesXLabels(map[int]string{
-					0: "start",
-					1: "end",
-				}))
+				return lc.Series("first", []float64{0, 300})
 			},
-			wantCapacity: 28,
+			wantCapacity: 26,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
-					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+					{Start: image.Point{6, 0}, End: image.Point{6, 8}},
+					{Start: image.Point{6, 8}, End: image.Point{19, 8}},
 				}
 				testdraw.MustHVLines(c, lines)
 
-				// Value labels.
-				testdraw.MustText(c, "0", image.Point{4, 7})
-				testdraw.MustText(c, "51.68", image.Point{0, 3})
-				testdraw.MustText(c, "start", image.Point{6, 9})
+				// Value labels, same as when the values fit.
+				testdraw.MustText(c, "0", image.Point{5, 7})
+				testdraw.MustText(c, "103.36", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{7, 9})
+				testdraw.MustText(c, "1", image.Point{19, 9})
 
-				// Braille line.
-				graphAr := image.Rect(6, 0, 20, 8)
+				// Braille line, the value 300 is clamped to 200.
+				graphAr := image.Rect(7, 0, 20, 8)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{26, 0})
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{25, 0})
 				testbraille.MustCopyTo(bc, c)
 
+				// Marker of the clamped value.
+				testcanvas.MustSetCell(c, image.Point{19, 0}, DefaultClampAboveRune)
+
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
 		},
 		{
-			desc: "custom X labels, horizontal with option",
+			desc: "ClampToScale marks values below the custom Y scale with the series cell options",
 			opts: []Option{
-				XLabelsHorizontal(),
+				YAxisCustomScale(0, 200),
+				ClampToScale(),
 			},
 			canvas: image.Rect(0, 0, 20, 10),
 			writes: func(lc *LineChart) error {
-				return lc.Series("first", []float64{0, 100}, SeriesXLabels(map[int]string{
-					0: "start",
-					1: "end",
-				}))
+				return lc.Series("first", []float64{-50, 100},
+					SeriesCellOpts(cell.FgColor(cell.ColorRed)),
+				)
 			},
-			wantCapacity: 28,
+			wantCapacity: 26,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
-					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+					{Start: image.Point{6, 0}, End: image.Point{6, 8}},
+					{Start: image.Point{6, 8}, End: image.Point{19, 8}},
 				}
 				testdraw.MustHVLines(c, lines)
 
-				// Value labels.
-				testdraw.MustText(c, "0", image.Point{4, 7})
-				testdraw.MustText(c, "51.68", image.Point{0, 3})
-				testdraw.MustText(c, "start", image.Point{6, 9})
+				// Value labels, same as when the values fit.
+				testdraw.MustText(c, "0", image.Point{5, 7})
+				testdraw.MustText(c, "103.36", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{7, 9})
+				testdraw.MustText(c, "1", image.Point{19, 9})
 
-				// Braille line.
-				graphAr := image.Rect(6, 0, 20, 8)
+				// Braille line, the value -50 is clamped to 0.
+				graphAr := image.Rect(7, 0, 20, 8)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{26, 0})
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{25, 16}, draw.BrailleLineCellOpts(cell.FgColor(cell.ColorRed)))
 				testbraille.MustCopyTo(bc, c)
 
+				// Marker of the clamped value.
+				testcanvas.MustSetCell(c, image.Point{7, 7}, DefaultClampBelowRune, cell.FgColor(cell.ColorRed))
+
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
 		},
 		{
-			desc: "custom X labels, vertical",
+			desc: "custom Y scale, zero based negative, values fit",
 			opts: []Option{
-				XLabelsVertical(),
+				YAxisCustomScale(-200, 0),
 			},
 			canvas: image.Rect(0, 0, 20, 10),
 			writes: func(lc *LineChart) error {
-				return lc.Series("first", []float64{0, 100}, SeriesXLabels(map[int]string{
-					0: "start",
-					1: "end",
-				}))
+				return lc.Series("first", []float64{0, -200})
 			},
 			wantCapacity: 26,
 			want: func(size image.Point) *faketerm.Terminal {
@@ -626,21 +716,21 @@ func TestLineChartDraws(t *testing.T) {
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{6, 0}, End: image.Point{6, 4}},
-					{Start: image.Point{6, 4}, End: image.Point{19, 4}},
+					{Start: image.Point{6, 0}, End: image.Point{6, 8}},
+					{Start: image.Point{6, 8}, End: image.Point{19, 8}},
 				}
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "0", image.Point{5, 3})
-				testdraw.MustText(c, "80.040", image.Point{0, 0})
-				testdraw.MustVerticalText(c, "start", image.Point{7, 5})
-				testdraw.MustVerticalText(c, "end", image.Point{19, 5})
+				testdraw.MustText(c, "-200", image.Point{2, 7})
+				testdraw.MustText(c, "-96.64", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{7, 9})
+				testdraw.MustText(c, "1", image.Point{19, 9})
 
 				// Braille line.
-				graphAr := image.Rect(7, 0, 20, 4)
+				graphAr := image.Rect(7, 0, 20, 8)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 15}, image.Point{25, 0})
+				testdraw.MustBrailleLine(bc, image.Point{0, 0}, image.Point{25, 31})
 				testbraille.MustCopyTo(bc, c)
 
 				testcanvas.MustApply(c, ft)
@@ -648,33 +738,36 @@ func TestLineChartDraws(t *testing.T) {
 			},
 		},
 		{
-			desc:   "sets series cell options",
+			desc: "custom Y scale, negative and positive, values fit",
+			opts: []Option{
+				YAxisCustomScale(-200, 200),
+			},
 			canvas: image.Rect(0, 0, 20, 10),
 			writes: func(lc *LineChart) error {
-				return lc.Series("first", []float64{0, 100}, SeriesCellOpts(cell.BgColor(cell.ColorRed), cell.FgColor(cell.ColorGreen)))
+				return lc.Series("first", []float64{0, 100})
 			},
-			wantCapacity: 28,
+			wantCapacity: 30,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
-					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+					{Start: image.Point{4, 0}, End: image.Point{4, 8}},
+					{Start: image.Point{4, 8}, End: image.Point{19, 8}},
 				}
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "0", image.Point{4, 7})
-				testdraw.MustText(c, "51.68", image.Point{0, 3})
-				testdraw.MustText(c, "0", image.Point{6, 9})
+				testdraw.MustText(c, "-200", image.Point{0, 7})
+				testdraw.MustText(c, "6.57", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{5, 9})
 				testdraw.MustText(c, "1", image.Point{19, 9})
 
 				// Braille line.
-				graphAr := image.Rect(6, 0, 20, 8)
+				graphAr := image.Rect(5, 0, 20, 8)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{26, 0}, draw.BrailleLineCellOpts(cell.BgColor(cell.ColorRed), cell.FgColor(cell.ColorGreen)))
+				testdraw.MustBrailleLine(bc, image.Point{0, 16}, image.Point{29, 8})
 				testbraille.MustCopyTo(bc, c)
 
 				testcanvas.MustApply(c, ft)
@@ -682,36 +775,36 @@ func TestLineChartDraws(t *testing.T) {
 			},
 		},
 		{
-			desc:   "multiple Y and X labels",
-			canvas: image.Rect(0, 0, 20, 11),
+			desc: "custom Y scale, negative only, values fit",
+			opts: []Option{
+				YAxisCustomScale(-200, -100),
+			},
+			canvas: image.Rect(0, 0, 20, 10),
 			writes: func(lc *LineChart) error {
-				return lc.Series("first", []float64{0, 50, 100})
+				return lc.Series("first", []float64{-200, -100})
 			},
-			wantCapacity: 28,
+			wantCapacity: 24,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
-					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
+					{Start: image.Point{7, 0}, End: image.Point{7, 8}},
+					{Start: image.Point{7, 8}, End: image.Point{19, 8}},
 				}
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "0", image.Point{4, 8})
-				testdraw.MustText(c, "45.76", image.Point{0, 4})
-				testdraw.MustText(c, "91.52", image.Point{0, 0})
-				testdraw.MustText(c, "0", image.Point{6, 10})
-				testdraw.MustText(c, "1", image.Point{12, 10})
-				testdraw.MustText(c, "2", image.Point{19, 10})
+				testdraw.MustText(c, "-200", image.Point{3, 7})
+				testdraw.MustText(c, "-148.32", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{8, 9})
+				testdraw.MustText(c, "1", image.Point{19, 9})
 
 				// Braille line.
-				graphAr := image.Rect(6, 0, 20, 9)
+				graphAr := image.Rect(8, 0, 20, 8)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 18})
-				testdraw.MustBrailleLine(bc, image.Point{13, 18}, image.Point{27, 0})
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{23, 0})
 				testbraille.MustCopyTo(bc, c)
 
 				testcanvas.MustApply(c, ft)
@@ -719,32 +812,38 @@ func TestLineChartDraws(t *testing.T) {
 			},
 		},
 		{
-			desc:   "Y labels are trimmed",
-			canvas: image.Rect(0, 0, 5, 4),
+			desc: "draws the zero line when the values span negative and positive",
+			opts: []Option{
+				YAxisCustomScale(-200, 200),
+				ZeroLine(cell.FgColor(cell.ColorRed)),
+			},
+			canvas: image.Rect(0, 0, 20, 10),
 			writes: func(lc *LineChart) error {
-				return lc.Series("first", []float64{0, 100})
+				return lc.Series("first", []float64{-100, 100})
 			},
-			wantCapacity: 2,
+			wantCapacity: 30,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{3, 0}, End: image.Point{3, 2}},
-					{Start: image.Point{3, 2}, End: image.Point{4, 2}},
+					{Start: image.Point{4, 0}, End: image.Point{4, 8}},
+					{Start: image.Point{4, 8}, End: image.Point{19, 8}},
 				}
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "0", image.Point{2, 1})
-				testdraw.MustText(c, "57…", image.Point{0, 0})
-				testdraw.MustText(c, "0", image.Point{4, 3})
+				testdraw.MustText(c, "-200", image.Point{0, 7})
+				testdraw.MustText(c, "6.57", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{5, 9})
+				testdraw.MustText(c, "1", image.Point{19, 9})
 
-				// Braille line.
-				graphAr := image.Rect(4, 0, 5, 2)
+				// Zero line under the braille line.
+				graphAr := image.Rect(5, 0, 20, 8)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 7}, image.Point{1, 0})
+				testdraw.MustBrailleLine(bc, image.Point{0, 16}, image.Point{29, 16}, draw.BrailleLineCellOpts(cell.FgColor(cell.ColorRed)))
+				testdraw.MustBrailleLine(bc, image.Point{0, 23}, image.Point{29, 8})
 				testbraille.MustCopyTo(bc, c)
 
 				testcanvas.MustApply(c, ft)
@@ -752,38 +851,39 @@ func TestLineChartDraws(t *testing.T) {
 			},
 		},
 		{
-			desc:   "draw multiple series",
+			desc: "zero line defaults to the cell options of the axes",
+			opts: []Option{
+				YAxisCustomScale(-200, 200),
+				AxesCellOpts(cell.FgColor(cell.ColorGreen)),
+				ZeroLine(),
+			},
 			canvas: image.Rect(0, 0, 20, 10),
 			writes: func(lc *LineChart) error {
-				if err := lc.Series("first", []float64{0, 50, 100}); err != nil {
-					return err
-				}
-				return lc.Series("second", []float64{100, 0})
+				return lc.Series("first", []float64{-100, 100})
 			},
-			wantCapacity: 28,
+			wantCapacity: 30,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
-					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+					{Start: image.Point{4, 0}, End: image.Point{4, 8}},
+					{Start: image.Point{4, 8}, End: image.Point{19, 8}},
 				}
-				testdraw.MustHVLines(c, lines)
+				testdraw.MustHVLines(c, lines, draw.HVLineCellOpts(cell.FgColor(cell.ColorGreen)))
 
 				// Value labels.
-				testdraw.MustText(c, "0", image.Point{4, 7})
-				testdraw.MustText(c, "51.68", image.Point{0, 3})
-				testdraw.MustText(c, "0", image.Point{6, 9})
-				testdraw.MustText(c, "1", image.Point{12, 9})
-				testdraw.MustText(c, "2", image.Point{19, 9})
+				testdraw.MustText(c, "-200", image.Point{0, 7})
+				testdraw.MustText(c, "6.57", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{5, 9})
+				testdraw.MustText(c, "1", image.Point{19, 9})
 
-				// Braille line.
-				graphAr := image.Rect(6, 0, 20, 8)
+				// Zero line under the braille line.
+				graphAr := image.Rect(5, 0, 20, 8)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{27, 0})
-				testdraw.MustBrailleLine(bc, image.Point{0, 0}, image.Point{13, 31})
+				testdraw.MustBrailleLine(bc, image.Point{0, 16}, image.Point{29, 16}, draw.BrailleLineCellOpts(cell.FgColor(cell.ColorGreen)))
+				testdraw.MustBrailleLine(bc, image.Point{0, 23}, image.Point{29, 8})
 				testbraille.MustCopyTo(bc, c)
 
 				testcanvas.MustApply(c, ft)
@@ -791,38 +891,37 @@ func TestLineChartDraws(t *testing.T) {
 			},
 		},
 		{
-			desc:   "draw multiple series with different cell options, last series wins where they cross",
+			desc: "doesn't draw the zero line when zero is outside of the scale",
+			opts: []Option{
+				YAxisCustomScale(-200, -100),
+				ZeroLine(cell.FgColor(cell.ColorRed)),
+			},
 			canvas: image.Rect(0, 0, 20, 10),
 			writes: func(lc *LineChart) error {
-				if err := lc.Series("first", []float64{0, 50, 100}, SeriesCellOpts(cell.FgColor(cell.ColorRed))); err != nil {
-					return err
-				}
-				return lc.Series("second", []float64{100, 0}, SeriesCellOpts(cell.FgColor(cell.ColorBlue)))
+				return lc.Series("first", []float64{-200, -100})
 			},
-			wantCapacity: 28,
+			wantCapacity: 24,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
-					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+					{Start: image.Point{7, 0}, End: image.Point{7, 8}},
+					{Start: image.Point{7, 8}, End: image.Point{19, 8}},
 				}
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "0", image.Point{4, 7})
-				testdraw.MustText(c, "51.68", image.Point{0, 3})
-				testdraw.MustText(c, "0", image.Point{6, 9})
-				testdraw.MustText(c, "1", image.Point{12, 9})
-				testdraw.MustText(c, "2", image.Point{19, 9})
+				testdraw.MustText(c, "-200", image.Point{3, 7})
+				testdraw.MustText(c, "-148.32", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{8, 9})
+				testdraw.MustText(c, "1", image.Point{19, 9})
 
 				// Braille line.
-				graphAr := image.Rect(6, 0, 20, 8)
+				graphAr := image.Rect(8, 0, 20, 8)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{27, 0}, draw.BrailleLineCellOpts(cell.FgColor(cell.ColorRed)))
-				testdraw.MustBrailleLine(bc, image.Point{0, 0}, image.Point{13, 31}, draw.BrailleLineCellOpts(cell.FgColor(cell.ColorBlue)))
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{23, 0})
 				testbraille.MustCopyTo(bc, c)
 
 				testcanvas.MustApply(c, ft)
@@ -830,13 +929,13 @@ func TestLineChartDraws(t *testing.T) {
 			},
 		},
 		{
-			desc:   "draw multiple series, the second has smaller scale than the first",
+			desc: "custom Y scale, negative and positive, values don't fit so adjusted",
+			opts: []Option{
+				YAxisCustomScale(-200, 200),
+			},
 			canvas: image.Rect(0, 0, 20, 10),
 			writes: func(lc *LineChart) error {
-				if err := lc.Series("first", []float64{0, 50, 100}); err != nil {
-					return err
-				}
-				return lc.Series("second", []float64{10, 20})
+				return lc.Series("first", []float64{-400, 400})
 			},
 			wantCapacity: 28,
 			want: func(size image.Point) *faketerm.Terminal {
@@ -851,18 +950,15 @@ func TestLineChartDraws(t *testing.T) {
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "0", image.Point{4, 7})
-				testdraw.MustText(c, "51.68", image.Point{0, 3})
+				testdraw.MustText(c, "-400", image.Point{1, 7})
+				testdraw.MustText(c, "12.96", image.Point{0, 3})
 				testdraw.MustText(c, "0", image.Point{6, 9})
-				testdraw.MustText(c, "1", image.Point{12, 9})
-				testdraw.MustText(c, "2", image.Point{19, 9})
+				testdraw.MustText(c, "1", image.Point{19, 9})
 
 				// Braille line.
 				graphAr := image.Rect(6, 0, 20, 8)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{13, 16})
-				testdraw.MustBrailleLine(bc, image.Point{13, 16}, image.Point{27, 0})
-				testdraw.MustBrailleLine(bc, image.Point{0, 28}, image.Point{13, 25})
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{26, 0})
 				testbraille.MustCopyTo(bc, c)
 
 				testcanvas.MustApply(c, ft)
@@ -870,39 +966,33 @@ func TestLineChartDraws(t *testing.T) {
 			},
 		},
 		{
-			desc:   "draw multiple series, the second has larger scale than the first",
+			desc:   "draws anchored Y axis",
 			canvas: image.Rect(0, 0, 20, 10),
 			writes: func(lc *LineChart) error {
-				if err := lc.Series("first", []float64{0, 50, 100}); err != nil {
-					return err
-				}
-				return lc.Series("second", []float64{-10, 200})
+				return lc.Series("first", []float64{1600, 1900})
 			},
-			wantCapacity: 28,
+			wantCapacity: 26,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
-					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+					{Start: image.Point{6, 0}, End: image.Point{6, 8}},
+					{Start: image.Point{6, 8}, End: image.Point{19, 8}},
 				}
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "-10", image.Point{2, 7})
-				testdraw.MustText(c, "98.48", image.Point{0, 3})
-				testdraw.MustText(c, "0", image.Point{6, 9})
-				testdraw.MustText(c, "1", image.Point{12, 9})
-				testdraw.MustText(c, "2", image.Point{19, 9})
+				testdraw.MustText(c, "0", image.Point{5, 7})
+				testdraw.MustText(c, "980.80", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{7, 9})
+				testdraw.MustText(c, "1", image.Point{19, 9})
 
 				// Braille line.
-				graphAr := image.Rect(6, 0, 20, 8)
+				graphAr := image.Rect(7, 0, 20, 8)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 30}, image.Point{13, 22})
-				testdraw.MustBrailleLine(bc, image.Point{13, 22}, image.Point{27, 15})
-				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{13, 0})
+				testdraw.MustBrailleLine(bc, image.Point{0, 5}, image.Point{25, 0})
 				testbraille.MustCopyTo(bc, c)
 
 				testcanvas.MustApply(c, ft)
@@ -910,51 +1000,36 @@ func TestLineChartDraws(t *testing.T) {
 			},
 		},
 		{
-			desc:   "more values than capacity, X rescales",
-			canvas: image.Rect(0, 0, 11, 10),
+			desc: "draws adaptive Y axis",
+			opts: []Option{
+				YAxisAdaptive(),
+			},
+			canvas: image.Rect(0, 0, 20, 10),
 			writes: func(lc *LineChart) error {
-				return lc.Series("first", []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19})
+				return lc.Series("first", []float64{1600, 1900})
 			},
-			wantCapacity: 12,
+			wantCapacity: 24,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{4, 0}, End: image.Point{4, 8}},
-					{Start: image.Point{4, 8}, End: image.Point{10, 8}},
+					{Start: image.Point{7, 0}, End: image.Point{7, 8}},
+					{Start: image.Point{7, 8}, End: image.Point{19, 8}},
 				}
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "0", image.Point{3, 7})
-				testdraw.MustText(c, "9.92", image.Point{0, 3})
-				testdraw.MustText(c, "0", image.Point{5, 9})
-				testdraw.MustText(c, "14", image.Point{9, 9})
+				testdraw.MustText(c, "1600", image.Point{3, 7})
+				testdraw.MustText(c, "1754.88", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{8, 9})
+				testdraw.MustText(c, "1", image.Point{19, 9})
 
 				// Braille line.
-				graphAr := image.Rect(5, 0, 11, 8)
+				graphAr := image.Rect(8, 0, 20, 8)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{1, 29})
-				testdraw.MustBrailleLine(bc, image.Point{1, 29}, image.Point{1, 28})
-				testdraw.MustBrailleLine(bc, image.Point{1, 28}, image.Point{2, 26})
-				testdraw.MustBrailleLine(bc, image.Point{2, 26}, image.Point{2, 25})
-				testdraw.MustBrailleLine(bc, image.Point{2, 25}, image.Point{3, 23})
-				testdraw.MustBrailleLine(bc, image.Point{3, 23}, image.Point{3, 21})
-				testdraw.MustBrailleLine(bc, image.Point{3, 21}, image.Point{4, 20})
-				testdraw.MustBrailleLine(bc, image.Point{4, 20}, image.Point{5, 18})
-				testdraw.MustBrailleLine(bc, image.Point{5, 18}, image.Point{5, 16})
-				testdraw.MustBrailleLine(bc, image.Point{5, 16}, image.Point{6, 15})
-				testdraw.MustBrailleLine(bc, image.Point{6, 15}, image.Point{6, 13})
-				testdraw.MustBrailleLine(bc, image.Point{6, 13}, image.Point{7, 12})
-				testdraw.MustBrailleLine(bc, image.Point{7, 12}, image.Point{8, 10})
-				testdraw.MustBrailleLine(bc, image.Point{8, 10}, image.Point{8, 8})
-				testdraw.MustBrailleLine(bc, image.Point{8, 8}, image.Point{9, 7})
-				testdraw.MustBrailleLine(bc, image.Point{9, 7}, image.Point{9, 5})
-				testdraw.MustBrailleLine(bc, image.Point{9, 5}, image.Point{10, 4})
-				testdraw.MustBrailleLine(bc, image.Point{10, 4}, image.Point{10, 2})
-				testdraw.MustBrailleLine(bc, image.Point{10, 2}, image.Point{11, 0})
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{23, 0})
 				testbraille.MustCopyTo(bc, c)
 
 				testcanvas.MustApply(c, ft)
@@ -962,36 +1037,1039 @@ func TestLineChartDraws(t *testing.T) {
 			},
 		},
 		{
-			desc: "more values than capacity, X unscaled",
-			opts: []Option{
-				XAxisUnscaled(),
-			},
-			canvas: image.Rect(0, 0, 11, 10),
+			desc:   "custom X labels, horizontal by default",
+			canvas: image.Rect(0, 0, 20, 10),
 			writes: func(lc *LineChart) error {
-				return lc.Series("first", []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19})
+				return lc.Series("first", []float64{0, 100}, SeriesXLabels(map[int]string{
+					0: "start",
+					1: "end",
+				}))
 			},
-			wantCapacity: 12,
+			wantCapacity: 28,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{4, 0}, End: image.Point{4, 8}},
-					{Start: image.Point{4, 8}, End: image.Point{10, 8}},
+					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
+					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
 				}
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "0", image.Point{3, 7})
-				testdraw.MustText(c, "9.92", image.Point{0, 3})
-				testdraw.MustText(c, "8", image.Point{5, 9})
-				testdraw.MustText(c, "16", image.Point{9, 9})
+				testdraw.MustText(c, "0", image.Point{4, 7})
+				testdraw.MustText(c, "51.68", image.Point{0, 3})
+				testdraw.MustText(c, "start", image.Point{6, 9})
 
 				// Braille line.
-				graphAr := image.Rect(5, 0, 11, 8)
+				graphAr := image.Rect(6, 0, 20, 8)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 18}, image.Point{1, 16})
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{26, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "series fails when point marker is a full-width rune",
+			canvas: image.Rect(0, 0, 3, 4),
+			writes: func(lc *LineChart) error {
+				return lc.Series("series", []float64{0, 1}, SeriesPointMarker('世'))
+			},
+			wantWriteErr: true,
+		},
+		{
+			desc:   "draws point markers at each value",
+			canvas: image.Rect(0, 0, 20, 11),
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{0, 50, 100}, SeriesPointMarker('o'))
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{6, 10})
+				testdraw.MustText(c, "1", image.Point{12, 10})
+				testdraw.MustText(c, "2", image.Point{19, 10})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 9)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 18})
+				testdraw.MustBrailleLine(bc, image.Point{13, 18}, image.Point{27, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				// Markers in the cells of pixels (0, 35), (13, 18) and (27, 0).
+				testcanvas.MustSetCell(c, image.Point{6, 8}, 'o')
+				testcanvas.MustSetCell(c, image.Point{12, 4}, 'o')
+				testcanvas.MustSetCell(c, image.Point{19, 0}, 'o')
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "point markers use series cell options by default",
+			canvas: image.Rect(0, 0, 20, 11),
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{0, 50, 100},
+					SeriesCellOpts(cell.FgColor(cell.ColorRed)),
+					SeriesPointMarker('o'),
+				)
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{6, 10})
+				testdraw.MustText(c, "1", image.Point{12, 10})
+				testdraw.MustText(c, "2", image.Point{19, 10})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 9)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 18}, draw.BrailleLineCellOpts(cell.FgColor(cell.ColorRed)))
+				testdraw.MustBrailleLine(bc, image.Point{13, 18}, image.Point{27, 0}, draw.BrailleLineCellOpts(cell.FgColor(cell.ColorRed)))
+				testbraille.MustCopyTo(bc, c)
+
+				// Markers.
+				testcanvas.MustSetCell(c, image.Point{6, 8}, 'o', cell.FgColor(cell.ColorRed))
+				testcanvas.MustSetCell(c, image.Point{12, 4}, 'o', cell.FgColor(cell.ColorRed))
+				testcanvas.MustSetCell(c, image.Point{19, 0}, 'o', cell.FgColor(cell.ColorRed))
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "point markers with custom cell options skip missing values",
+			canvas: image.Rect(0, 0, 20, 11),
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{0, math.NaN(), 100},
+					SeriesPointMarker('o', cell.FgColor(cell.ColorBlue)),
+				)
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{6, 10})
+				testdraw.MustText(c, "1", image.Point{12, 10})
+				testdraw.MustText(c, "2", image.Point{19, 10})
+
+				// Markers, the missing value has no line and no marker.
+				testcanvas.MustSetCell(c, image.Point{6, 8}, 'o', cell.FgColor(cell.ColorBlue))
+				testcanvas.MustSetCell(c, image.Point{19, 0}, 'o', cell.FgColor(cell.ColorBlue))
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "fails when the last value highlight is a full-width rune",
+			opts: []Option{
+				HighlightLast('世'),
+			},
+			canvas:  image.Rect(0, 0, 3, 4),
+			wantErr: true,
+		},
+		{
+			desc: "highlights the last value using series cell options by default",
+			opts: []Option{
+				HighlightLast('*'),
+			},
+			canvas: image.Rect(0, 0, 20, 11),
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{0, 50, 100},
+					SeriesCellOpts(cell.FgColor(cell.ColorRed)),
+				)
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{6, 10})
+				testdraw.MustText(c, "1", image.Point{12, 10})
+				testdraw.MustText(c, "2", image.Point{19, 10})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 9)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 18}, draw.BrailleLineCellOpts(cell.FgColor(cell.ColorRed)))
+				testdraw.MustBrailleLine(bc, image.Point{13, 18}, image.Point{27, 0}, draw.BrailleLineCellOpts(cell.FgColor(cell.ColorRed)))
+				testbraille.MustCopyTo(bc, c)
+
+				// Highlight in the cell of pixel (27, 0).
+				testcanvas.MustSetCell(c, image.Point{19, 0}, '*', cell.FgColor(cell.ColorRed))
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "last value highlight moves with new values and is drawn over point markers",
+			opts: []Option{
+				HighlightLast('*', cell.FgColor(cell.ColorBlue)),
+			},
+			canvas: image.Rect(0, 0, 20, 11),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 50, 100}, SeriesPointMarker('o')); err != nil {
+					return err
+				}
+				cvs := testcanvas.MustNew(image.Rect(0, 0, 20, 11))
+				if err := lc.Draw(cvs, &widgetapi.Meta{}); err != nil {
+					return err
+				}
+				return lc.Series("first", []float64{0, 100, 50}, SeriesPointMarker('o'))
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{6, 10})
+				testdraw.MustText(c, "1", image.Point{12, 10})
+				testdraw.MustText(c, "2", image.Point{19, 10})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 9)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 0})
+				testdraw.MustBrailleLine(bc, image.Point{13, 0}, image.Point{27, 18})
+				testbraille.MustCopyTo(bc, c)
+
+				// Markers in the cells of pixels (0, 35) and (13, 0), the
+				// highlight in the cell of pixel (27, 18).
+				testcanvas.MustSetCell(c, image.Point{6, 8}, 'o')
+				testcanvas.MustSetCell(c, image.Point{12, 0}, 'o')
+				testcanvas.MustSetCell(c, image.Point{19, 4}, '*', cell.FgColor(cell.ColorBlue))
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "last value highlight skips missing values at the end",
+			opts: []Option{
+				HighlightLast('*'),
+			},
+			canvas: image.Rect(0, 0, 20, 11),
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{0, 100, math.NaN()})
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{6, 10})
+				testdraw.MustText(c, "1", image.Point{12, 10})
+				testdraw.MustText(c, "2", image.Point{19, 10})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 9)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				// Highlight in the cell of pixel (13, 0).
+				testcanvas.MustSetCell(c, image.Point{12, 0}, '*')
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "last value highlight isn't drawn when zoomed out of view",
+			opts: []Option{
+				HighlightLast('*'),
+			},
+			canvas: image.Rect(0, 0, 20, 10),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 100, 0, 100, 0}); err != nil {
+					return err
+				}
+				// Draw once so zoom tracker is initialized.
+				cvs := testcanvas.MustNew(image.Rect(0, 0, 20, 10))
+				if err := lc.Draw(cvs, &widgetapi.Meta{}); err != nil {
+					return err
+				}
+				// Zoom to the first three values.
+				for _, m := range []*terminalapi.Mouse{
+					{Position: image.Point{6, 5}, Button: mouse.ButtonLeft},
+					{Position: image.Point{13, 5}, Button: mouse.ButtonLeft},
+					{Position: image.Point{13, 5}, Button: mouse.ButtonRelease},
+				} {
+					if err := lc.Mouse(m, &widgetapi.EventMeta{}); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
+					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 7})
+				testdraw.MustText(c, "51.68", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{6, 9})
+				testdraw.MustText(c, "1", image.Point{12, 9})
+				testdraw.MustText(c, "2", image.Point{19, 9})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{13, 0})
+				testdraw.MustBrailleLine(bc, image.Point{13, 0}, image.Point{27, 31})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "X labels formatted from timestamps",
+			opts: []Option{
+				XAxisTimeFormat("15:04"),
+			},
+			canvas: image.Rect(0, 0, 20, 11),
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{0, 50, 100}, SeriesTimestamps([]time.Time{
+					time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+					time.Date(2024, 1, 1, 10, 15, 0, 0, time.UTC),
+					time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC),
+				}))
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels, the last timestamp doesn't fit.
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "10:00", image.Point{6, 10})
+				testdraw.MustText(c, "10:15", image.Point{14, 10})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 9)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 18})
+				testdraw.MustBrailleLine(bc, image.Point{13, 18}, image.Point{27, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "X labels formatted from timestamps with the default format",
+			canvas: image.Rect(0, 0, 20, 10),
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{0, 100}, SeriesTimestamps([]time.Time{
+					time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+					time.Date(2024, 1, 1, 10, 15, 0, 0, time.UTC),
+				}))
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
+					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 7})
+				testdraw.MustText(c, "51.68", image.Point{0, 3})
+				testdraw.MustText(c, "10:00:00", image.Point{6, 9})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{26, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "custom X labels, horizontal with option",
+			opts: []Option{
+				XLabelsHorizontal(),
+			},
+			canvas: image.Rect(0, 0, 20, 10),
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{0, 100}, SeriesXLabels(map[int]string{
+					0: "start",
+					1: "end",
+				}))
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
+					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 7})
+				testdraw.MustText(c, "51.68", image.Point{0, 3})
+				testdraw.MustText(c, "start", image.Point{6, 9})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{26, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "custom X labels, vertical",
+			opts: []Option{
+				XLabelsVertical(),
+			},
+			canvas: image.Rect(0, 0, 20, 10),
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{0, 100}, SeriesXLabels(map[int]string{
+					0: "start",
+					1: "end",
+				}))
+			},
+			wantCapacity: 26,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{6, 0}, End: image.Point{6, 4}},
+					{Start: image.Point{6, 4}, End: image.Point{19, 4}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{5, 3})
+				testdraw.MustText(c, "80.040", image.Point{0, 0})
+				testdraw.MustVerticalText(c, "start", image.Point{7, 5})
+				testdraw.MustVerticalText(c, "end", image.Point{19, 5})
+
+				// Braille line.
+				graphAr := image.Rect(7, 0, 20, 4)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 15}, image.Point{25, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "sets series cell options",
+			canvas: image.Rect(0, 0, 20, 10),
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{0, 100}, SeriesCellOpts(cell.BgColor(cell.ColorRed), cell.FgColor(cell.ColorGreen)))
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
+					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 7})
+				testdraw.MustText(c, "51.68", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{6, 9})
+				testdraw.MustText(c, "1", image.Point{19, 9})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{26, 0}, draw.BrailleLineCellOpts(cell.BgColor(cell.ColorRed), cell.FgColor(cell.ColorGreen)))
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "multiple Y and X labels",
+			canvas: image.Rect(0, 0, 20, 11),
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{0, 50, 100})
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{6, 10})
+				testdraw.MustText(c, "1", image.Point{12, 10})
+				testdraw.MustText(c, "2", image.Point{19, 10})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 9)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 18})
+				testdraw.MustBrailleLine(bc, image.Point{13, 18}, image.Point{27, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "Y labels are trimmed",
+			canvas: image.Rect(0, 0, 5, 4),
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{0, 100})
+			},
+			wantCapacity: 2,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{3, 0}, End: image.Point{3, 2}},
+					{Start: image.Point{3, 2}, End: image.Point{4, 2}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{2, 1})
+				testdraw.MustText(c, "57…", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{4, 3})
+
+				// Braille line.
+				graphAr := image.Rect(4, 0, 5, 2)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 7}, image.Point{1, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "draw multiple series",
+			canvas: image.Rect(0, 0, 20, 10),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 50, 100}); err != nil {
+					return err
+				}
+				return lc.Series("second", []float64{100, 0})
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
+					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 7})
+				testdraw.MustText(c, "51.68", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{6, 9})
+				testdraw.MustText(c, "1", image.Point{12, 9})
+				testdraw.MustText(c, "2", image.Point{19, 9})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{27, 0})
+				testdraw.MustBrailleLine(bc, image.Point{0, 0}, image.Point{13, 31})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "draws a series on the secondary Y axis with its own scale",
+			canvas: image.Rect(0, 0, 26, 10),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 50, 100}); err != nil {
+					return err
+				}
+				return lc.Series("second", []float64{10, 0}, SeriesSecondaryAxis())
+			},
+			wantCapacity: 30,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y, X and secondary Y axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
+					{Start: image.Point{5, 8}, End: image.Point{21, 8}},
+					{Start: image.Point{21, 0}, End: image.Point{21, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 7})
+				testdraw.MustText(c, "51.68", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{22, 7})
+				testdraw.MustText(c, "5.28", image.Point{22, 3})
+				testdraw.MustText(c, "0", image.Point{6, 9})
+				testdraw.MustText(c, "1", image.Point{13, 9})
+				testdraw.MustText(c, "2", image.Point{20, 9})
+
+				// Braille lines.
+				graphAr := image.Rect(6, 0, 21, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{29, 0})
+				testdraw.MustBrailleLine(bc, image.Point{0, 1}, image.Point{14, 31})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "draws resize needed character when the secondary Y axis doesn't fit",
+			canvas: image.Rect(0, 0, 6, 4),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 100}); err != nil {
+					return err
+				}
+				return lc.Series("second", []float64{0, 100}, SeriesSecondaryAxis())
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustResizeNeeded(c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "draw multiple series with different cell options, last series wins where they cross",
+			canvas: image.Rect(0, 0, 20, 10),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 50, 100}, SeriesCellOpts(cell.FgColor(cell.ColorRed))); err != nil {
+					return err
+				}
+				return lc.Series("second", []float64{100, 0}, SeriesCellOpts(cell.FgColor(cell.ColorBlue)))
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
+					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 7})
+				testdraw.MustText(c, "51.68", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{6, 9})
+				testdraw.MustText(c, "1", image.Point{12, 9})
+				testdraw.MustText(c, "2", image.Point{19, 9})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{27, 0}, draw.BrailleLineCellOpts(cell.FgColor(cell.ColorRed)))
+				testdraw.MustBrailleLine(bc, image.Point{0, 0}, image.Point{13, 31}, draw.BrailleLineCellOpts(cell.FgColor(cell.ColorBlue)))
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "draw multiple series, the second has smaller scale than the first",
+			canvas: image.Rect(0, 0, 20, 10),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 50, 100}); err != nil {
+					return err
+				}
+				return lc.Series("second", []float64{10, 20})
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
+					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 7})
+				testdraw.MustText(c, "51.68", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{6, 9})
+				testdraw.MustText(c, "1", image.Point{12, 9})
+				testdraw.MustText(c, "2", image.Point{19, 9})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{13, 16})
+				testdraw.MustBrailleLine(bc, image.Point{13, 16}, image.Point{27, 0})
+				testdraw.MustBrailleLine(bc, image.Point{0, 28}, image.Point{13, 25})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "draw multiple series, the second has larger scale than the first",
+			canvas: image.Rect(0, 0, 20, 10),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 50, 100}); err != nil {
+					return err
+				}
+				return lc.Series("second", []float64{-10, 200})
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
+					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "-10", image.Point{2, 7})
+				testdraw.MustText(c, "98.48", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{6, 9})
+				testdraw.MustText(c, "1", image.Point{12, 9})
+				testdraw.MustText(c, "2", image.Point{19, 9})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 30}, image.Point{13, 22})
+				testdraw.MustBrailleLine(bc, image.Point{13, 22}, image.Point{27, 15})
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{13, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "more values than capacity, X rescales",
+			canvas: image.Rect(0, 0, 11, 10),
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19})
+			},
+			wantCapacity: 12,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{4, 0}, End: image.Point{4, 8}},
+					{Start: image.Point{4, 8}, End: image.Point{10, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{3, 7})
+				testdraw.MustText(c, "9.92", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{5, 9})
+				testdraw.MustText(c, "14", image.Point{9, 9})
+
+				// Braille line.
+				graphAr := image.Rect(5, 0, 11, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{1, 29})
+				testdraw.MustBrailleLine(bc, image.Point{1, 29}, image.Point{1, 28})
+				testdraw.MustBrailleLine(bc, image.Point{1, 28}, image.Point{2, 26})
+				testdraw.MustBrailleLine(bc, image.Point{2, 26}, image.Point{2, 25})
+				testdraw.MustBrailleLine(bc, image.Point{2, 25}, image.Point{3, 23})
+				testdraw.MustBrailleLine(bc, image.Point{3, 23}, image.Point{3, 21})
+				testdraw.MustBrailleLine(bc, image.Point{3, 21}, image.Point{4, 20})
+				testdraw.MustBrailleLine(bc, image.Point{4, 20}, image.Point{5, 18})
+				testdraw.MustBrailleLine(bc, image.Point{5, 18}, image.Point{5, 16})
+				testdraw.MustBrailleLine(bc, image.Point{5, 16}, image.Point{6, 15})
+				testdraw.MustBrailleLine(bc, image.Point{6, 15}, image.Point{6, 13})
+				testdraw.MustBrailleLine(bc, image.Point{6, 13}, image.Point{7, 12})
+				testdraw.MustBrailleLine(bc, image.Point{7, 12}, image.Point{8, 10})
+				testdraw.MustBrailleLine(bc, image.Point{8, 10}, image.Point{8, 8})
+				testdraw.MustBrailleLine(bc, image.Point{8, 8}, image.Point{9, 7})
+				testdraw.MustBrailleLine(bc, image.Point{9, 7}, image.Point{9, 5})
+				testdraw.MustBrailleLine(bc, image.Point{9, 5}, image.Point{10, 4})
+				testdraw.MustBrailleLine(bc, image.Point{10, 4}, image.Point{10, 2})
+				testdraw.MustBrailleLine(bc, image.Point{10, 2}, image.Point{11, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "more values than capacity, X unscaled",
+			opts: []Option{
+				XAxisUnscaled(),
+			},
+			canvas: image.Rect(0, 0, 11, 10),
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19})
+			},
+			wantCapacity: 12,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{4, 0}, End: image.Point{4, 8}},
+					{Start: image.Point{4, 8}, End: image.Point{10, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{3, 7})
+				testdraw.MustText(c, "9.92", image.Point{0, 3})
+				testdraw.MustText(c, "8", image.Point{5, 9})
+				testdraw.MustText(c, "16", image.Point{9, 9})
+
+				// Braille line.
+				graphAr := image.Rect(5, 0, 11, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 18}, image.Point{1, 16})
+				testdraw.MustBrailleLine(bc, image.Point{1, 16}, image.Point{2, 15})
+				testdraw.MustBrailleLine(bc, image.Point{2, 15}, image.Point{3, 13})
+				testdraw.MustBrailleLine(bc, image.Point{3, 13}, image.Point{4, 12})
+				testdraw.MustBrailleLine(bc, image.Point{4, 12}, image.Point{5, 10})
+				testdraw.MustBrailleLine(bc, image.Point{5, 10}, image.Point{6, 8})
+				testdraw.MustBrailleLine(bc, image.Point{6, 8}, image.Point{7, 7})
+				testdraw.MustBrailleLine(bc, image.Point{7, 7}, image.Point{8, 5})
+				testdraw.MustBrailleLine(bc, image.Point{8, 5}, image.Point{9, 4})
+				testdraw.MustBrailleLine(bc, image.Point{9, 4}, image.Point{10, 2})
+				testdraw.MustBrailleLine(bc, image.Point{10, 2}, image.Point{11, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "more values than capacity, X unscaled, hides shorter series",
+			opts: []Option{
+				XAxisUnscaled(),
+			},
+			canvas: image.Rect(0, 0, 11, 10),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}); err != nil {
+					return err
+				}
+				return lc.Series("shorter", []float64{8, 7, 6, 5, 4, 3, 2, 1, 0})
+			},
+			wantCapacity: 12,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{4, 0}, End: image.Point{4, 8}},
+					{Start: image.Point{4, 8}, End: image.Point{10, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{3, 7})
+				testdraw.MustText(c, "9.92", image.Point{0, 3})
+				testdraw.MustText(c, "8", image.Point{5, 9})
+				testdraw.MustText(c, "16", image.Point{9, 9})
+
+				// Braille line.
+				graphAr := image.Rect(5, 0, 11, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 18}, image.Point{1, 16})
+				testdraw.MustBrailleLine(bc, image.Point{1, 16}, image.Point{2, 15})
+				testdraw.MustBrailleLine(bc, image.Point{2, 15}, image.Point{3, 13})
+				testdraw.MustBrailleLine(bc, image.Point{3, 13}, image.Point{4, 12})
+				testdraw.MustBrailleLine(bc, image.Point{4, 12}, image.Point{5, 10})
+				testdraw.MustBrailleLine(bc, image.Point{5, 10}, image.Point{6, 8})
+				testdraw.MustBrailleLine(bc, image.Point{6, 8}, image.Point{7, 7})
+				testdraw.MustBrailleLine(bc, image.Point{7, 7}, image.Point{8, 5})
+				testdraw.MustBrailleLine(bc, image.Point{8, 5}, image.Point{9, 4})
+				testdraw.MustBrailleLine(bc, image.Point{9, 4}, image.Point{10, 2})
+				testdraw.MustBrailleLine(bc, image.Point{10, 2}, image.Point{11, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "more values than capacity, X unscaled, shorter series displayed partially",
+			opts: []Option{
+				XAxisUnscaled(),
+			},
+			canvas: image.Rect(0, 0, 11, 10),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}); err != nil {
+					return err
+				}
+				return lc.Series("shorter", []float64{9, 8, 7, 6, 5, 4, 3, 2, 1, 0})
+			},
+			wantCapacity: 12,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{4, 0}, End: image.Point{4, 8}},
+					{Start: image.Point{4, 8}, End: image.Point{10, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{3, 7})
+				testdraw.MustText(c, "9.92", image.Point{0, 3})
+				testdraw.MustText(c, "8", image.Point{5, 9})
+				testdraw.MustText(c, "16", image.Point{9, 9})
+
+				// Braille line.
+				graphAr := image.Rect(5, 0, 11, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 18}, image.Point{1, 16})
 				testdraw.MustBrailleLine(bc, image.Point{1, 16}, image.Point{2, 15})
 				testdraw.MustBrailleLine(bc, image.Point{2, 15}, image.Point{3, 13})
 				testdraw.MustBrailleLine(bc, image.Point{3, 13}, image.Point{4, 12})
@@ -1002,154 +2080,625 @@ func TestLineChartDraws(t *testing.T) {
 				testdraw.MustBrailleLine(bc, image.Point{8, 5}, image.Point{9, 4})
 				testdraw.MustBrailleLine(bc, image.Point{9, 4}, image.Point{10, 2})
 				testdraw.MustBrailleLine(bc, image.Point{10, 2}, image.Point{11, 0})
+				testdraw.MustBrailleLine(bc, image.Point{0, 29}, image.Point{1, 31})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "values fit capacity, X unscaled takes no effect",
+			opts: []Option{
+				XAxisUnscaled(),
+			},
+			canvas: image.Rect(0, 0, 11, 10),
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11})
+			},
+			wantCapacity: 12,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{4, 0}, End: image.Point{4, 8}},
+					{Start: image.Point{4, 8}, End: image.Point{10, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{3, 7})
+				testdraw.MustText(c, "5.76", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{5, 9})
+				testdraw.MustText(c, "8", image.Point{9, 9})
+
+				// Braille line.
+				graphAr := image.Rect(5, 0, 11, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{1, 28})
+				testdraw.MustBrailleLine(bc, image.Point{1, 28}, image.Point{2, 25})
+				testdraw.MustBrailleLine(bc, image.Point{2, 25}, image.Point{3, 23})
+				testdraw.MustBrailleLine(bc, image.Point{3, 23}, image.Point{4, 20})
+				testdraw.MustBrailleLine(bc, image.Point{4, 20}, image.Point{5, 17})
+				testdraw.MustBrailleLine(bc, image.Point{5, 17}, image.Point{6, 14})
+				testdraw.MustBrailleLine(bc, image.Point{6, 14}, image.Point{7, 12})
+				testdraw.MustBrailleLine(bc, image.Point{7, 12}, image.Point{8, 9})
+				testdraw.MustBrailleLine(bc, image.Point{8, 9}, image.Point{9, 6})
+				testdraw.MustBrailleLine(bc, image.Point{9, 6}, image.Point{10, 3})
+				testdraw.MustBrailleLine(bc, image.Point{10, 3}, image.Point{11, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "highlights area for zoom",
+			canvas: image.Rect(0, 0, 20, 10),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 100}); err != nil {
+					return err
+				}
+				// Draw once so zoom tracker is initialized.
+				cvs := testcanvas.MustNew(image.Rect(0, 0, 20, 10))
+				if err := lc.Draw(cvs, &widgetapi.Meta{}); err != nil {
+					return err
+				}
+				return lc.Mouse(&terminalapi.Mouse{
+					Position: image.Point{6, 5},
+					Button:   mouse.ButtonLeft,
+				}, &widgetapi.EventMeta{})
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
+					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 7})
+				testdraw.MustText(c, "51.68", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{6, 9})
+				testdraw.MustText(c, "1", image.Point{19, 9})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{26, 0})
+
+				// Highlighted area for zoom.
+				testbraille.MustSetAreaCellOpts(bc, image.Rect(0, 0, 1, 8), cell.BgColor(cell.ColorNumber(235)))
+
+				testbraille.MustCopyTo(bc, c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "highlights area for zoom to a custom color",
+			opts: []Option{
+				ZoomHightlightColor(cell.ColorNumber(13)),
+			},
+			canvas: image.Rect(0, 0, 20, 10),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 100}); err != nil {
+					return err
+				}
+				// Draw once so zoom tracker is initialized.
+				cvs := testcanvas.MustNew(image.Rect(0, 0, 20, 10))
+				if err := lc.Draw(cvs, &widgetapi.Meta{}); err != nil {
+					return err
+				}
+				return lc.Mouse(&terminalapi.Mouse{
+					Position: image.Point{6, 5},
+					Button:   mouse.ButtonLeft,
+				}, &widgetapi.EventMeta{})
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
+					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 7})
+				testdraw.MustText(c, "51.68", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{6, 9})
+				testdraw.MustText(c, "1", image.Point{19, 9})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{26, 0})
+
+				// Highlighted area for zoom.
+				testbraille.MustSetAreaCellOpts(bc, image.Rect(0, 0, 1, 8), cell.BgColor(cell.ColorNumber(13)))
+
+				testbraille.MustCopyTo(bc, c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "hover tooltip shows the nearest value",
+			opts: []Option{
+				HoverTooltip(),
+			},
+			canvas: image.Rect(0, 0, 20, 11),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 50, 100}); err != nil {
+					return err
+				}
+				// Draw once so the graph area is known.
+				cvs := testcanvas.MustNew(image.Rect(0, 0, 20, 11))
+				if err := lc.Draw(cvs, &widgetapi.Meta{}); err != nil {
+					return err
+				}
+				if err := lc.Mouse(&terminalapi.Mouse{
+					Position: image.Point{11, 5},
+					Button:   mouse.ButtonRelease,
+				}, &widgetapi.EventMeta{}); err != nil {
+					return err
+				}
+				return nil
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{6, 10})
+				testdraw.MustText(c, "1", image.Point{12, 10})
+				testdraw.MustText(c, "2", image.Point{19, 10})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 9)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 18})
+				testdraw.MustBrailleLine(bc, image.Point{13, 18}, image.Point{27, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				// Tooltip on the row above the cursor.
+				testdraw.MustText(c, "1: 50", image.Point{12, 4}, draw.TextCellOpts(cell.Inverse()))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "crosshair at the mouse column labels the nearest value",
+			opts: []Option{
+				Crosshair(),
+			},
+			canvas: image.Rect(0, 0, 20, 11),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 50, 100}); err != nil {
+					return err
+				}
+				// Draw once so the graph area is known.
+				cvs := testcanvas.MustNew(image.Rect(0, 0, 20, 11))
+				if err := lc.Draw(cvs, &widgetapi.Meta{}); err != nil {
+					return err
+				}
+				if err := lc.Mouse(&terminalapi.Mouse{
+					Position: image.Point{11, 5},
+					Button:   mouse.ButtonRelease,
+				}, &widgetapi.EventMeta{}); err != nil {
+					return err
+				}
+				return nil
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{6, 10})
+				testdraw.MustText(c, "1", image.Point{12, 10})
+				testdraw.MustText(c, "2", image.Point{19, 10})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 9)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 18})
+				testdraw.MustBrailleLine(bc, image.Point{13, 18}, image.Point{27, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				// Vertical line in the cells without the series.
+				for _, row := range []int{0, 1, 2, 3, 4, 6, 7, 8} {
+					testcanvas.MustSetCell(c, image.Point{11, row}, '│', cell.Dim())
+				}
+
+				// Label of the nearest value on the Y axis.
+				testdraw.MustText(c, "   50", image.Point{0, 4}, draw.TextCellOpts(cell.Inverse()))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "crosshair with custom label cell options",
+			opts: []Option{
+				Crosshair(),
+				CrosshairLabelCellOpts(cell.FgColor(cell.ColorRed)),
+			},
+			canvas: image.Rect(0, 0, 20, 11),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 50, 100}); err != nil {
+					return err
+				}
+				// Draw once so the graph area is known.
+				cvs := testcanvas.MustNew(image.Rect(0, 0, 20, 11))
+				if err := lc.Draw(cvs, &widgetapi.Meta{}); err != nil {
+					return err
+				}
+				if err := lc.Mouse(&terminalapi.Mouse{
+					Position: image.Point{11, 5},
+					Button:   mouse.ButtonRelease,
+				}, &widgetapi.EventMeta{}); err != nil {
+					return err
+				}
+				return nil
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{6, 10})
+				testdraw.MustText(c, "1", image.Point{12, 10})
+				testdraw.MustText(c, "2", image.Point{19, 10})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 9)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 18})
+				testdraw.MustBrailleLine(bc, image.Point{13, 18}, image.Point{27, 0})
 				testbraille.MustCopyTo(bc, c)
 
+				// Vertical line in the cells without the series.
+				for _, row := range []int{0, 1, 2, 3, 4, 6, 7, 8} {
+					testcanvas.MustSetCell(c, image.Point{11, row}, '│', cell.Dim())
+				}
+
+				// Label of the nearest value on the Y axis.
+				testdraw.MustText(c, "   50", image.Point{0, 4}, draw.TextCellOpts(cell.FgColor(cell.ColorRed)))
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
 		},
 		{
-			desc: "more values than capacity, X unscaled, hides shorter series",
+			desc: "crosshair with horizontal line and custom cell options",
 			opts: []Option{
-				XAxisUnscaled(),
+				Crosshair(cell.FgColor(cell.ColorRed)),
+				CrosshairHorizontal(),
 			},
-			canvas: image.Rect(0, 0, 11, 10),
+			canvas: image.Rect(0, 0, 20, 11),
 			writes: func(lc *LineChart) error {
-				if err := lc.Series("first", []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}); err != nil {
+				if err := lc.Series("first", []float64{0, 50, 100}); err != nil {
 					return err
 				}
-				return lc.Series("shorter", []float64{8, 7, 6, 5, 4, 3, 2, 1, 0})
+				// Draw once so the graph area is known.
+				cvs := testcanvas.MustNew(image.Rect(0, 0, 20, 11))
+				if err := lc.Draw(cvs, &widgetapi.Meta{}); err != nil {
+					return err
+				}
+				if err := lc.Mouse(&terminalapi.Mouse{
+					Position: image.Point{11, 5},
+					Button:   mouse.ButtonRelease,
+				}, &widgetapi.EventMeta{}); err != nil {
+					return err
+				}
+				return nil
 			},
-			wantCapacity: 12,
+			wantCapacity: 28,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{4, 0}, End: image.Point{4, 8}},
-					{Start: image.Point{4, 8}, End: image.Point{10, 8}},
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
 				}
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "0", image.Point{3, 7})
-				testdraw.MustText(c, "9.92", image.Point{0, 3})
-				testdraw.MustText(c, "8", image.Point{5, 9})
-				testdraw.MustText(c, "16", image.Point{9, 9})
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{6, 10})
+				testdraw.MustText(c, "1", image.Point{12, 10})
+				testdraw.MustText(c, "2", image.Point{19, 10})
 
 				// Braille line.
-				graphAr := image.Rect(5, 0, 11, 8)
+				graphAr := image.Rect(6, 0, 20, 9)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 18}, image.Point{1, 16})
-				testdraw.MustBrailleLine(bc, image.Point{1, 16}, image.Point{2, 15})
-				testdraw.MustBrailleLine(bc, image.Point{2, 15}, image.Point{3, 13})
-				testdraw.MustBrailleLine(bc, image.Point{3, 13}, image.Point{4, 12})
-				testdraw.MustBrailleLine(bc, image.Point{4, 12}, image.Point{5, 10})
-				testdraw.MustBrailleLine(bc, image.Point{5, 10}, image.Point{6, 8})
-				testdraw.MustBrailleLine(bc, image.Point{6, 8}, image.Point{7, 7})
-				testdraw.MustBrailleLine(bc, image.Point{7, 7}, image.Point{8, 5})
-				testdraw.MustBrailleLine(bc, image.Point{8, 5}, image.Point{9, 4})
-				testdraw.MustBrailleLine(bc, image.Point{9, 4}, image.Point{10, 2})
-				testdraw.MustBrailleLine(bc, image.Point{10, 2}, image.Point{11, 0})
+				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 18})
+				testdraw.MustBrailleLine(bc, image.Point{13, 18}, image.Point{27, 0})
 				testbraille.MustCopyTo(bc, c)
 
+				// Vertical and horizontal lines in the cells without the series.
+				opts := []cell.Option{cell.FgColor(cell.ColorRed)}
+				for _, row := range []int{0, 1, 2, 3, 4, 6, 7, 8} {
+					testcanvas.MustSetCell(c, image.Point{11, row}, '│', opts...)
+				}
+				for _, col := range []int{6, 7, 8, 9, 12, 13, 14, 15, 16, 17, 18, 19} {
+					testcanvas.MustSetCell(c, image.Point{col, 5}, '─', opts...)
+				}
+
+				// Label of the nearest value on the Y axis.
+				testdraw.MustText(c, "   50", image.Point{0, 4}, draw.TextCellOpts(cell.Inverse()))
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
 		},
 		{
-			desc: "more values than capacity, X unscaled, shorter series displayed partially",
+			desc: "crosshair disappears when a mouse button is pressed",
 			opts: []Option{
-				XAxisUnscaled(),
+				Crosshair(),
 			},
-			canvas: image.Rect(0, 0, 11, 10),
+			canvas: image.Rect(0, 0, 20, 11),
 			writes: func(lc *LineChart) error {
-				if err := lc.Series("first", []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}); err != nil {
+				if err := lc.Series("first", []float64{0, 50, 100}); err != nil {
 					return err
 				}
-				return lc.Series("shorter", []float64{9, 8, 7, 6, 5, 4, 3, 2, 1, 0})
+				// Draw once so the graph area is known.
+				cvs := testcanvas.MustNew(image.Rect(0, 0, 20, 11))
+				if err := lc.Draw(cvs, &widgetapi.Meta{}); err != nil {
+					return err
+				}
+				for _, m := range []*terminalapi.Mouse{
+					{Position: image.Point{11, 5}, Button: mouse.ButtonRelease},
+					{Position: image.Point{11, 5}, Button: mouse.ButtonRight},
+				} {
+					if err := lc.Mouse(m, &widgetapi.EventMeta{}); err != nil {
+						return err
+					}
+				}
+				return nil
 			},
-			wantCapacity: 12,
+			wantCapacity: 28,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{4, 0}, End: image.Point{4, 8}},
-					{Start: image.Point{4, 8}, End: image.Point{10, 8}},
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
 				}
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "0", image.Point{3, 7})
-				testdraw.MustText(c, "9.92", image.Point{0, 3})
-				testdraw.MustText(c, "8", image.Point{5, 9})
-				testdraw.MustText(c, "16", image.Point{9, 9})
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{6, 10})
+				testdraw.MustText(c, "1", image.Point{12, 10})
+				testdraw.MustText(c, "2", image.Point{19, 10})
 
 				// Braille line.
-				graphAr := image.Rect(5, 0, 11, 8)
+				graphAr := image.Rect(6, 0, 20, 9)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 18}, image.Point{1, 16})
-				testdraw.MustBrailleLine(bc, image.Point{1, 16}, image.Point{2, 15})
-				testdraw.MustBrailleLine(bc, image.Point{2, 15}, image.Point{3, 13})
-				testdraw.MustBrailleLine(bc, image.Point{3, 13}, image.Point{4, 12})
-				testdraw.MustBrailleLine(bc, image.Point{4, 12}, image.Point{5, 10})
-				testdraw.MustBrailleLine(bc, image.Point{5, 10}, image.Point{6, 8})
-				testdraw.MustBrailleLine(bc, image.Point{6, 8}, image.Point{7, 7})
-				testdraw.MustBrailleLine(bc, image.Point{7, 7}, image.Point{8, 5})
-				testdraw.MustBrailleLine(bc, image.Point{8, 5}, image.Point{9, 4})
-				testdraw.MustBrailleLine(bc, image.Point{9, 4}, image.Point{10, 2})
-				testdraw.MustBrailleLine(bc, image.Point{10, 2}, image.Point{11, 0})
-				testdraw.MustBrailleLine(bc, image.Point{0, 29}, image.Point{1, 31})
+				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 18})
+				testdraw.MustBrailleLine(bc, image.Point{13, 18}, image.Point{27, 0})
+				testbraille.MustCopyTo(bc, c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "hover tooltip shifts left when it doesn't fit",
+			opts: []Option{
+				HoverTooltip(),
+			},
+			canvas: image.Rect(0, 0, 20, 11),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 50, 100}); err != nil {
+					return err
+				}
+				// Draw once so the graph area is known.
+				cvs := testcanvas.MustNew(image.Rect(0, 0, 20, 11))
+				if err := lc.Draw(cvs, &widgetapi.Meta{}); err != nil {
+					return err
+				}
+				if err := lc.Mouse(&terminalapi.Mouse{
+					Position: image.Point{18, 1},
+					Button:   mouse.ButtonRelease,
+				}, &widgetapi.EventMeta{}); err != nil {
+					return err
+				}
+				return nil
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{6, 10})
+				testdraw.MustText(c, "1", image.Point{12, 10})
+				testdraw.MustText(c, "2", image.Point{19, 10})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 9)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 18})
+				testdraw.MustBrailleLine(bc, image.Point{13, 18}, image.Point{27, 0})
 				testbraille.MustCopyTo(bc, c)
 
+				// Tooltip shifted left to fit the canvas.
+				testdraw.MustText(c, "2: 100", image.Point{14, 0}, draw.TextCellOpts(cell.Inverse()))
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
 		},
 		{
-			desc: "values fit capacity, X unscaled takes no effect",
+			desc: "hover tooltip on the first row with custom cell options",
 			opts: []Option{
-				XAxisUnscaled(),
+				HoverTooltip(cell.FgColor(cell.ColorRed)),
 			},
-			canvas: image.Rect(0, 0, 11, 10),
+			canvas: image.Rect(0, 0, 20, 11),
 			writes: func(lc *LineChart) error {
-				return lc.Series("first", []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11})
+				if err := lc.Series("first", []float64{0, 50, 100}); err != nil {
+					return err
+				}
+				// Draw once so the graph area is known.
+				cvs := testcanvas.MustNew(image.Rect(0, 0, 20, 11))
+				if err := lc.Draw(cvs, &widgetapi.Meta{}); err != nil {
+					return err
+				}
+				if err := lc.Mouse(&terminalapi.Mouse{
+					Position: image.Point{7, 0},
+					Button:   mouse.ButtonRelease,
+				}, &widgetapi.EventMeta{}); err != nil {
+					return err
+				}
+				return nil
 			},
-			wantCapacity: 12,
+			wantCapacity: 28,
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{4, 0}, End: image.Point{4, 8}},
-					{Start: image.Point{4, 8}, End: image.Point{10, 8}},
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
 				}
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "0", image.Point{3, 7})
-				testdraw.MustText(c, "5.76", image.Point{0, 3})
-				testdraw.MustText(c, "0", image.Point{5, 9})
-				testdraw.MustText(c, "8", image.Point{9, 9})
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{6, 10})
+				testdraw.MustText(c, "1", image.Point{12, 10})
+				testdraw.MustText(c, "2", image.Point{19, 10})
+
+				// Braille line.
+				graphAr := image.Rect(6, 0, 20, 9)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 18})
+				testdraw.MustBrailleLine(bc, image.Point{13, 18}, image.Point{27, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				// Tooltip on the row of the cursor.
+				testdraw.MustText(c, "1: 50", image.Point{8, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorRed)))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "hover tooltip disappears when the mouse leaves the graph",
+			opts: []Option{
+				HoverTooltip(),
+			},
+			canvas: image.Rect(0, 0, 20, 11),
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 50, 100}); err != nil {
+					return err
+				}
+				// Draw once so the graph area is known.
+				cvs := testcanvas.MustNew(image.Rect(0, 0, 20, 11))
+				if err := lc.Draw(cvs, &widgetapi.Meta{}); err != nil {
+					return err
+				}
+				if err := lc.Mouse(&terminalapi.Mouse{
+					Position: image.Point{11, 5},
+					Button:   mouse.ButtonRelease,
+				}, &widgetapi.EventMeta{}); err != nil {
+					return err
+				}
+				if err := lc.Mouse(&terminalapi.Mouse{
+					Position: image.Point{-1, -1},
+					Button:   mouse.ButtonRelease,
+				}, &widgetapi.EventMeta{}); err != nil {
+					return err
+				}
+				return nil
+			},
+			wantCapacity: 28,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{6, 10})
+				testdraw.MustText(c, "1", image.Point{12, 10})
+				testdraw.MustText(c, "2", image.Point{19, 10})
 
 				// Braille line.
-				graphAr := image.Rect(5, 0, 11, 8)
+				graphAr := image.Rect(6, 0, 20, 9)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{1, 28})
-				testdraw.MustBrailleLine(bc, image.Point{1, 28}, image.Point{2, 25})
-				testdraw.MustBrailleLine(bc, image.Point{2, 25}, image.Point{3, 23})
-				testdraw.MustBrailleLine(bc, image.Point{3, 23}, image.Point{4, 20})
-				testdraw.MustBrailleLine(bc, image.Point{4, 20}, image.Point{5, 17})
-				testdraw.MustBrailleLine(bc, image.Point{5, 17}, image.Point{6, 14})
-				testdraw.MustBrailleLine(bc, image.Point{6, 14}, image.Point{7, 12})
-				testdraw.MustBrailleLine(bc, image.Point{7, 12}, image.Point{8, 9})
-				testdraw.MustBrailleLine(bc, image.Point{8, 9}, image.Point{9, 6})
-				testdraw.MustBrailleLine(bc, image.Point{9, 6}, image.Point{10, 3})
-				testdraw.MustBrailleLine(bc, image.Point{10, 3}, image.Point{11, 0})
+				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 18})
+				testdraw.MustBrailleLine(bc, image.Point{13, 18}, image.Point{27, 0})
 				testbraille.MustCopyTo(bc, c)
 
 				testcanvas.MustApply(c, ft)
@@ -1157,21 +2706,33 @@ func TestLineChartDraws(t *testing.T) {
 			},
 		},
 		{
-			desc:   "highlights area for zoom",
-			canvas: image.Rect(0, 0, 20, 10),
+			desc: "hover tooltip disappears when a mouse button is pressed",
+			opts: []Option{
+				HoverTooltip(),
+			},
+			canvas: image.Rect(0, 0, 20, 11),
 			writes: func(lc *LineChart) error {
-				if err := lc.Series("first", []float64{0, 100}); err != nil {
+				if err := lc.Series("first", []float64{0, 50, 100}); err != nil {
 					return err
 				}
-				// Draw once so zoom tracker is initialized.
-				cvs := testcanvas.MustNew(image.Rect(0, 0, 20, 10))
+				// Draw once so the graph area is known.
+				cvs := testcanvas.MustNew(image.Rect(0, 0, 20, 11))
 				if err := lc.Draw(cvs, &widgetapi.Meta{}); err != nil {
 					return err
 				}
-				return lc.Mouse(&terminalapi.Mouse{
-					Position: image.Point{6, 5},
-					Button:   mouse.ButtonLeft,
-				}, &widgetapi.EventMeta{})
+				if err := lc.Mouse(&terminalapi.Mouse{
+					Position: image.Point{11, 5},
+					Button:   mouse.ButtonRelease,
+				}, &widgetapi.EventMeta{}); err != nil {
+					return err
+				}
+				if err := lc.Mouse(&terminalapi.Mouse{
+					Position: image.Point{11, 5},
+					Button:   mouse.ButtonRight,
+				}, &widgetapi.EventMeta{}); err != nil {
+					return err
+				}
+				return nil
 			},
 			wantCapacity: 28,
 			want: func(size image.Point) *faketerm.Terminal {
@@ -1180,49 +2741,52 @@ func TestLineChartDraws(t *testing.T) {
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
-					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
 				}
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "0", image.Point{4, 7})
-				testdraw.MustText(c, "51.68", image.Point{0, 3})
-				testdraw.MustText(c, "0", image.Point{6, 9})
-				testdraw.MustText(c, "1", image.Point{19, 9})
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{6, 10})
+				testdraw.MustText(c, "1", image.Point{12, 10})
+				testdraw.MustText(c, "2", image.Point{19, 10})
 
 				// Braille line.
-				graphAr := image.Rect(6, 0, 20, 8)
+				graphAr := image.Rect(6, 0, 20, 9)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{26, 0})
-
-				// Highlighted area for zoom.
-				testbraille.MustSetAreaCellOpts(bc, image.Rect(0, 0, 1, 8), cell.BgColor(cell.ColorNumber(235)))
-
+				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 18})
+				testdraw.MustBrailleLine(bc, image.Point{13, 18}, image.Point{27, 0})
 				testbraille.MustCopyTo(bc, c)
+
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
 		},
 		{
-			desc: "highlights area for zoom to a custom color",
+			desc: "no hover tooltip without the option",
 			opts: []Option{
-				ZoomHightlightColor(cell.ColorNumber(13)),
+				ZoomStepPercent(50),
 			},
-			canvas: image.Rect(0, 0, 20, 10),
+			canvas: image.Rect(0, 0, 20, 11),
 			writes: func(lc *LineChart) error {
-				if err := lc.Series("first", []float64{0, 100}); err != nil {
+				if err := lc.Series("first", []float64{0, 50, 100}); err != nil {
 					return err
 				}
-				// Draw once so zoom tracker is initialized.
-				cvs := testcanvas.MustNew(image.Rect(0, 0, 20, 10))
+				// Draw once so the graph area is known.
+				cvs := testcanvas.MustNew(image.Rect(0, 0, 20, 11))
 				if err := lc.Draw(cvs, &widgetapi.Meta{}); err != nil {
 					return err
 				}
-				return lc.Mouse(&terminalapi.Mouse{
-					Position: image.Point{6, 5},
-					Button:   mouse.ButtonLeft,
-				}, &widgetapi.EventMeta{})
+				if err := lc.Mouse(&terminalapi.Mouse{
+					Position: image.Point{11, 5},
+					Button:   mouse.ButtonRelease,
+				}, &widgetapi.EventMeta{}); err != nil {
+					return err
+				}
+				return nil
 			},
 			wantCapacity: 28,
 			want: func(size image.Point) *faketerm.Terminal {
@@ -1231,26 +2795,26 @@ func TestLineChartDraws(t *testing.T) {
 
 				// Y and X axis.
 				lines := []draw.HVLine{
-					{Start: image.Point{5, 0}, End: image.Point{5, 8}},
-					{Start: image.Point{5, 8}, End: image.Point{19, 8}},
+					{Start: image.Point{5, 0}, End: image.Point{5, 9}},
+					{Start: image.Point{5, 9}, End: image.Point{19, 9}},
 				}
 				testdraw.MustHVLines(c, lines)
 
 				// Value labels.
-				testdraw.MustText(c, "0", image.Point{4, 7})
-				testdraw.MustText(c, "51.68", image.Point{0, 3})
-				testdraw.MustText(c, "0", image.Point{6, 9})
-				testdraw.MustText(c, "1", image.Point{19, 9})
+				testdraw.MustText(c, "0", image.Point{4, 8})
+				testdraw.MustText(c, "45.76", image.Point{0, 4})
+				testdraw.MustText(c, "91.52", image.Point{0, 0})
+				testdraw.MustText(c, "0", image.Point{6, 10})
+				testdraw.MustText(c, "1", image.Point{12, 10})
+				testdraw.MustText(c, "2", image.Point{19, 10})
 
 				// Braille line.
-				graphAr := image.Rect(6, 0, 20, 8)
+				graphAr := image.Rect(6, 0, 20, 9)
 				bc := testbraille.MustNew(graphAr)
-				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{26, 0})
-
-				// Highlighted area for zoom.
-				testbraille.MustSetAreaCellOpts(bc, image.Rect(0, 0, 1, 8), cell.BgColor(cell.ColorNumber(13)))
-
+				testdraw.MustBrailleLine(bc, image.Point{0, 35}, image.Point{13, 18})
+				testdraw.MustBrailleLine(bc, image.Point{13, 18}, image.Point{27, 0})
 				testbraille.MustCopyTo(bc, c)
+
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
@@ -1641,6 +3205,57 @@ func TestLineChartDraws(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:   "ConnectNulls bridges the gap created by NaN values",
+			canvas: image.Rect(0, 0, 11, 10),
+			opts: []Option{
+				ConnectNulls(),
+			},
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{0, 1, 2, 3, 4, 5, 6, 7, math.NaN(), math.NaN(), math.NaN(), math.NaN(), 12, 13, 14, 15, 16, 17, 18, 19})
+			},
+			wantCapacity: 12,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{4, 0}, End: image.Point{4, 8}},
+					{Start: image.Point{4, 8}, End: image.Point{10, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{3, 7})
+				testdraw.MustText(c, "9.92", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{5, 9})
+				testdraw.MustText(c, "14", image.Point{9, 9})
+
+				// Braille line.
+				graphAr := image.Rect(5, 0, 11, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{1, 29})
+				testdraw.MustBrailleLine(bc, image.Point{1, 29}, image.Point{1, 28})
+				testdraw.MustBrailleLine(bc, image.Point{1, 28}, image.Point{2, 26})
+				testdraw.MustBrailleLine(bc, image.Point{2, 26}, image.Point{2, 25})
+				testdraw.MustBrailleLine(bc, image.Point{2, 25}, image.Point{3, 23})
+				testdraw.MustBrailleLine(bc, image.Point{3, 23}, image.Point{3, 21})
+				testdraw.MustBrailleLine(bc, image.Point{3, 21}, image.Point{4, 20})
+				testdraw.MustBrailleLine(bc, image.Point{4, 20}, image.Point{7, 12})
+				testdraw.MustBrailleLine(bc, image.Point{7, 12}, image.Point{8, 10})
+				testdraw.MustBrailleLine(bc, image.Point{8, 10}, image.Point{8, 8})
+				testdraw.MustBrailleLine(bc, image.Point{8, 8}, image.Point{9, 7})
+				testdraw.MustBrailleLine(bc, image.Point{9, 7}, image.Point{9, 5})
+				testdraw.MustBrailleLine(bc, image.Point{9, 5}, image.Point{10, 4})
+				testdraw.MustBrailleLine(bc, image.Point{10, 4}, image.Point{10, 2})
+				testdraw.MustBrailleLine(bc, image.Point{10, 2}, image.Point{11, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc:   "custom Y-axis labels using a value formatter",
 			canvas: image.Rect(0, 0, 20, 10),
@@ -1683,6 +3298,43 @@ func TestLineChartDraws(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:   "Y-axis labels with SI prefixes",
+			canvas: image.Rect(0, 0, 20, 10),
+			opts: []Option{
+				YAxisFormattedValues(ValueFormatterSI(0)),
+			},
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{0, 100000})
+			},
+			wantCapacity: 32,
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				// Y and X axis.
+				lines := []draw.HVLine{
+					{Start: image.Point{3, 0}, End: image.Point{3, 8}},
+					{Start: image.Point{3, 8}, End: image.Point{19, 8}},
+				}
+				testdraw.MustHVLines(c, lines)
+
+				// Value labels.
+				testdraw.MustText(c, "0", image.Point{2, 7})
+				testdraw.MustText(c, "52k", image.Point{0, 3})
+				testdraw.MustText(c, "0", image.Point{4, 9})
+				testdraw.MustText(c, "1", image.Point{19, 9})
+
+				// Braille line.
+				graphAr := image.Rect(4, 0, 20, 8)
+				bc := testbraille.MustNew(graphAr)
+				testdraw.MustBrailleLine(bc, image.Point{0, 31}, image.Point{30, 0})
+				testbraille.MustCopyTo(bc, c)
+
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc:   "custom Y-axis labels using a value formatter that returns empty labels",
 			canvas: image.Rect(0, 0, 20, 10),
@@ -1931,6 +3583,28 @@ func TestOptions(t *testing.T) {
 				WantMouse:   widgetapi.MouseScopeGlobal,
 			},
 		},
+		{
+			desc: "requests mouse motion events for the hover tooltip",
+			opts: []Option{
+				HoverTooltip(),
+			},
+			want: widgetapi.Options{
+				MinimumSize:   image.Point{3, 4},
+				WantMouse:     widgetapi.MouseScopeGlobal,
+				WantMouseMove: true,
+			},
+		},
+		{
+			desc: "requests mouse motion events for the crosshair",
+			opts: []Option{
+				Crosshair(),
+			},
+			want: widgetapi.Options{
+				MinimumSize:   image.Point{3, 4},
+				WantMouse:     widgetapi.MouseScopeGlobal,
+				WantMouseMove: true,
+			},
+		},
 		{
 			desc: "reserves space for longer Y labels",
 			addSeries: func(lc *LineChart) error {
@@ -1998,3 +3672,133 @@ func TestOptions(t *testing.T) {
 		})
 	}
 }
+
+// drawTerm draws the line chart on a new canvas of the provided size and
+// returns a fake terminal with the result.
+func drawTerm(lc *LineChart, size image.Point, meta *widgetapi.Meta) (*faketerm.Terminal, error) {
+	cvs, err := canvas.New(image.Rect(0, 0, size.X, size.Y))
+	if err != nil {
+		return nil, err
+	}
+	if err := lc.Draw(cvs, meta); err != nil {
+		return nil, err
+	}
+	ft, err := faketerm.New(size)
+	if err != nil {
+		return nil, err
+	}
+	if err := cvs.Apply(ft); err != nil {
+		return nil, err
+	}
+	return ft, nil
+}
+
+func TestDrawCache(t *testing.T) {
+	size := image.Point{30, 12}
+	tests := []struct {
+		desc string
+		// epochs are the epochs of the two calls to Draw.
+		epochs [2]uint64
+		// between is called between the two calls to Draw.
+		between func(lc *LineChart) error
+		// wantReused indicates if the second call to Draw is expected to
+		// reuse the axes computed by the first one.
+		wantReused bool
+	}{
+		{
+			desc:       "reuses the results within the same epoch",
+			epochs:     [2]uint64{1, 1},
+			wantReused: true,
+		},
+		{
+			desc:   "computes again in a new epoch",
+			epochs: [2]uint64{1, 2},
+		},
+		{
+			desc:   "computes again when the epoch is unknown",
+			epochs: [2]uint64{0, 0},
+		},
+		{
+			desc:   "computes again when the series change",
+			epochs: [2]uint64{1, 1},
+			between: func(lc *LineChart) error {
+				return lc.Series("second", []float64{-100, 200, 50})
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			lc, err := New()
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if err := lc.Series("first", []float64{0, 50, 100, 25}); err != nil {
+				t.Fatalf("Series => unexpected error: %v", err)
+			}
+
+			if _, err := drawTerm(lc, size, &widgetapi.Meta{Epoch: tc.epochs[0]}); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+			var firstXD *axes.XDetails
+			if lc.cache != nil {
+				firstXD = lc.cache.xd
+			}
+			if tc.between != nil {
+				if err := tc.between(lc); err != nil {
+					t.Fatalf("between => unexpected error: %v", err)
+				}
+			}
+			got, err := drawTerm(lc, size, &widgetapi.Meta{Epoch: tc.epochs[1]})
+			if err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			gotReused := lc.cache != nil && firstXD != nil && lc.cache.xd == firstXD
+			if gotReused != tc.wantReused {
+				t.Errorf("Draw => reused the cached axes: %v, want %v", gotReused, tc.wantReused)
+			}
+
+			// The result is the same as when drawing without the cache.
+			lc.cache = nil
+			want, err := drawTerm(lc, size, &widgetapi.Meta{})
+			if err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+			if diff := faketerm.Diff(want, got); diff != "" {
+				t.Errorf("Draw => %v", diff)
+			}
+		})
+	}
+}
+
+func BenchmarkDrawRepeated(b *testing.B) {
+	for _, bc := range []struct {
+		desc  string
+		epoch uint64
+	}{
+		{desc: "unknown epoch", epoch: 0},
+		{desc: "same epoch", epoch: 1},
+	} {
+		b.Run(bc.desc, func(b *testing.B) {
+			lc, err := New()
+			if err != nil {
+				b.Fatalf("New => unexpected error: %v", err)
+			}
+			if err := lc.Series("series", denseValues(50000)); err != nil {
+				b.Fatalf("Series => unexpected error: %v", err)
+			}
+			cvs, err := canvas.New(image.Rect(0, 0, 80, 20))
+			if err != nil {
+				b.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := lc.Draw(cvs, &widgetapi.Meta{Epoch: bc.epoch}); err != nil {
+					b.Fatalf("Draw => unexpected error: %v", err)
+				}
+			}
+		})
+	}
+}
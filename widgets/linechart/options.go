@@ -15,10 +15,12 @@
 package linechart
 
 import (
+	"errors"
 	"fmt"
 	"math"
 
 	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/private/runewidth"
 	"github.com/mum4k/termdash/widgets/linechart/internal/axes"
 	"github.com/mum4k/termdash/widgets/linechart/internal/zoom"
 )
@@ -41,8 +43,22 @@ type options struct {
 	yAxisMode           axes.YScaleMode
 	yAxisCustomScale    *customScale
 	yAxisValueFormatter ValueFormatter
+	xAxisTimeFormat     string
+	hoverTooltip        bool
+	hoverTooltipOpts    []cell.Option
+	crosshair           bool
+	crosshairHorizontal bool
+	crosshairOpts       []cell.Option
+	crosshairLabelOpts  []cell.Option
+	zeroLine            bool
+	zeroLineOpts        []cell.Option
 	zoomHightlightColor cell.Color
 	zoomStepPercent     int
+	yTicks              int
+	xLabelSkip          int
+	highlightLast       *pointMarker
+	clampToScale        bool
+	connectNulls        bool
 }
 
 // validate validates the provided options.
@@ -55,15 +71,33 @@ func (o *options) validate() error {
 			return fmt.Errorf("the min(%v) must be less than the max(%v) provided as custom Y scale", o.yAxisCustomScale.min, o.yAxisCustomScale.max)
 		}
 	}
+	if o.xAxisTimeFormat == "" {
+		return errors.New("the layout provided as XAxisTimeFormat cannot be empty")
+	}
 	if got, min, max := o.zoomStepPercent, 1, 100; got < min || got > max {
 		return fmt.Errorf("invalid ZoomStepPercent %d, must be in range %d <= value <= %d", got, min, max)
 	}
+	if got := o.yTicks; got != 0 && got < 2 {
+		return fmt.Errorf("invalid YTicks %d, must be zero or at least 2", got)
+	}
+	if got := o.xLabelSkip; got < 0 {
+		return fmt.Errorf("invalid XLabelSkip %d, must be zero or a positive integer", got)
+	}
+	if o.highlightLast != nil {
+		if got := runewidth.RuneWidth(o.highlightLast.r); got != 1 {
+			return fmt.Errorf("invalid rune %q provided in HighlightLast, must be a half-width rune, got width %d", o.highlightLast.r, got)
+		}
+	}
 	return nil
 }
 
 // newOptions returns a new options instance.
 func newOptions(opts ...Option) *options {
 	opt := &options{
+		xAxisTimeFormat:     DefaultXAxisTimeFormat,
+		hoverTooltipOpts:    []cell.Option{cell.Inverse()},
+		crosshairOpts:       []cell.Option{cell.Dim()},
+		crosshairLabelOpts:  []cell.Option{cell.Inverse()},
 		zoomHightlightColor: cell.ColorNumber(235),
 		zoomStepPercent:     zoom.DefaultScrollStep,
 	}
@@ -103,6 +137,19 @@ func XLabelsVertical() Option {
 	})
 }
 
+// XLabelSkip places a label under the X axis only on every nth value, i.e.
+// on the first displayed value and then on every nth value after it. This
+// avoids crowded labels when the graph displays many values. Labels that
+// still wouldn't fit next to each other are thinned further, according to
+// their width.
+// Must be zero or a positive integer, zero and one place a label on every
+// value that fits, which is the default.
+func XLabelSkip(n int) Option {
+	return option(func(opts *options) {
+		opts.xLabelSkip = n
+	})
+}
+
 // XLabelsHorizontal makes the labels under the X axis flow horizontally.
 // This is the default option.
 func XLabelsHorizontal() Option {
@@ -118,6 +165,19 @@ func YLabelCellOpts(co ...cell.Option) Option {
 	})
 }
 
+// YTicks requests approximately n labels on the Y axis, evenly spaced
+// between the bottom and the top of the graph. The labels display the values
+// of the rows of the braille scale they are placed on. If the graph doesn't
+// have n rows, a label is placed on every row. Labels that would display the
+// same value are omitted. This also applies to the secondary Y axis, if any.
+// Must be zero or at least two. If not set, or set to zero, a label is placed
+// on every fourth row.
+func YTicks(n int) Option {
+	return option(func(opts *options) {
+		opts.yTicks = n
+	})
+}
+
 // YAxisAdaptive makes the Y axis adapt its base value depending on the
 // provided series.
 // Without this option, the Y axis always starts at the zero value regardless of
@@ -145,7 +205,8 @@ type customScale struct {
 // value from the series before drawing the LineChart.
 // Even when this option is provided, the LineChart would still rescale the Y
 // axis if a value is encountered that is outside of the range specified here,
-// i.e. smaller than the minimum or larger than the maximum.
+// i.e. smaller than the minimum or larger than the maximum, unless the
+// ClampToScale option is provided.
 // Both the minimum and the maximum must be valid numbers and the minimum must
 // be smaller than the maximum.
 //
@@ -160,6 +221,27 @@ func YAxisCustomScale(min, max float64) Option {
 	})
 }
 
+// The default runes for the ClampToScale option.
+const (
+	DefaultClampAboveRune = '⇧'
+	DefaultClampBelowRune = '⇩'
+)
+
+// ClampToScale when provided together with YAxisCustomScale, keeps the scale
+// of the Y axis fixed to the specified minimum and maximum even if a value is
+// encountered that is outside of that range. Values larger than the maximum
+// are drawn at the top edge of the graph and marked with the
+// DefaultClampAboveRune in the top row, values smaller than the minimum are
+// drawn at the bottom edge and marked with the DefaultClampBelowRune in the
+// bottom row. The markers use the cell options provided via SeriesCellOpts.
+// Has no effect on series assigned to the secondary Y axis or without the
+// YAxisCustomScale option.
+func ClampToScale() Option {
+	return option(func(opts *options) {
+		opts.clampToScale = true
+	})
+}
+
 // XAxisUnscaled when provided, stops the LineChart from rescaling the X axis
 // when it can't fit all the values in the series, instead the LineCharts only
 // displays the last n values that fit into its width. This is useful to create
@@ -177,6 +259,108 @@ func XAxisUnscaled() Option {
 	})
 }
 
+// DefaultXAxisTimeFormat is the default value for the XAxisTimeFormat option.
+const DefaultXAxisTimeFormat = "15:04:05"
+
+// XAxisTimeFormat sets the layout used to format the timestamps provided via
+// the SeriesTimestamps option into labels under the X axis.
+// The layout is in the format accepted by time.Time.Format.
+// Defaults to DefaultXAxisTimeFormat.
+func XAxisTimeFormat(layout string) Option {
+	return option(func(opts *options) {
+		opts.xAxisTimeFormat = layout
+	})
+}
+
+// HoverTooltip enables a tooltip that displays the X and Y value of the data
+// point nearest to the mouse cursor while the mouse hovers over the graph.
+// The tooltip is drawn next to the cursor on the next redraw and disappears
+// when the mouse leaves the graph or a mouse button is pressed.
+// The cell options are applied to the tooltip, defaults to inverse colors.
+func HoverTooltip(co ...cell.Option) Option {
+	return option(func(opts *options) {
+		opts.hoverTooltip = true
+		if len(co) > 0 {
+			opts.hoverTooltipOpts = co
+		}
+	})
+}
+
+// Crosshair enables a crosshair that follows the mouse cursor while the mouse
+// hovers over the graph. A vertical line is drawn across the graph at the
+// column of the mouse cursor and the Y value of the data point nearest to the
+// cursor is labeled on the Y axis the point is projected against, at the row
+// of the point. The line is only drawn in cells that don't contain any part
+// of the series, so it doesn't obscure the data.
+// The crosshair is drawn on the next redraw and disappears when the mouse
+// leaves the graph or a mouse button is pressed.
+// The cell options are applied to the line, defaults to dim (faint) text. Use
+// CrosshairLabelCellOpts to change the cell options of the label.
+func Crosshair(co ...cell.Option) Option {
+	return option(func(opts *options) {
+		opts.crosshair = true
+		if len(co) > 0 {
+			opts.crosshairOpts = co
+		}
+	})
+}
+
+// CrosshairLabelCellOpts set the cell options for the label of the nearest
+// value drawn on the Y axis by the crosshair. Defaults to inverse colors.
+// Has no effect unless the Crosshair option is also provided.
+func CrosshairLabelCellOpts(co ...cell.Option) Option {
+	return option(func(opts *options) {
+		opts.crosshairLabelOpts = co
+	})
+}
+
+// CrosshairHorizontal additionally draws a horizontal line across the graph
+// at the row of the mouse cursor.
+// Has no effect unless the Crosshair option is also provided.
+func CrosshairHorizontal() Option {
+	return option(func(opts *options) {
+		opts.crosshairHorizontal = true
+	})
+}
+
+// ZeroLine draws a horizontal line across the graph at the value zero on the
+// Y axis, so it is easier to tell positive values from negative ones. The line
+// is only drawn while zero is within the scale of the Y axis and is drawn
+// under the series. The secondary Y axis doesn't have a zero line.
+// The cell options are applied to the line, defaults to the cell options
+// provided via AxesCellOpts.
+func ZeroLine(co ...cell.Option) Option {
+	return option(func(opts *options) {
+		opts.zeroLine = true
+		opts.zeroLineOpts = co
+	})
+}
+
+// HighlightLast draws the provided rune at the position of the last value in
+// each series, e.g. to mark the most recent value of streaming data. The
+// marker moves as new values are added and isn't drawn while the last value
+// is outside of the zoomed view. Missing (NaN) values at the end of a series
+// are skipped over. The marker is drawn over any SeriesPointMarker.
+// The cell options are applied to the marker cell, if none are provided the
+// marker uses the cell options provided via SeriesCellOpts.
+func HighlightLast(r rune, co ...cell.Option) Option {
+	return option(func(opts *options) {
+		opts.highlightLast = &pointMarker{
+			r:        r,
+			cellOpts: co,
+		}
+	})
+}
+
+// ConnectNulls bridges the gaps created by missing (NaN) values in the
+// series, i.e. the values on both sides of the missing values are connected by
+// a line. By default the line is broken at the missing values.
+func ConnectNulls() Option {
+	return option(func(opts *options) {
+		opts.connectNulls = true
+	})
+}
+
 // ZoomHightlightColor sets the background color of the area that is selected
 // with mouse in order to zoom the linechart.
 // Defaults to color number 235.
@@ -0,0 +1,118 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linechart
+
+// snapshot.go contains code that exports the data displayed by the LineChart.
+
+// Snapshot is a point in time copy of the data displayed by the LineChart.
+// The caller owns the snapshot and can serialize or modify it freely.
+type Snapshot struct {
+	// Series are the values of the series keyed by the names of the series.
+	// The values of series projected against the secondary Y axis are
+	// included.
+	Series map[string][]float64
+
+	// XMin and XMax are the positions of the first and the last value that
+	// were visible on the X axis, both inclusive. These reflect zooming as
+	// observed on the last call to Draw. If Draw wasn't called yet, they span
+	// all the values.
+	XMin, XMax int
+
+	// YMin and YMax are the smallest and the largest value that determine the
+	// scale of the Y axis. These include the YAxisCustomScale if provided.
+	YMin, YMax float64
+
+	// Y2Min and Y2Max are the smallest and the largest value that determine
+	// the scale of the secondary Y axis. Both are zero if none of the series
+	// uses the secondary Y axis.
+	Y2Min, Y2Max float64
+}
+
+// SnapshotOption is used to provide options to Snapshot.
+type SnapshotOption interface {
+	// set sets the provided option.
+	set(*snapshotOptions)
+}
+
+// snapshotOptions stores the provided snapshot options.
+type snapshotOptions struct {
+	visibleOnly bool
+}
+
+// snapshotOption implements SnapshotOption.
+type snapshotOption func(*snapshotOptions)
+
+// set implements SnapshotOption.set.
+func (so snapshotOption) set(opts *snapshotOptions) {
+	so(opts)
+}
+
+// SnapshotVisibleOnly limits the values of the series in the snapshot to
+// those between XMin and XMax, i.e. to the values visible on the last call to
+// Draw. The first value of each series in the snapshot is then the value at
+// position XMin.
+func SnapshotVisibleOnly() SnapshotOption {
+	return snapshotOption(func(opts *snapshotOptions) {
+		opts.visibleOnly = true
+	})
+}
+
+// Snapshot returns a copy of the series values and of the current scale of
+// the axes.
+func (lc *LineChart) Snapshot(opts ...SnapshotOption) *Snapshot {
+	lc.mu.RLock()
+	defer lc.mu.RUnlock()
+
+	so := &snapshotOptions{}
+	for _, opt := range opts {
+		opt.set(so)
+	}
+
+	s := &Snapshot{
+		Series: make(map[string][]float64, len(lc.series)),
+		XMax:   lc.maxXValue(),
+		YMin:   lc.yMin,
+		YMax:   lc.yMax,
+		Y2Min:  lc.y2Min,
+		Y2Max:  lc.y2Max,
+	}
+	if lc.lastXD != nil {
+		s.XMin = int(lc.lastXD.Scale.Min.Value)
+		s.XMax = int(lc.lastXD.Scale.Max.Value)
+	}
+
+	for name, sv := range lc.series {
+		values := sv.values
+		if so.visibleOnly {
+			values = visibleValues(values, s.XMin, s.XMax)
+		}
+		v := make([]float64, len(values))
+		copy(v, values)
+		s.Series[name] = v
+	}
+	return s
+}
+
+// visibleValues returns the values at positions between min and max, both
+// inclusive.
+func visibleValues(values []float64, min, max int) []float64 {
+	if min >= len(values) {
+		return nil
+	}
+	if max >= len(values) {
+		max = len(values) - 1
+	}
+	return values[min : max+1]
+}
@@ -0,0 +1,207 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linechart
+
+import (
+	"image"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/mum4k/termdash/mouse"
+	"github.com/mum4k/termdash/private/canvas/testcanvas"
+	"github.com/mum4k/termdash/terminal/terminalapi"
+	"github.com/mum4k/termdash/widgetapi"
+)
+
+func TestSnapshot(t *testing.T) {
+	tests := []struct {
+		desc     string
+		opts     []Option
+		writes   func(*LineChart) error
+		snapOpts []SnapshotOption
+		want     *Snapshot
+	}{
+		{
+			desc: "empty line chart",
+			want: &Snapshot{
+				Series: map[string][]float64{},
+			},
+		},
+		{
+			desc: "series before the first draw",
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{1, 2, 3}); err != nil {
+					return err
+				}
+				return lc.Series("second", []float64{-1, 5})
+			},
+			want: &Snapshot{
+				Series: map[string][]float64{
+					"first":  {1, 2, 3},
+					"second": {-1, 5},
+				},
+				XMax: 2,
+				YMin: -1,
+				YMax: 5,
+			},
+		},
+		{
+			desc: "includes the custom Y scale",
+			opts: []Option{
+				YAxisCustomScale(-10, 10),
+			},
+			writes: func(lc *LineChart) error {
+				return lc.Series("first", []float64{1, 2, 3})
+			},
+			want: &Snapshot{
+				Series: map[string][]float64{
+					"first": {1, 2, 3},
+				},
+				XMax: 2,
+				YMin: -10,
+				YMax: 10,
+			},
+		},
+		{
+			desc: "includes the secondary Y scale",
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{1, 2, 3}); err != nil {
+					return err
+				}
+				return lc.Series("second", []float64{100, 200}, SeriesSecondaryAxis())
+			},
+			want: &Snapshot{
+				Series: map[string][]float64{
+					"first":  {1, 2, 3},
+					"second": {100, 200},
+				},
+				XMax:  2,
+				YMin:  1,
+				YMax:  3,
+				Y2Min: 100,
+				Y2Max: 200,
+			},
+		},
+		{
+			desc: "visible range after a draw without zoom",
+			writes: func(lc *LineChart) error {
+				if err := lc.Series("first", []float64{0, 25, 75, 100}); err != nil {
+					return err
+				}
+				return lc.Draw(testcanvas.MustNew(image.Rect(0, 0, 20, 10)), &widgetapi.Meta{})
+			},
+			snapOpts: []SnapshotOption{SnapshotVisibleOnly()},
+			want: &Snapshot{
+				Series: map[string][]float64{
+					"first": {0, 25, 75, 100},
+				},
+				XMax: 3,
+				YMax: 100,
+			},
+		},
+		{
+			desc: "all values after zoom",
+			opts: []Option{
+				ZoomStepPercent(50),
+			},
+			writes: zoomIn,
+			want: &Snapshot{
+				Series: map[string][]float64{
+					"first":  {0, 25, 75, 100},
+					"second": {10, 20},
+				},
+				XMin: 1,
+				XMax: 3,
+				YMax: 100,
+			},
+		},
+		{
+			desc: "only visible values after zoom",
+			opts: []Option{
+				ZoomStepPercent(50),
+			},
+			writes:   zoomIn,
+			snapOpts: []SnapshotOption{SnapshotVisibleOnly()},
+			want: &Snapshot{
+				Series: map[string][]float64{
+					"first":  {25, 75, 100},
+					"second": {20},
+				},
+				XMin: 1,
+				XMax: 3,
+				YMax: 100,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			lc, err := New(tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if tc.writes != nil {
+				if err := tc.writes(lc); err != nil {
+					t.Fatalf("writes => unexpected error: %v", err)
+				}
+			}
+
+			got := lc.Snapshot(tc.snapOpts...)
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("Snapshot => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// zoomIn writes series into the line chart, draws it and zooms in using the
+// mouse scroll button.
+func zoomIn(lc *LineChart) error {
+	if err := lc.Series("first", []float64{0, 25, 75, 100}); err != nil {
+		return err
+	}
+	if err := lc.Series("second", []float64{10, 20}); err != nil {
+		return err
+	}
+	cvs := testcanvas.MustNew(image.Rect(0, 0, 20, 10))
+	if err := lc.Draw(cvs, &widgetapi.Meta{}); err != nil {
+		return err
+	}
+	if err := lc.Mouse(&terminalapi.Mouse{
+		Position: image.Point{17, 5},
+		Button:   mouse.ButtonWheelUp,
+	}, &widgetapi.EventMeta{}); err != nil {
+		return err
+	}
+	return lc.Draw(cvs, &widgetapi.Meta{})
+}
+
+func TestSnapshotIsACopy(t *testing.T) {
+	lc, err := New()
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+	if err := lc.Series("first", []float64{1, 2, 3}); err != nil {
+		t.Fatalf("Series => unexpected error: %v", err)
+	}
+
+	s := lc.Snapshot()
+	s.Series["first"][0] = 100
+
+	want := []float64{1, 2, 3}
+	if diff := pretty.Compare(want, lc.Snapshot().Series["first"]); diff != "" {
+		t.Errorf("Snapshot => unexpected diff after modifying a previous snapshot (-want, +got):\n%s", diff)
+	}
+}
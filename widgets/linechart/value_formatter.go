@@ -144,3 +144,41 @@ func valueFormatterSuffixWithTransformer(decimals int, suffix string, transformF
 		return fmt.Sprintf(dFmt, value)
 	}
 }
+
+// siPrefixes are the SI prefixes used by ValueFormatterSI, each one is
+// thousand times larger than the previous one.
+var siPrefixes = []string{"", "k", "M", "G", "T", "P", "E"}
+
+// ValueFormatterSI is a factory that returns a formatter that will receive a
+// float64 value and return its representation with an SI prefix, e.g. 1500
+// becomes "1.5k" with one decimal and 2000000 becomes "2M" without decimals.
+// Values smaller than a thousand are returned without a prefix.
+// If the received decimal value is negative it will fallback to a 0 decimal
+// value.
+// The result value formatter handles NaN values, if the value formatter
+// receives a NaN float64 it will return an empty string.
+func ValueFormatterSI(decimals int) ValueFormatter {
+	if decimals < 0 {
+		decimals = 0
+	}
+
+	return func(value float64) string {
+		if math.IsNaN(value) {
+			return ""
+		}
+
+		// Values are rounded to the decimals before choosing the prefix, so
+		// that e.g. 999.96 with one decimal becomes "1.0k" and not "1000.0".
+		pow := math.Pow(10, float64(decimals))
+		rounded := func(v float64) float64 {
+			return math.Round(v*pow) / pow
+		}
+
+		i := 0
+		for math.Abs(rounded(value)) >= 1000 && i < len(siPrefixes)-1 {
+			value /= 1000
+			i++
+		}
+		return fmt.Sprintf(suffixDecimalFormat(decimals, siPrefixes[i]), value)
+	}
+}
@@ -227,6 +227,66 @@ func TestFormatters(t *testing.T) {
 			formatter: ValueFormatterRoundWithSuffix("%"),
 			want:      "97%",
 		},
+		{
+			desc:      "SI formatter handles NaN values",
+			value:     math.NaN(),
+			formatter: ValueFormatterSI(1),
+			want:      "",
+		},
+		{
+			desc:      "SI formatter handles 0 values",
+			value:     0,
+			formatter: ValueFormatterSI(1),
+			want:      "0.0",
+		},
+		{
+			desc:      "SI formatter doesn't add prefix to values below thousand",
+			value:     999.4,
+			formatter: ValueFormatterSI(0),
+			want:      "999",
+		},
+		{
+			desc:      "SI formatter handles thousands",
+			value:     1500,
+			formatter: ValueFormatterSI(1),
+			want:      "1.5k",
+		},
+		{
+			desc:      "SI formatter handles millions",
+			value:     2000000,
+			formatter: ValueFormatterSI(0),
+			want:      "2M",
+		},
+		{
+			desc:      "SI formatter handles billions",
+			value:     3250000000,
+			formatter: ValueFormatterSI(2),
+			want:      "3.25G",
+		},
+		{
+			desc:      "SI formatter handles minus values",
+			value:     -1500,
+			formatter: ValueFormatterSI(1),
+			want:      "-1.5k",
+		},
+		{
+			desc:      "SI formatter switches the prefix when the value rounds up",
+			value:     999960,
+			formatter: ValueFormatterSI(1),
+			want:      "1.0M",
+		},
+		{
+			desc:      "SI formatter uses the largest prefix for huge values",
+			value:     5e21,
+			formatter: ValueFormatterSI(0),
+			want:      "5000E",
+		},
+		{
+			desc:      "SI formatter with negative decimals",
+			value:     1500,
+			formatter: ValueFormatterSI(-1),
+			want:      "2k",
+		},
 	}
 
 	for _, tc := range tests {
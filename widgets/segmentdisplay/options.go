@@ -62,7 +62,10 @@ func newOptions() *options {
 }
 
 // AlignHorizontal sets the horizontal alignment for the individual display
-// segments. Defaults to alignment in the center.
+// segments. This also applies when the text is shorter than the number of
+// segments that fit onto the canvas, e.g. a right aligned counter keeps its
+// last digit at the right edge of the canvas.
+// Defaults to alignment in the center.
 func AlignHorizontal(h align.Horizontal) Option {
 	return option(func(opts *options) {
 		opts.hAlign = h
@@ -36,13 +36,22 @@ type segArea struct {
 	gaps int
 }
 
-// needArea returns the complete area required for all the segments that we can
-// fit and any gaps.
-func (sa *segArea) needArea() image.Rectangle {
+// needArea returns the complete area required for the segments of a text of
+// the provided length and any gaps. Only accounts for the segments that we can
+// fit, so that shorter texts can be aligned within the canvas.
+func (sa *segArea) needArea(textLen int) image.Rectangle {
+	segments := textLen
+	if segments > sa.canFit {
+		segments = sa.canFit
+	}
+	gaps := sa.gaps
+	if segments > 0 && gaps > segments-1 {
+		gaps = segments - 1
+	}
 	return image.Rect(
 		0,
 		0,
-		sa.segment.Dx()*sa.canFit+sa.gaps*sa.gapPixels,
+		sa.segment.Dx()*segments+gaps*sa.gapPixels,
 		sa.segment.Dy(),
 	)
 }
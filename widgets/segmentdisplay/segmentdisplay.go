@@ -219,7 +219,7 @@ func (sd *SegmentDisplay) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 	}
 
 	text := sd.buff.String()
-	aligned, err := alignfor.Rectangle(cvs.Area(), segAr.needArea(), sd.opts.hAlign, sd.opts.vAlign)
+	aligned, err := alignfor.Rectangle(cvs.Area(), segAr.needArea(sd.buff.Len()), sd.opts.hAlign, sd.opts.vAlign)
 	if err != nil {
 		return fmt.Errorf("alignfor.Rectangle => %v", err)
 	}
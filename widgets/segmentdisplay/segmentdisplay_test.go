@@ -593,6 +593,68 @@ func TestSegmentDisplay(t *testing.T) {
 			},
 			wantCapacity: 1,
 		},
+		{
+			desc: "centers text shorter than the capacity by default",
+			opts: []Option{
+				GapPercent(0),
+			},
+			canvas: image.Rect(0, 0, 3*segdisp.MinCols, segdisp.MinRows),
+			update: func(sd *SegmentDisplay) error {
+				return sd.Write([]*TextChunk{NewChunk("8")})
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				mustDrawChar(cvs, '8', image.Rect(segdisp.MinCols, 0, 2*segdisp.MinCols, segdisp.MinRows))
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCapacity: 3,
+		},
+		{
+			desc: "aligns text shorter than the capacity on the left with option",
+			opts: []Option{
+				AlignHorizontal(align.HorizontalLeft),
+				GapPercent(0),
+			},
+			canvas: image.Rect(0, 0, 3*segdisp.MinCols, segdisp.MinRows),
+			update: func(sd *SegmentDisplay) error {
+				return sd.Write([]*TextChunk{NewChunk("8")})
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				mustDrawChar(cvs, '8', image.Rect(0, 0, segdisp.MinCols, segdisp.MinRows))
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCapacity: 3,
+		},
+		{
+			desc: "aligns text shorter than the capacity on the right with option",
+			opts: []Option{
+				AlignHorizontal(align.HorizontalRight),
+			},
+			canvas: image.Rect(0, 0, 3*segdisp.MinCols+2, segdisp.MinRows),
+			update: func(sd *SegmentDisplay) error {
+				return sd.Write([]*TextChunk{NewChunk("12")})
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				mustDrawChar(cvs, '1', image.Rect(segdisp.MinCols+1, 0, 2*segdisp.MinCols+1, segdisp.MinRows))
+				mustDrawChar(cvs, '2', image.Rect(2*segdisp.MinCols+2, 0, 3*segdisp.MinCols+2, segdisp.MinRows))
+
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+			wantCapacity: 3,
+		},
 		{
 			desc: "draws multiple segments, not enough space, maximizes segment height with option",
 			opts: []Option{
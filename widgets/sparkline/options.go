@@ -38,10 +38,15 @@ func (o option) set(opts *options) {
 
 // options holds the provided options.
 type options struct {
-	label         string
-	labelCellOpts []cell.Option
-	height        int
-	color         cell.Color
+	label          string
+	labelCellOpts  []cell.Option
+	height         int
+	color          cell.Color
+	braille        bool
+	showCurrent    bool
+	currentFormat  string
+	currentOpts    []cell.Option
+	colorThreshold *colorThreshold
 }
 
 // newOptions returns options with the default values set.
@@ -56,6 +61,9 @@ func (o *options) validate() error {
 	if got, min := o.height, 0; got < min {
 		return fmt.Errorf("invalid Height %d, must be %d <= Height", got, min)
 	}
+	if o.showCurrent && o.currentFormat == "" {
+		return fmt.Errorf("invalid ShowCurrent format %q, cannot be empty", o.currentFormat)
+	}
 	return nil
 }
 
@@ -70,6 +78,8 @@ func Label(text string, cOpts ...cell.Option) Option {
 // Height sets a fixed height for the SparkLine.
 // If not provided or set to zero, the SparkLine takes all the available
 // vertical space in the container. Must be a positive or zero integer.
+// When displaying multiple series added via AddSeries, this is the height of
+// each of the series, not including their labels.
 func Height(h int) Option {
 	return option(func(opts *options) {
 		opts.height = h
@@ -86,3 +96,108 @@ func Color(c cell.Color) Option {
 		opts.color = c
 	})
 }
+
+// Braille draws the bars using braille dots instead of block characters.
+// Each cell then displays two data points, each of them with a resolution of
+// four dots per cell vertically, while the block characters display one data
+// point per cell with eight levels per cell. This doubles the number of data
+// points visible on narrow SparkLines.
+// Defaults to drawing the bars with block characters.
+func Braille() Option {
+	return option(func(opts *options) {
+		opts.braille = true
+	})
+}
+
+// ShowCurrent displays the last data point formatted according to the
+// provided format at the end of the bottom line of the SparkLine, e.g. "%d".
+// The format must contain exactly one verb that accepts an int. The cells
+// needed for the formatted value and one cell separating it from the bars are
+// reserved on the right, i.e. the bars are plotted in the remaining width.
+// Nothing is displayed or reserved until the SparkLine has data points.
+// When displaying multiple series added via AddSeries, each of the series
+// displays its own last data point.
+// The provided cell options are applied to the formatted value.
+func ShowCurrent(format string, cOpts ...cell.Option) Option {
+	return option(func(opts *options) {
+		opts.showCurrent = true
+		opts.currentFormat = format
+		opts.currentOpts = cOpts
+	})
+}
+
+// colorThreshold holds the values provided to the ColorThreshold option.
+type colorThreshold struct {
+	value int
+	below cell.Color
+	above cell.Color
+}
+
+// color returns the color of the bar for the data point.
+func (ct *colorThreshold) color(v int) cell.Color {
+	if v > ct.value {
+		return ct.above
+	}
+	return ct.below
+}
+
+// ColorThreshold colors each bar of the SparkLine based on its data point,
+// bars of data points larger than the value get the above color and the
+// remaining bars get the below color, e.g. to highlight anomalies. With the
+// Braille option each cell displays two data points and gets the color of
+// the larger one.
+// Overrides the Color option and the SeriesColor option of all the series.
+func ColorThreshold(value int, below, above cell.Color) Option {
+	return option(func(opts *options) {
+		opts.colorThreshold = &colorThreshold{
+			value: value,
+			below: below,
+			above: above,
+		}
+	})
+}
+
+// SeriesOption is used to provide options to AddSeries.
+type SeriesOption interface {
+	// set sets the provided option.
+	set(*seriesOptions)
+}
+
+// seriesOption implements SeriesOption.
+type seriesOption func(*seriesOptions)
+
+// set implements SeriesOption.set.
+func (so seriesOption) set(opts *seriesOptions) {
+	so(opts)
+}
+
+// seriesOptions holds the provided options for one series.
+type seriesOptions struct {
+	labelCellOpts []cell.Option
+	color         cell.Color
+}
+
+// newSeriesOptions returns series options with the default values taken from
+// the options of the SparkLine.
+func newSeriesOptions(opts *options) *seriesOptions {
+	return &seriesOptions{
+		labelCellOpts: opts.labelCellOpts,
+		color:         opts.color,
+	}
+}
+
+// SeriesColor sets the color of the series.
+// Defaults to the color of the SparkLine, see the Color option.
+func SeriesColor(c cell.Color) SeriesOption {
+	return seriesOption(func(opts *seriesOptions) {
+		opts.color = c
+	})
+}
+
+// SeriesLabelCellOpts sets the cell options of the label of the series.
+// Defaults to the cell options provided to the Label option.
+func SeriesLabelCellOpts(cOpts ...cell.Option) SeriesOption {
+	return seriesOption(func(opts *seriesOptions) {
+		opts.labelCellOpts = cOpts
+	})
+}
@@ -19,12 +19,15 @@ import (
 	"errors"
 	"fmt"
 	"image"
+	"sort"
 	"sync"
 
 	"github.com/mum4k/termdash/cell"
 	"github.com/mum4k/termdash/private/area"
 	"github.com/mum4k/termdash/private/canvas"
+	"github.com/mum4k/termdash/private/canvas/braille"
 	"github.com/mum4k/termdash/private/draw"
+	"github.com/mum4k/termdash/private/runewidth"
 	"github.com/mum4k/termdash/terminal/terminalapi"
 	"github.com/mum4k/termdash/widgetapi"
 )
@@ -34,12 +37,20 @@ import (
 // Bars can have sub-cell height. The graphs scale adjusts dynamically based on
 // the largest visible value.
 //
+// The SparkLine can also display multiple labeled series stacked on top of
+// each other, see AddSeries.
+//
 // Implements widgetapi.Widget. This object is thread-safe.
 type SparkLine struct {
 	// data are the data points the SparkLine displays.
 	data []int
+	// series are the stacked series added via AddSeries in the order they
+	// were added.
+	series []*series
 
-	// lastWidth is the width of the canvas as of the last time when Draw was called.
+	// lastWidth is the width of the canvas available to the bars as of the
+	// last time when Draw was called, i.e. without the cells reserved for the
+	// ShowCurrent option.
 	lastWidth int
 
 	// mu protects the SparkLine.
@@ -70,7 +81,10 @@ func (sl *SparkLine) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 	sl.mu.Lock()
 	defer sl.mu.Unlock()
 
-	sl.lastWidth = cvs.Area().Dx()
+	sl.lastWidth = cvs.Area().Dx() - sl.currentWidth()
+	if sl.lastWidth < 0 {
+		sl.lastWidth = 0
+	}
 	needAr, err := area.FromSize(sl.minSize())
 	if err != nil {
 		return err
@@ -79,8 +93,57 @@ func (sl *SparkLine) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 		return draw.ResizeNeeded(cvs)
 	}
 
+	if len(sl.series) > 0 {
+		return sl.drawSeries(cvs)
+	}
+
 	ar := sl.area(cvs)
-	visible, max := visibleMax(sl.data, ar.Dx())
+	if err := sl.drawSparks(cvs, ar, sl.data, sl.opts.color); err != nil {
+		return err
+	}
+
+	if sl.opts.label != "" {
+		// Label is placed immediately above the SparkLine.
+		lStart := image.Point{ar.Min.X, ar.Min.Y - 1}
+		if err := drawLabel(cvs, sl.opts.label, lStart, sl.opts.labelCellOpts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drawSparks draws the data points as vertical bars in the area of the canvas
+// using either block characters or braille dots, depending on the options.
+// If the ShowCurrent option was provided, the last data point is displayed at
+// the end of the area and the bars are drawn in the remaining width.
+// The color is used for the bars unless the ColorThreshold option was
+// provided.
+func (sl *SparkLine) drawSparks(cvs *canvas.Canvas, ar image.Rectangle, data []int, color cell.Color) error {
+	if cur := sl.currentValue(data); cur != "" {
+		curWidth := runewidth.StringWidth(cur)
+		start := image.Point{ar.Max.X - curWidth, ar.Max.Y - 1}
+		if err := draw.Text(cvs, cur, start, draw.TextCellOpts(sl.opts.currentOpts...)); err != nil {
+			return err
+		}
+		ar.Max.X -= curWidth + 1 // One cell separates the value from the bars.
+	}
+
+	colorFn := func(int) cell.Color { return color }
+	if ct := sl.opts.colorThreshold; ct != nil {
+		colorFn = ct.color
+	}
+	if sl.opts.braille {
+		return drawBrailleSparks(cvs, ar, data, colorFn)
+	}
+	return drawBlockSparks(cvs, ar, data, colorFn)
+}
+
+// drawBlockSparks draws the data points as vertical bars made of block
+// characters in the area of the canvas. The bars are scaled to the largest
+// visible data point. The colorFn returns the color of the bar for a data
+// point.
+func drawBlockSparks(cvs *canvas.Canvas, ar image.Rectangle, data []int, colorFn func(int) cell.Color) error {
+	visible, max := visibleMax(data, ar.Dx())
 	var curX int
 	if len(visible) < ar.Dx() {
 		curX = ar.Max.X - len(visible)
@@ -89,13 +152,14 @@ func (sl *SparkLine) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 	}
 
 	for _, v := range visible {
+		color := colorFn(v)
 		blocks := toBlocks(v, max, ar.Dy())
 		curY := ar.Max.Y - 1
 		for i := 0; i < blocks.full; i++ {
 			if _, err := cvs.SetCell(
 				image.Point{curX, curY},
 				sparks[len(sparks)-1], // Last spark represents full cell.
-				cell.FgColor(sl.opts.color),
+				cell.FgColor(color),
 			); err != nil {
 				return err
 			}
@@ -107,7 +171,7 @@ func (sl *SparkLine) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 			if _, err := cvs.SetCell(
 				image.Point{curX, curY},
 				blocks.partSpark,
-				cell.FgColor(sl.opts.color),
+				cell.FgColor(color),
 			); err != nil {
 				return err
 			}
@@ -115,23 +179,146 @@ func (sl *SparkLine) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 
 		curX++
 	}
+	return nil
+}
+
+// drawBrailleSparks draws the data points as vertical bars made of braille
+// dots in the area of the canvas, each data point occupies one column of dots.
+// The bars are scaled to the largest visible data point. The colorFn returns
+// the color of the bar for a data point. Each cell displays two data points,
+// if their colors differ, the cell gets the color of the larger one.
+func drawBrailleSparks(cvs *canvas.Canvas, ar image.Rectangle, data []int, colorFn func(int) cell.Color) error {
+	bc, err := braille.New(ar)
+	if err != nil {
+		return err
+	}
+
+	size := bc.Size()
+	visible, max := visibleMax(data, size.X)
+	start := size.X - len(visible)
+	// The bars are drawn from the smallest data point, since each pixel sets
+	// the color of the entire cell.
+	order := make([]int, len(visible))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return visible[order[i]] < visible[order[j]]
+	})
+	for _, i := range order {
+		v := visible[i]
+		pixels := toPixels(v, max, size.Y)
+		for y := size.Y - 1; y >= size.Y-pixels; y-- {
+			if err := bc.SetPixel(image.Point{start + i, y}, cell.FgColor(colorFn(v))); err != nil {
+				return err
+			}
+		}
+	}
+	return bc.CopyTo(cvs)
+}
 
+// currentValue returns the last data point formatted for the ShowCurrent
+// option. Returns an empty string if the option wasn't provided or there are
+// no data points.
+func (sl *SparkLine) currentValue(data []int) string {
+	if !sl.opts.showCurrent || len(data) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(sl.opts.currentFormat, data[len(data)-1])
+}
+
+// currentWidth returns the number of cells reserved for the ShowCurrent
+// option on the right of the bars, including the separating cell.
+func (sl *SparkLine) currentWidth() int {
+	var width int
+	if cur := sl.currentValue(sl.data); cur != "" {
+		width = runewidth.StringWidth(cur) + 1
+	}
+	for _, s := range sl.series {
+		if cur := sl.currentValue(s.data); cur != "" {
+			if w := runewidth.StringWidth(cur) + 1; w > width {
+				width = w
+			}
+		}
+	}
+	return width
+}
+
+// drawLabel draws the label starting at the specified point.
+func drawLabel(cvs *canvas.Canvas, label string, start image.Point, cOpts []cell.Option) error {
+	return draw.Text(cvs, label, start,
+		draw.TextCellOpts(cOpts...),
+		draw.TextOverrunMode(draw.OverrunModeThreeDot),
+	)
+}
+
+// drawSeries draws the series stacked on top of each other, the first added
+// series is at the top. The label of the SparkLine, if any, is drawn above
+// all the series.
+func (sl *SparkLine) drawSeries(cvs *canvas.Canvas) error {
+	ar := cvs.Area()
 	if sl.opts.label != "" {
-		// Label is placed immediately above the SparkLine.
-		lStart := image.Point{ar.Min.X, ar.Min.Y - 1}
-		if err := draw.Text(cvs, sl.opts.label, lStart,
-			draw.TextCellOpts(sl.opts.labelCellOpts...),
-			draw.TextOverrunMode(draw.OverrunModeThreeDot),
-		); err != nil {
+		if err := drawLabel(cvs, sl.opts.label, ar.Min, sl.opts.labelCellOpts); err != nil {
 			return err
 		}
+		ar.Min.Y++
+	}
+
+	heights := sl.seriesHeights(ar.Dy())
+	curY := ar.Min.Y
+	for i, s := range sl.series {
+		if s.label != "" {
+			if err := drawLabel(cvs, s.label, image.Point{ar.Min.X, curY}, s.opts.labelCellOpts); err != nil {
+				return err
+			}
+			curY++
+		}
+
+		sAr := image.Rect(ar.Min.X, curY, ar.Max.X, curY+heights[i])
+		if err := sl.drawSparks(cvs, sAr, s.data, s.opts.color); err != nil {
+			return err
+		}
+		curY += heights[i]
 	}
 	return nil
 }
 
+// seriesHeights returns the heights of the individual series, not including
+// their labels, given the height available to all of them.
+// If the Height option is set, each series has that height. Otherwise the
+// height left after placing the labels is divided evenly and any remaining
+// rows are added to the series at the top, one row each.
+func (sl *SparkLine) seriesHeights(available int) []int {
+	heights := make([]int, len(sl.series))
+	if sl.opts.height > 0 {
+		for i := range heights {
+			heights[i] = sl.opts.height
+		}
+		return heights
+	}
+
+	for _, s := range sl.series {
+		if s.label != "" {
+			available--
+		}
+	}
+	base := available / len(sl.series)
+	rem := available % len(sl.series)
+	for i := range heights {
+		heights[i] = base
+		if i < rem {
+			heights[i]++
+		}
+	}
+	return heights
+}
+
 // ValueCapacity returns the number of values that can fit into the canvas.
-// This is essentially the number of available cells on the canvas as observed
-// on the last call to draw. Returns zero if draw wasn't called.
+// This is essentially the number of cells available to the bars on the canvas
+// as observed on the last call to draw, or twice that if the Braille option
+// was provided. The cells that display the value of the ShowCurrent option
+// aren't available to the bars.
+// Returns zero if draw wasn't called.
 //
 // Note that this capacity changes each time the terminal resizes, so there is
 // no guarantee this remains the same next time Draw is called.
@@ -139,6 +326,9 @@ func (sl *SparkLine) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 func (sl *SparkLine) ValueCapacity() int {
 	sl.mu.Lock()
 	defer sl.mu.Unlock()
+	if sl.opts.braille {
+		return sl.lastWidth * braille.ColMult
+	}
 	return sl.lastWidth
 }
 
@@ -156,30 +346,104 @@ func (sl *SparkLine) ValueCapacity() int {
 // visible.
 //
 // Provided options override values set when New() was called.
+//
+// Cannot be combined with AddSeries on the same SparkLine until Clear is
+// called.
 func (sl *SparkLine) Add(data []int, opts ...Option) error {
 	sl.mu.Lock()
 	defer sl.mu.Unlock()
 
+	if len(sl.series) > 0 {
+		return errors.New("cannot add data points with Add, the SparkLine displays series added with AddSeries")
+	}
+
 	for _, opt := range opts {
 		opt.set(sl.opts)
 	}
 
+	if err := validateData(data); err != nil {
+		return err
+	}
+	sl.data = append(sl.data, data...)
+	return nil
+}
+
+// series is one of the series displayed by the SparkLine.
+type series struct {
+	// label is displayed above the series.
+	label string
+	// data are the data points of the series.
+	data []int
+	// opts are the options of the series.
+	opts *seriesOptions
+}
+
+// AddSeries adds data points to the series with the provided label. The
+// series is created on the first call with the label. Each series is drawn as
+// its own SparkLine with the label above it, the series are stacked on top of
+// each other in the order they were created. The label can be empty in which
+// case no line is reserved for it.
+//
+// The series share the height of the widget, the height left after reserving
+// one line for each label is divided evenly among them. See the Height option
+// for setting a fixed height of each of the series instead. Each series scales
+// independently based on its largest visible data point.
+//
+// The data points follow the same rules as the ones provided to Add.
+// Provided options override values set when the series was created.
+//
+// Cannot be combined with Add on the same SparkLine until Clear is called.
+func (sl *SparkLine) AddSeries(label string, data []int, opts ...SeriesOption) error {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	if len(sl.data) > 0 {
+		return errors.New("cannot add a series with AddSeries, the SparkLine displays data points added with Add")
+	}
+	if err := validateData(data); err != nil {
+		return err
+	}
+
+	var s *series
+	for _, cur := range sl.series {
+		if cur.label == label {
+			s = cur
+			break
+		}
+	}
+	if s == nil {
+		s = &series{
+			label: label,
+			opts:  newSeriesOptions(sl.opts),
+		}
+		sl.series = append(sl.series, s)
+	}
+
+	for _, opt := range opts {
+		opt.set(s.opts)
+	}
+	s.data = append(s.data, data...)
+	return nil
+}
+
+// validateData validates the provided data points.
+func validateData(data []int) error {
 	for i, d := range data {
 		if d < 0 {
 			return fmt.Errorf("data point[%d]: %v must be a positive integer", i, d)
 		}
 	}
-	sl.data = append(sl.data, data...)
 	return nil
 }
 
-// Clear removes all the data points in the SparkLine, effectively returning to
-// an empty graph.
+// Clear removes all the data points and series in the SparkLine, effectively
+// returning to an empty graph.
 func (sl *SparkLine) Clear() {
 	sl.mu.Lock()
 	defer sl.mu.Unlock()
 
 	sl.data = nil
+	sl.series = nil
 }
 
 // Keyboard input isn't supported on the SparkLine widget.
@@ -218,7 +482,7 @@ func (sl *SparkLine) area(cvs *canvas.Canvas) image.Rectangle {
 
 // minSize returns the minimum canvas size for the SparkLine based on the options.
 func (sl *SparkLine) minSize() image.Point {
-	const minWidth = 1 // At least one data point.
+	minWidth := 1 + sl.currentWidth() // At least one data point.
 
 	var minHeight int
 	if sl.opts.height > 0 {
@@ -227,6 +491,15 @@ func (sl *SparkLine) minSize() image.Point {
 		minHeight = 1 // At least one line of characters.
 	}
 
+	if len(sl.series) > 0 {
+		minHeight *= len(sl.series)
+		for _, s := range sl.series {
+			if s.label != "" {
+				minHeight++ // One line for the label of the series.
+			}
+		}
+	}
+
 	if sl.opts.label != "" {
 		minHeight++ // One line for the text label.
 	}
@@ -21,6 +21,8 @@ import (
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/mum4k/termdash/cell"
 	"github.com/mum4k/termdash/private/canvas"
+	"github.com/mum4k/termdash/private/canvas/braille"
+	"github.com/mum4k/termdash/private/canvas/braille/testbraille"
 	"github.com/mum4k/termdash/private/canvas/testcanvas"
 	"github.com/mum4k/termdash/private/draw"
 	"github.com/mum4k/termdash/private/draw/testdraw"
@@ -55,6 +57,20 @@ func TestSparkLine(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			desc: "fails on an empty ShowCurrent format",
+			opts: []Option{
+				ShowCurrent(""),
+			},
+			update: func(sl *SparkLine) error {
+				return nil
+			},
+			canvas: image.Rect(0, 0, 1, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
 		{
 			desc: "draws empty for no data points",
 			update: func(sl *SparkLine) error {
@@ -95,6 +111,120 @@ func TestSparkLine(t *testing.T) {
 			},
 			wantCapacity: 9,
 		},
+		{
+			desc: "braille single height sparkline",
+			opts: []Option{
+				Braille(),
+			},
+			update: func(sl *SparkLine) error {
+				return sl.Add([]int{0, 1, 2, 3, 4, 5, 6, 7, 8})
+			},
+			canvas: image.Rect(0, 0, 5, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				bc := testbraille.MustNew(c.Area())
+				mustBrailleBars(bc, 1, []int{0, 1, 1, 2, 2, 3, 3, 4, 4}, DefaultColor)
+				testbraille.MustCopyTo(bc, c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 10,
+		},
+		{
+			desc: "blocks draw data compared with braille below",
+			update: func(sl *SparkLine) error {
+				return sl.Add([]int{2, 4, 8})
+			},
+			canvas: image.Rect(0, 0, 3, 2),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "█", image.Point{2, 0}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "▄██", image.Point{0, 1}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 3,
+		},
+		{
+			desc: "braille draws data compared with blocks above",
+			opts: []Option{
+				Braille(),
+			},
+			update: func(sl *SparkLine) error {
+				return sl.Add([]int{2, 4, 8})
+			},
+			canvas: image.Rect(0, 0, 3, 2),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				bc := testbraille.MustNew(c.Area())
+				mustBrailleBars(bc, 3, []int{2, 4, 8}, DefaultColor)
+				testbraille.MustCopyTo(bc, c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 6,
+		},
+		{
+			desc: "braille sparkline with label",
+			opts: []Option{
+				Braille(),
+				Label("Hi"),
+			},
+			update: func(sl *SparkLine) error {
+				return sl.Add([]int{2, 4, 8})
+			},
+			canvas: image.Rect(0, 0, 3, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "Hi", image.Point{0, 0})
+				bc := testbraille.MustNew(image.Rect(0, 1, 3, 3))
+				mustBrailleBars(bc, 3, []int{2, 4, 8}, DefaultColor)
+				testbraille.MustCopyTo(bc, c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 6,
+		},
+		{
+			desc: "braille series",
+			opts: []Option{
+				Braille(),
+			},
+			update: func(sl *SparkLine) error {
+				if err := sl.AddSeries("a", []int{1, 2}); err != nil {
+					return err
+				}
+				return sl.AddSeries("", []int{2}, SeriesColor(cell.ColorBlue))
+			},
+			canvas: image.Rect(0, 0, 2, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "a", image.Point{0, 0})
+				first := testbraille.MustNew(image.Rect(0, 1, 2, 2))
+				mustBrailleBars(first, 2, []int{2, 4}, DefaultColor)
+				testbraille.MustCopyTo(first, c)
+				second := testbraille.MustNew(image.Rect(0, 2, 2, 3))
+				mustBrailleBars(second, 3, []int{4}, cell.ColorBlue)
+				testbraille.MustCopyTo(second, c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 4,
+		},
 		{
 			desc: "sparkline can be cleared",
 			update: func(sl *SparkLine) error {
@@ -149,6 +279,189 @@ func TestSparkLine(t *testing.T) {
 			},
 			wantCapacity: 9,
 		},
+		{
+			desc: "ColorThreshold colors the bars straddling the threshold",
+			opts: []Option{
+				ColorThreshold(4, cell.ColorGreen, cell.ColorRed),
+			},
+			update: func(sl *SparkLine) error {
+				return sl.Add([]int{0, 1, 2, 3, 4, 5, 6, 7, 8})
+			},
+			canvas: image.Rect(0, 0, 9, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "▁▂▃▄", image.Point{1, 0}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorGreen),
+				))
+				testdraw.MustText(c, "▅▆▇█", image.Point{5, 0}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorRed),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 9,
+		},
+		{
+			desc: "ColorThreshold overrides the Color option",
+			opts: []Option{
+				Color(cell.ColorMagenta),
+				ColorThreshold(6, cell.ColorGreen, cell.ColorRed),
+			},
+			update: func(sl *SparkLine) error {
+				return sl.Add([]int{8, 2, 8})
+			},
+			canvas: image.Rect(0, 0, 3, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "█", image.Point{0, 0}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorRed),
+				))
+				testdraw.MustText(c, "▂", image.Point{1, 0}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorGreen),
+				))
+				testdraw.MustText(c, "█", image.Point{2, 0}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorRed),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 3,
+		},
+		{
+			desc: "ColorThreshold with braille colors cells by the larger data point",
+			opts: []Option{
+				Braille(),
+				ColorThreshold(5, cell.ColorGreen, cell.ColorRed),
+			},
+			update: func(sl *SparkLine) error {
+				return sl.Add([]int{2, 4, 8, 4})
+			},
+			canvas: image.Rect(0, 0, 2, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				bc := testbraille.MustNew(c.Area())
+				mustBrailleBars(bc, 0, []int{1, 2}, cell.ColorGreen)
+				mustBrailleBars(bc, 3, []int{2}, cell.ColorGreen)
+				mustBrailleBars(bc, 2, []int{4}, cell.ColorRed)
+				testbraille.MustCopyTo(bc, c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 4,
+		},
+		{
+			desc: "ShowCurrent displays the last data point and shortens the plot",
+			opts: []Option{
+				ShowCurrent("%d"),
+			},
+			update: func(sl *SparkLine) error {
+				return sl.Add([]int{0, 1, 2, 3, 4, 5, 6, 7, 8})
+			},
+			canvas: image.Rect(0, 0, 9, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "▂▃▄▅▆▇█", image.Point{0, 0}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "8", image.Point{8, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 7,
+		},
+		{
+			desc: "ShowCurrent with cell options on the bottom line below a label",
+			opts: []Option{
+				Label("foo"),
+				ShowCurrent("%d%%", cell.FgColor(cell.ColorRed)),
+			},
+			update: func(sl *SparkLine) error {
+				return sl.Add([]int{50, 100})
+			},
+			canvas: image.Rect(0, 0, 7, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "foo", image.Point{0, 0})
+				testdraw.MustText(c, "█", image.Point{1, 1}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "██", image.Point{0, 2}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "100%", image.Point{3, 2}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorRed),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 2,
+		},
+		{
+			desc: "ShowCurrent with braille",
+			opts: []Option{
+				Braille(),
+				ShowCurrent("%d"),
+			},
+			update: func(sl *SparkLine) error {
+				return sl.Add([]int{0, 1, 2, 3, 4, 5, 6, 7, 8})
+			},
+			canvas: image.Rect(0, 0, 5, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				bc := testbraille.MustNew(image.Rect(0, 0, 3, 1))
+				mustBrailleBars(bc, 0, []int{2, 2, 3, 3, 4, 4}, DefaultColor)
+				testbraille.MustCopyTo(bc, c)
+				testdraw.MustText(c, "8", image.Point{4, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 6,
+		},
+		{
+			desc: "ShowCurrent displays nothing without data points",
+			opts: []Option{
+				ShowCurrent("%d"),
+			},
+			update: func(sl *SparkLine) error {
+				return nil
+			},
+			canvas: image.Rect(0, 0, 3, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantCapacity: 3,
+		},
+		{
+			desc: "ShowCurrent draws resize needed character when the value doesn't fit",
+			opts: []Option{
+				ShowCurrent("%d"),
+			},
+			update: func(sl *SparkLine) error {
+				return sl.Add([]int{100})
+			},
+			canvas: image.Rect(0, 0, 4, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustResizeNeeded(c)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 0,
+		},
 		{
 			desc: "draws data points from the right",
 			update: func(sl *SparkLine) error {
@@ -451,6 +764,292 @@ func TestSparkLine(t *testing.T) {
 			},
 			wantCapacity: 9,
 		},
+		{
+			desc: "two series share the height evenly",
+			update: func(sl *SparkLine) error {
+				if err := sl.AddSeries("a", []int{1, 2}); err != nil {
+					return err
+				}
+				return sl.AddSeries("b", []int{4, 4})
+			},
+			canvas: image.Rect(0, 0, 4, 6),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "a", image.Point{0, 0})
+				testdraw.MustText(c, "█", image.Point{3, 1}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "██", image.Point{2, 2}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "b", image.Point{0, 3})
+				testdraw.MustText(c, "██", image.Point{2, 4}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "██", image.Point{2, 5}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 4,
+		},
+		{
+			desc: "series display their own last data point",
+			opts: []Option{
+				ShowCurrent("%d"),
+			},
+			update: func(sl *SparkLine) error {
+				if err := sl.AddSeries("a", []int{1, 2}); err != nil {
+					return err
+				}
+				return sl.AddSeries("b", []int{4, 4})
+			},
+			canvas: image.Rect(0, 0, 6, 6),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "a", image.Point{0, 0})
+				testdraw.MustText(c, "█", image.Point{3, 1}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "██", image.Point{2, 2}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "2", image.Point{5, 2})
+				testdraw.MustText(c, "b", image.Point{0, 3})
+				testdraw.MustText(c, "██", image.Point{2, 4}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "██", image.Point{2, 5}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "4", image.Point{5, 5})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 4,
+		},
+		{
+			desc: "remaining height goes to the series at the top",
+			update: func(sl *SparkLine) error {
+				if err := sl.AddSeries("a", []int{3}); err != nil {
+					return err
+				}
+				return sl.AddSeries("b", []int{2})
+			},
+			canvas: image.Rect(0, 0, 4, 7),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "a", image.Point{0, 0})
+				testdraw.MustText(c, "█", image.Point{3, 1}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "█", image.Point{3, 2}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "█", image.Point{3, 3}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "b", image.Point{0, 4})
+				testdraw.MustText(c, "█", image.Point{3, 5}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "█", image.Point{3, 6}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 4,
+		},
+		{
+			desc: "series without a label don't reserve a line for it",
+			update: func(sl *SparkLine) error {
+				if err := sl.AddSeries("", []int{1}); err != nil {
+					return err
+				}
+				return sl.AddSeries("b", []int{1})
+			},
+			canvas: image.Rect(0, 0, 2, 3),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "█", image.Point{1, 0}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "b", image.Point{0, 1})
+				testdraw.MustText(c, "█", image.Point{1, 2}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 2,
+		},
+		{
+			desc: "series with fixed height and a label of the SparkLine",
+			opts: []Option{
+				Label("w"),
+				Height(1),
+			},
+			update: func(sl *SparkLine) error {
+				if err := sl.AddSeries("a", []int{1, 2}); err != nil {
+					return err
+				}
+				return sl.AddSeries("b", []int{4, 4})
+			},
+			canvas: image.Rect(0, 0, 4, 5),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "w", image.Point{0, 0})
+				testdraw.MustText(c, "a", image.Point{0, 1})
+				testdraw.MustText(c, "▄█", image.Point{2, 2}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "b", image.Point{0, 3})
+				testdraw.MustText(c, "██", image.Point{2, 4}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 4,
+		},
+		{
+			desc: "series with custom options",
+			opts: []Option{
+				Label("w", cell.FgColor(cell.ColorRed)),
+			},
+			update: func(sl *SparkLine) error {
+				if err := sl.AddSeries("a", []int{1}); err != nil {
+					return err
+				}
+				return sl.AddSeries("b", []int{1}, SeriesColor(cell.ColorBlue), SeriesLabelCellOpts(cell.FgColor(cell.ColorYellow)))
+			},
+			canvas: image.Rect(0, 0, 2, 5),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "w", image.Point{0, 0}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorRed),
+				))
+				testdraw.MustText(c, "a", image.Point{0, 1}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorRed),
+				))
+				testdraw.MustText(c, "█", image.Point{1, 2}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "b", image.Point{0, 3}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorYellow),
+				))
+				testdraw.MustText(c, "█", image.Point{1, 4}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorBlue),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 2,
+		},
+		{
+			desc: "adding to an existing series appends its data points",
+			update: func(sl *SparkLine) error {
+				if err := sl.AddSeries("a", []int{1}); err != nil {
+					return err
+				}
+				if err := sl.AddSeries("b", []int{1}); err != nil {
+					return err
+				}
+				return sl.AddSeries("a", []int{2})
+			},
+			canvas: image.Rect(0, 0, 2, 4),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "a", image.Point{0, 0})
+				testdraw.MustText(c, "▄█", image.Point{0, 1}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testdraw.MustText(c, "b", image.Point{0, 2})
+				testdraw.MustText(c, "█", image.Point{1, 3}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 2,
+		},
+		{
+			desc: "series can be cleared",
+			update: func(sl *SparkLine) error {
+				if err := sl.AddSeries("a", []int{1}); err != nil {
+					return err
+				}
+				sl.Clear()
+				return sl.Add([]int{1})
+			},
+			canvas: image.Rect(0, 0, 2, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "█", image.Point{1, 0}, draw.TextCellOpts(
+					cell.FgColor(DefaultColor),
+				))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+			wantCapacity: 2,
+		},
+		{
+			desc: "fails on negative data points in a series",
+			update: func(sl *SparkLine) error {
+				return sl.AddSeries("a", []int{1, -1})
+			},
+			canvas: image.Rect(0, 0, 1, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantUpdateErr: true,
+		},
+		{
+			desc: "fails to add a series after data points",
+			update: func(sl *SparkLine) error {
+				if err := sl.Add([]int{1}); err != nil {
+					return err
+				}
+				return sl.AddSeries("a", []int{1})
+			},
+			canvas: image.Rect(0, 0, 1, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantUpdateErr: true,
+		},
+		{
+			desc: "fails to add data points after a series",
+			update: func(sl *SparkLine) error {
+				if err := sl.AddSeries("a", []int{1}); err != nil {
+					return err
+				}
+				return sl.Add([]int{1})
+			},
+			canvas: image.Rect(0, 0, 1, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantUpdateErr: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -504,11 +1103,23 @@ func TestSparkLine(t *testing.T) {
 	}
 }
 
+// mustBrailleBars draws vertical bars of the specified heights in pixels onto
+// the braille canvas, starting at the specified column of pixels.
+func mustBrailleBars(bc *braille.Canvas, startX int, heights []int, color cell.Color) {
+	maxY := bc.Area().Max.Y
+	for i, h := range heights {
+		for y := maxY - 1; y >= maxY-h; y-- {
+			testbraille.MustSetPixel(bc, image.Point{startX + i, y}, cell.FgColor(color))
+		}
+	}
+}
+
 func TestOptions(t *testing.T) {
 	tests := []struct {
-		desc string
-		opts []Option
-		want widgetapi.Options
+		desc   string
+		opts   []Option
+		update func(*SparkLine) error // update gets called before getting the options, if set.
+		want   widgetapi.Options
 	}{
 		{
 			desc: "no label and no fixed height",
@@ -554,6 +1165,81 @@ func TestOptions(t *testing.T) {
 				WantMouse:    widgetapi.MouseScopeNone,
 			},
 		},
+		{
+			desc: "ShowCurrent without data points",
+			opts: []Option{
+				ShowCurrent("%d"),
+			},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{1, 1},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeNone,
+			},
+		},
+		{
+			desc: "ShowCurrent reserves width for the last data point",
+			opts: []Option{
+				ShowCurrent("%d"),
+			},
+			update: func(sl *SparkLine) error {
+				return sl.Add([]int{1000, 1})
+			},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{3, 1},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeNone,
+			},
+		},
+		{
+			desc: "ShowCurrent reserves width for the widest last data point of the series",
+			opts: []Option{
+				ShowCurrent("%d"),
+			},
+			update: func(sl *SparkLine) error {
+				if err := sl.AddSeries("a", []int{1}); err != nil {
+					return err
+				}
+				return sl.AddSeries("b", []int{100})
+			},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{5, 4},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeNone,
+			},
+		},
+		{
+			desc: "series with and without labels",
+			update: func(sl *SparkLine) error {
+				if err := sl.AddSeries("a", []int{1}); err != nil {
+					return err
+				}
+				return sl.AddSeries("", []int{1})
+			},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{1, 3},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeNone,
+			},
+		},
+		{
+			desc: "series with a label of the SparkLine and fixed height",
+			opts: []Option{
+				Label("foo"),
+				Height(2),
+			},
+			update: func(sl *SparkLine) error {
+				if err := sl.AddSeries("a", []int{1}); err != nil {
+					return err
+				}
+				return sl.AddSeries("b", []int{1})
+			},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{1, 7},
+				MaximumSize:  image.Point{1, 7},
+				WantKeyboard: widgetapi.KeyScopeNone,
+				WantMouse:    widgetapi.MouseScopeNone,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -562,6 +1248,11 @@ func TestOptions(t *testing.T) {
 			if err != nil {
 				t.Fatalf("New => unexpected error: %v", err)
 			}
+			if tc.update != nil {
+				if err := tc.update(sp); err != nil {
+					t.Fatalf("update => unexpected error: %v", err)
+				}
+			}
 			got := sp.Options()
 			if diff := pretty.Compare(tc.want, got); diff != "" {
 				t.Errorf("Options => unexpected diff (-want, +got):\n%s", diff)
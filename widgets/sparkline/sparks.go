@@ -88,6 +88,16 @@ func toBlocks(value, max, vertCells int) blocks {
 	return b
 }
 
+// toPixels determines the number of braille pixels required to represent the
+// provided value given the specified max visible value and number of vertical
+// pixels available to the SparkLine.
+func toPixels(value, max, vertPixels int) int {
+	if value <= 0 || max <= 0 || vertPixels <= 0 {
+		return 0
+	}
+	return int(math.Round(float64(value) * float64(vertPixels) / float64(max)))
+}
+
 // init ensures that all spark characters are half-width runes.
 // The SparkLine widget assumes that each value can be represented in a column
 // that has a width of one cell.
@@ -268,3 +268,71 @@ func findRune(target rune, runes []rune) int {
 	}
 	return -1
 }
+
+func TestToPixels(t *testing.T) {
+	tests := []struct {
+		desc       string
+		value      int
+		max        int
+		vertPixels int
+		want       int
+	}{
+		{
+			desc:       "zero value has no pixels",
+			value:      0,
+			max:        10,
+			vertPixels: 8,
+			want:       0,
+		},
+		{
+			desc:       "negative value has no pixels",
+			value:      -1,
+			max:        10,
+			vertPixels: 8,
+			want:       0,
+		},
+		{
+			desc:       "zero max has no pixels",
+			value:      10,
+			max:        0,
+			vertPixels: 8,
+			want:       0,
+		},
+		{
+			desc:       "no vertical pixels",
+			value:      10,
+			max:        10,
+			vertPixels: 0,
+			want:       0,
+		},
+		{
+			desc:       "max value uses all pixels",
+			value:      10,
+			max:        10,
+			vertPixels: 8,
+			want:       8,
+		},
+		{
+			desc:       "rounds to the nearest pixel",
+			value:      3,
+			max:        10,
+			vertPixels: 8,
+			want:       2,
+		},
+		{
+			desc:       "rounds half up",
+			value:      1,
+			max:        8,
+			vertPixels: 4,
+			want:       1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := toPixels(tc.value, tc.max, tc.vertPixels); got != tc.want {
+				t.Errorf("toPixels(%d, %d, %d) => %d, want %d", tc.value, tc.max, tc.vertPixels, got, tc.want)
+			}
+		})
+	}
+}
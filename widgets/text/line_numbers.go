@@ -0,0 +1,119 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/private/canvas"
+	"github.com/mum4k/termdash/private/canvas/buffer"
+	"github.com/mum4k/termdash/private/wrap"
+)
+
+// line_numbers.go contains code that draws the line numbers gutter.
+
+// splitLines splits the cells into lines at the newline characters.
+// The newline characters aren't included in the returned lines.
+func splitLines(cells []*buffer.Cell) [][]*buffer.Cell {
+	var (
+		lines [][]*buffer.Cell
+		start int
+	)
+	for i, c := range cells {
+		if c.Rune == '\n' {
+			lines = append(lines, cells[start:i])
+			start = i + 1
+		}
+	}
+	return append(lines, cells[start:])
+}
+
+// numberedLines returns the number of lines of the content that get a line
+// number. A trailing newline doesn't start a new numbered line.
+func numberedLines(lines [][]*buffer.Cell) int {
+	if n := len(lines); n > 1 && len(lines[n-1]) == 0 {
+		return n - 1
+	}
+	return len(lines)
+}
+
+// gutterWidth returns the width of the line numbers gutter in cells for the
+// specified number of lines. This includes one cell that separates the
+// numbers from the text.
+func gutterWidth(lines int) int {
+	return len(fmt.Sprint(lines)) + 1
+}
+
+// wrapNumbered wraps the cells into lines of the specified width, the same
+// way wrap.Cells does. Also returns the line number of each of the wrapped
+// lines. The line numbers start at one, wrapped lines that continue the
+// previous line and the line after a trailing newline have the number zero.
+func wrapNumbered(cells []*buffer.Cell, width int, m wrap.Mode) ([][]*buffer.Cell, []int, error) {
+	if width <= 0 {
+		return nil, nil, nil
+	}
+
+	var (
+		wrapped [][]*buffer.Cell
+		numbers []int
+	)
+	lines := splitLines(cells)
+	numbered := numberedLines(lines)
+	for i, line := range lines {
+		num := i + 1
+		if num > numbered {
+			num = 0
+		}
+
+		if len(line) == 0 {
+			wrapped = append(wrapped, nil)
+			numbers = append(numbers, num)
+			continue
+		}
+
+		wr, err := wrap.Cells(line, width, m)
+		if err != nil {
+			return nil, nil, err
+		}
+		for j, w := range wr {
+			wrapped = append(wrapped, w)
+			if j == 0 {
+				numbers = append(numbers, num)
+			} else {
+				numbers = append(numbers, 0)
+			}
+		}
+	}
+	return wrapped, numbers, nil
+}
+
+// drawLineNumber draws the line number right-aligned in the gutter of the
+// specified width on the specified row of the canvas. Draws nothing if the
+// number is zero.
+func drawLineNumber(cvs *canvas.Canvas, row, gutterWidth, num int, opts ...cell.Option) error {
+	if num == 0 {
+		return nil
+	}
+	// One cell separates the numbers from the text.
+	text := fmt.Sprintf("%*d", gutterWidth-1, num)
+	for i, r := range text {
+		if _, err := cvs.SetCell(image.Point{i, row}, r, opts...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
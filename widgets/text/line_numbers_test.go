@@ -0,0 +1,128 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+	"github.com/mum4k/termdash/private/canvas/buffer"
+	"github.com/mum4k/termdash/private/wrap"
+)
+
+func TestWrapNumbered(t *testing.T) {
+	tests := []struct {
+		desc        string
+		text        string
+		width       int
+		mode        wrap.Mode
+		wantLines   []string
+		wantNumbers []int
+	}{
+		{
+			desc:        "zero width",
+			text:        "abc",
+			width:       0,
+			wantLines:   nil,
+			wantNumbers: nil,
+		},
+		{
+			desc:        "single line",
+			text:        "abc",
+			width:       5,
+			wantLines:   []string{"abc"},
+			wantNumbers: []int{1},
+		},
+		{
+			desc:        "multiple lines",
+			text:        "abc\nde",
+			width:       5,
+			wantLines:   []string{"abc", "de"},
+			wantNumbers: []int{1, 2},
+		},
+		{
+			desc:        "empty lines are numbered",
+			text:        "a\n\nb",
+			width:       5,
+			wantLines:   []string{"a", "", "b"},
+			wantNumbers: []int{1, 2, 3},
+		},
+		{
+			desc:        "trailing newline isn't numbered",
+			text:        "a\n",
+			width:       5,
+			wantLines:   []string{"a", ""},
+			wantNumbers: []int{1, 0},
+		},
+		{
+			desc:        "only the first row of a wrapped line is numbered",
+			text:        "abcdefg\nh",
+			width:       3,
+			mode:        wrap.AtRunes,
+			wantLines:   []string{"abc", "def", "g", "h"},
+			wantNumbers: []int{1, 0, 0, 2},
+		},
+		{
+			desc:        "wraps at words",
+			text:        "ab cd\ne",
+			width:       3,
+			mode:        wrap.AtWords,
+			wantLines:   []string{"ab", "cd", "e"},
+			wantNumbers: []int{1, 0, 2},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			gotWrapped, gotNumbers, err := wrapNumbered(buffer.NewCells(tc.text), tc.width, tc.mode)
+			if err != nil {
+				t.Fatalf("wrapNumbered => unexpected error: %v", err)
+			}
+
+			var gotLines []string
+			for _, line := range gotWrapped {
+				var s string
+				for _, c := range line {
+					s += string(c.Rune)
+				}
+				gotLines = append(gotLines, s)
+			}
+			if diff := pretty.Compare(tc.wantLines, gotLines); diff != "" {
+				t.Errorf("wrapNumbered => unexpected lines, diff (-want, +got):\n%s", diff)
+			}
+			if diff := pretty.Compare(tc.wantNumbers, gotNumbers); diff != "" {
+				t.Errorf("wrapNumbered => unexpected numbers, diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGutterWidth(t *testing.T) {
+	tests := []struct {
+		lines int
+		want  int
+	}{
+		{lines: 1, want: 2},
+		{lines: 9, want: 2},
+		{lines: 10, want: 3},
+		{lines: 100, want: 4},
+	}
+
+	for _, tc := range tests {
+		if got := gutterWidth(tc.lines); got != tc.want {
+			t.Errorf("gutterWidth(%d) => %d, want %d", tc.lines, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"errors"
+
+	"github.com/mum4k/termdash/private/canvas/buffer"
+)
+
+// links.go contains code that resolves clickable links in the text content.
+
+// LinkFn is the function called when a link is clicked.
+// The callback function must be light-weight, ideally just storing a value and
+// returning, since more clicks might occur.
+//
+// The callback function must be thread-safe as the mouse events that click
+// the link are processed in a separate goroutine.
+//
+// If the function returns an error, the widget will forward it back to the
+// termdash infrastructure which causes a panic, unless the user provided a
+// termdash.ErrorHandler.
+type LinkFn func() error
+
+// link is a clickable substring of the text content.
+type link struct {
+	// text are the runes of the substring.
+	text []rune
+	// onClick is called when the link is clicked.
+	onClick LinkFn
+}
+
+// AddLink makes all occurrences of the substring in the text content
+// clickable. Clicking onto any cell of a displayed occurrence with the left
+// mouse button invokes the callback function. Occurrences are searched for in
+// the content as it was written, so they remain clickable when wrapped onto
+// multiple lines or scrolled.
+//
+// If occurrences of multiple links overlap, the link added first takes
+// precedence. The links remain registered when the content is reset.
+func (t *Text) AddLink(substr string, onClick LinkFn) error {
+	if substr == "" {
+		return errors.New("the link substring cannot be empty")
+	}
+	if onClick == nil {
+		return errors.New("the link callback function cannot be nil")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.links = append(t.links, &link{
+		text:    []rune(substr),
+		onClick: onClick,
+	})
+	t.linksChanged = true
+	return nil
+}
+
+// resolveLinks finds all occurrences of the links in the content and returns
+// the cells that belong to them.
+func resolveLinks(content []*buffer.Cell, links []*link) map[*buffer.Cell]*link {
+	res := map[*buffer.Cell]*link{}
+	for _, l := range links {
+		for i := 0; i+len(l.text) <= len(content); i++ {
+			if !matchesAt(content, i, l.text) {
+				continue
+			}
+
+			occ := content[i : i+len(l.text)]
+			if overlaps(occ, res) {
+				continue
+			}
+			for _, c := range occ {
+				res[c] = l
+			}
+			i += len(l.text) - 1
+		}
+	}
+	return res
+}
+
+// matchesAt determines if the content starting at the index matches the text.
+func matchesAt(content []*buffer.Cell, idx int, text []rune) bool {
+	for j, r := range text {
+		if content[idx+j].Rune != r {
+			return false
+		}
+	}
+	return true
+}
+
+// overlaps determines if any of the cells already belongs to a link.
+func overlaps(cells []*buffer.Cell, linkCells map[*buffer.Cell]*link) bool {
+	for _, c := range cells {
+		if _, ok := linkCells[c]; ok {
+			return true
+		}
+	}
+	return false
+}
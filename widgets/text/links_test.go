@@ -0,0 +1,267 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"errors"
+	"image"
+	"testing"
+
+	"github.com/mum4k/termdash/mouse"
+	"github.com/mum4k/termdash/private/canvas"
+	"github.com/mum4k/termdash/terminal/terminalapi"
+	"github.com/mum4k/termdash/widgetapi"
+)
+
+func TestLinks(t *testing.T) {
+	tests := []struct {
+		desc string
+		// canvas is the area the widget is drawn onto.
+		canvas image.Rectangle
+		opts   []Option
+		// text is written into the widget.
+		text string
+		// links are added to the widget.
+		links []string
+		// scroll is the number of lines to scroll down before drawing.
+		scroll int
+		// clicks are the mouse events sent to the widget after drawing.
+		clicks []*terminalapi.Mouse
+		// wantClicks are the numbers of invocations of the callbacks of the
+		// individual links.
+		wantClicks   []int
+		wantLinkErr  bool
+		wantMouseErr bool
+	}{
+		{
+			desc:        "fails on empty substring",
+			canvas:      image.Rect(0, 0, 10, 1),
+			text:        "hello",
+			links:       []string{""},
+			wantLinkErr: true,
+		},
+		{
+			desc:   "click on a link invokes its callback",
+			canvas: image.Rect(0, 0, 20, 1),
+			text:   "see details here",
+			links:  []string{"details"},
+			clicks: []*terminalapi.Mouse{
+				{Position: image.Point{4, 0}, Button: mouse.ButtonLeft},
+				{Position: image.Point{10, 0}, Button: mouse.ButtonLeft},
+			},
+			wantClicks: []int{2},
+		},
+		{
+			desc:   "click outside of a link is ignored",
+			canvas: image.Rect(0, 0, 20, 1),
+			text:   "see details here",
+			links:  []string{"details"},
+			clicks: []*terminalapi.Mouse{
+				{Position: image.Point{3, 0}, Button: mouse.ButtonLeft},
+				{Position: image.Point{11, 0}, Button: mouse.ButtonLeft},
+				{Position: image.Point{0, 1}, Button: mouse.ButtonLeft},
+			},
+			wantClicks: []int{0},
+		},
+		{
+			desc:   "only the left mouse button clicks a link",
+			canvas: image.Rect(0, 0, 20, 1),
+			text:   "see details here",
+			links:  []string{"details"},
+			clicks: []*terminalapi.Mouse{
+				{Position: image.Point{4, 0}, Button: mouse.ButtonRight},
+				{Position: image.Point{4, 0}, Button: mouse.ButtonRelease},
+			},
+			wantClicks: []int{0},
+		},
+		{
+			desc:   "all occurrences of the substring are clickable",
+			canvas: image.Rect(0, 0, 20, 2),
+			text:   "ab\nxab",
+			links:  []string{"ab"},
+			clicks: []*terminalapi.Mouse{
+				{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+				{Position: image.Point{2, 1}, Button: mouse.ButtonLeft},
+			},
+			wantClicks: []int{2},
+		},
+		{
+			desc:   "link wrapped onto multiple lines is clickable on all of them",
+			canvas: image.Rect(0, 0, 4, 2),
+			opts:   []Option{WrapAtRunes()},
+			text:   "abcdef",
+			links:  []string{"cdef"},
+			clicks: []*terminalapi.Mouse{
+				{Position: image.Point{3, 0}, Button: mouse.ButtonLeft},
+				{Position: image.Point{1, 1}, Button: mouse.ButtonLeft},
+				{Position: image.Point{1, 0}, Button: mouse.ButtonLeft},
+			},
+			wantClicks: []int{2},
+		},
+		{
+			desc:   "link positions follow the scrolled content",
+			canvas: image.Rect(0, 0, 10, 2),
+			text:   "line0\nline1\nlink",
+			links:  []string{"link"},
+			scroll: 1,
+			clicks: []*terminalapi.Mouse{
+				{Position: image.Point{0, 1}, Button: mouse.ButtonLeft},
+			},
+			wantClicks: []int{1},
+		},
+		{
+			desc:   "link positions account for the line numbers gutter",
+			canvas: image.Rect(0, 0, 10, 1),
+			opts:   []Option{ShowLineNumbers()},
+			text:   "link",
+			links:  []string{"link"},
+			clicks: []*terminalapi.Mouse{
+				{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+				{Position: image.Point{2, 0}, Button: mouse.ButtonLeft},
+			},
+			wantClicks: []int{1},
+		},
+		{
+			desc:   "link positions account for the header",
+			canvas: image.Rect(0, 0, 10, 2),
+			opts:   []Option{Header("link")},
+			text:   "link",
+			links:  []string{"link"},
+			clicks: []*terminalapi.Mouse{
+				{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+				{Position: image.Point{0, 1}, Button: mouse.ButtonLeft},
+			},
+			wantClicks: []int{1},
+		},
+		{
+			desc:   "link that isn't displayed isn't clickable",
+			canvas: image.Rect(0, 0, 5, 1),
+			text:   "abc link",
+			links:  []string{"link"},
+			clicks: []*terminalapi.Mouse{
+				{Position: image.Point{4, 0}, Button: mouse.ButtonLeft},
+			},
+			wantClicks: []int{0},
+		},
+		{
+			desc:   "full-width runes of a link are clickable on both cells",
+			canvas: image.Rect(0, 0, 10, 1),
+			text:   "a世界",
+			links:  []string{"世"},
+			clicks: []*terminalapi.Mouse{
+				{Position: image.Point{1, 0}, Button: mouse.ButtonLeft},
+				{Position: image.Point{2, 0}, Button: mouse.ButtonLeft},
+				{Position: image.Point{3, 0}, Button: mouse.ButtonLeft},
+			},
+			wantClicks: []int{2},
+		},
+		{
+			desc:   "the link added first takes precedence on overlap",
+			canvas: image.Rect(0, 0, 10, 1),
+			text:   "abcd",
+			links:  []string{"bc", "abcd", "d"},
+			clicks: []*terminalapi.Mouse{
+				{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+				{Position: image.Point{1, 0}, Button: mouse.ButtonLeft},
+				{Position: image.Point{3, 0}, Button: mouse.ButtonLeft},
+			},
+			wantClicks: []int{1, 0, 1},
+		},
+		{
+			desc:   "links are clickable when scrolling is disabled",
+			canvas: image.Rect(0, 0, 10, 1),
+			opts:   []Option{DisableScrolling()},
+			text:   "link",
+			links:  []string{"link"},
+			clicks: []*terminalapi.Mouse{
+				{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+			},
+			wantClicks: []int{1},
+		},
+		{
+			desc:   "forwards errors from the callback",
+			canvas: image.Rect(0, 0, 10, 1),
+			text:   "error",
+			links:  []string{"error"},
+			clicks: []*terminalapi.Mouse{
+				{Position: image.Point{0, 0}, Button: mouse.ButtonLeft},
+			},
+			wantClicks:   []int{1},
+			wantMouseErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			widget, err := New(tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if err := widget.Write(tc.text); err != nil {
+				t.Fatalf("Write => unexpected error: %v", err)
+			}
+
+			gotClicks := make([]int, len(tc.links))
+			for i, l := range tc.links {
+				i := i
+				l := l
+				err := widget.AddLink(l, func() error {
+					gotClicks[i]++
+					if l == "error" {
+						return errors.New("callback error")
+					}
+					return nil
+				})
+				if (err != nil) != tc.wantLinkErr {
+					t.Errorf("AddLink => unexpected error: %v, wantLinkErr: %v", err, tc.wantLinkErr)
+				}
+				if err != nil {
+					return
+				}
+			}
+
+			if got := widget.Options().WantMouse; got != widgetapi.MouseScopeWidget {
+				t.Errorf("Options => WantMouse %v, want %v", got, widgetapi.MouseScopeWidget)
+			}
+
+			for i := 0; i < tc.scroll; i++ {
+				if err := widget.Keyboard(&terminalapi.Keyboard{Key: DefaultScrollKeyDown}, &widgetapi.EventMeta{}); err != nil {
+					t.Fatalf("Keyboard => unexpected error: %v", err)
+				}
+			}
+
+			cvs, err := canvas.New(tc.canvas)
+			if err != nil {
+				t.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+			if err := widget.Draw(cvs, &widgetapi.Meta{}); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			for _, m := range tc.clicks {
+				err := widget.Mouse(m, &widgetapi.EventMeta{})
+				if (err != nil) != tc.wantMouseErr {
+					t.Errorf("Mouse => unexpected error: %v, wantMouseErr: %v", err, tc.wantMouseErr)
+				}
+			}
+
+			for i, want := range tc.wantClicks {
+				if got := gotClicks[i]; got != want {
+					t.Errorf("link %q clicked %d times, want %d", tc.links[i], got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"unicode"
+
+	"github.com/mum4k/termdash/cell"
+)
+
+// markdown.go contains code that parses the inline markdown-like styling.
+
+// styledRune is a rune of the written text that gets displayed.
+type styledRune struct {
+	// r is the rune.
+	r rune
+	// idx is the index of the rune within the written text.
+	idx int
+	// opts are the cell options applied by the markers around the rune.
+	opts []cell.Option
+}
+
+// inlineMarkers maps the supported markers to the cell options they apply.
+var inlineMarkers = map[rune]cell.Option{
+	'*': cell.Bold(),
+	'_': cell.Italic(),
+	'`': cell.Inverse(),
+}
+
+// styledRunes returns the runes of the text that get displayed. The idx is the
+// index of the first rune of the text within the written text.
+// If the ParseMarkdownInline option was provided, the markers are removed and
+// their styling applied to the runes between them.
+func (t *Text) styledRunes(text string, idx int) []styledRune {
+	runes := []rune(text)
+	if t.opts.markdownInline {
+		return parseInline(runes, idx, nil)
+	}
+
+	res := make([]styledRune, len(runes))
+	for i, r := range runes {
+		res[i] = styledRune{r: r, idx: idx + i}
+	}
+	return res
+}
+
+// parseInline parses the markers in the runes, idx is the index of the first
+// rune within the written text and opts are the cell options applied by the
+// markers around the runes.
+//
+// A marker only starts styling if a matching closing marker follows on the
+// same line. The styled text can't start or end with a space and the bold and
+// italic markers can't be surrounded by letters or digits, so identifiers like
+// snake_case remain unchanged. Markers within code aren't parsed. Markers
+// preceded by a backslash are displayed as is.
+func parseInline(runes []rune, idx int, opts []cell.Option) []styledRune {
+	var res []styledRune
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r == '\\' && i+1 < len(runes) && isEscapable(runes[i+1]) {
+			i++
+			res = append(res, styledRune{r: runes[i], idx: idx + i, opts: opts})
+			continue
+		}
+
+		mOpt, ok := inlineMarkers[r]
+		if !ok {
+			res = append(res, styledRune{r: r, idx: idx + i, opts: opts})
+			continue
+		}
+		end := closingMarker(runes, i)
+		if end == -1 {
+			res = append(res, styledRune{r: r, idx: idx + i, opts: opts})
+			continue
+		}
+
+		inner := append(opts[:len(opts):len(opts)], mOpt)
+		if r == '`' {
+			for j := i + 1; j < end; j++ {
+				res = append(res, styledRune{r: runes[j], idx: idx + j, opts: inner})
+			}
+		} else {
+			res = append(res, parseInline(runes[i+1:end], idx+i+1, inner)...)
+		}
+		i = end
+	}
+	return res
+}
+
+// isEscapable determines if the rune can be escaped with a backslash.
+func isEscapable(r rune) bool {
+	if r == '\\' {
+		return true
+	}
+	_, ok := inlineMarkers[r]
+	return ok
+}
+
+// isWordRune determines if the rune is a letter or a digit.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// closingMarker returns the index of the marker that closes the marker at the
+// start index or -1 if the marker at the start doesn't open styling.
+func closingMarker(runes []rune, start int) int {
+	m := runes[start]
+	code := m == '`'
+	if start+1 >= len(runes) || unicode.IsSpace(runes[start+1]) {
+		return -1
+	}
+	if !code && start > 0 && isWordRune(runes[start-1]) {
+		return -1
+	}
+
+	for i := start + 2; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\n':
+			return -1
+		case r == '\\' && !code:
+			i++
+		case r == m:
+			if unicode.IsSpace(runes[i-1]) {
+				continue
+			}
+			if !code && i+1 < len(runes) && isWordRune(runes[i+1]) {
+				continue
+			}
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,178 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mum4k/termdash/cell"
+)
+
+// styleOf returns a letter describing the styling of the cell options.
+// Returns '.' for no styling, 'b' for bold, 'i' for italic, 'B' for bold and
+// italic and 'c' for code.
+func styleOf(opts *cell.Options) byte {
+	switch {
+	case opts.Inverse:
+		return 'c'
+	case opts.Bold && opts.Italic:
+		return 'B'
+	case opts.Bold:
+		return 'b'
+	case opts.Italic:
+		return 'i'
+	default:
+		return '.'
+	}
+}
+
+func TestParseInline(t *testing.T) {
+	tests := []struct {
+		desc string
+		text string
+		// want is the displayed text.
+		want string
+		// wantStyle has one letter for each rune of want, see styleOf.
+		wantStyle string
+		// wantIdx are the indexes of the displayed runes in the text, only
+		// checked if specified.
+		wantIdx []int
+	}{
+		{
+			desc:      "no markers",
+			text:      "abc",
+			want:      "abc",
+			wantStyle: "...",
+		},
+		{
+			desc:      "bold",
+			text:      "a *bc* d",
+			want:      "a bc d",
+			wantStyle: "..bb..",
+			wantIdx:   []int{0, 1, 3, 4, 6, 7},
+		},
+		{
+			desc:      "italic",
+			text:      "_ab_",
+			want:      "ab",
+			wantStyle: "ii",
+		},
+		{
+			desc:      "code",
+			text:      "run `ls`",
+			want:      "run ls",
+			wantStyle: "....cc",
+		},
+		{
+			desc:      "italic inside bold",
+			text:      "*a _b_*",
+			want:      "a b",
+			wantStyle: "bbB",
+		},
+		{
+			desc:      "markers inside code aren't parsed",
+			text:      "`*a*`",
+			want:      "*a*",
+			wantStyle: "ccc",
+		},
+		{
+			desc:      "escaped markers",
+			text:      `\*a\* \_b\_ \` + "`c\\`",
+			want:      "*a* _b_ `c`",
+			wantStyle: "...........",
+		},
+		{
+			desc:      "escaped backslash before a marker",
+			text:      `\\*a*`,
+			want:      `\a`,
+			wantStyle: ".b",
+		},
+		{
+			desc:      "escaped marker inside bold",
+			text:      `*a\*b*`,
+			want:      "a*b",
+			wantStyle: "bbb",
+		},
+		{
+			desc:      "backslash before other runes is displayed",
+			text:      `a\b`,
+			want:      `a\b`,
+			wantStyle: "...",
+		},
+		{
+			desc:      "unclosed marker",
+			text:      "2 * 3 = 6",
+			want:      "2 * 3 = 6",
+			wantStyle: ".........",
+		},
+		{
+			desc:      "marker followed by a space doesn't open",
+			text:      "a * b *",
+			want:      "a * b *",
+			wantStyle: ".......",
+		},
+		{
+			desc:      "marker preceded by a space doesn't close",
+			text:      "*a *",
+			want:      "*a *",
+			wantStyle: "....",
+		},
+		{
+			desc:      "markers inside words are ignored",
+			text:      "snake_case_name",
+			want:      "snake_case_name",
+			wantStyle: "...............",
+		},
+		{
+			desc:      "markers don't span lines",
+			text:      "*a\nb*",
+			want:      "*a\nb*",
+			wantStyle: ".....",
+		},
+		{
+			desc:      "empty styled text",
+			text:      "** __ ``",
+			want:      "** __ ``",
+			wantStyle: "........",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := parseInline([]rune(tc.text), 0, nil)
+
+			var (
+				gotText  []rune
+				gotStyle []byte
+				gotIdx   []int
+			)
+			for _, sr := range got {
+				gotText = append(gotText, sr.r)
+				gotStyle = append(gotStyle, styleOf(cell.NewOptions(sr.opts...)))
+				gotIdx = append(gotIdx, sr.idx)
+			}
+			if string(gotText) != tc.want {
+				t.Errorf("parseInline(%q) => text %q, want %q", tc.text, string(gotText), tc.want)
+			}
+			if string(gotStyle) != tc.wantStyle {
+				t.Errorf("parseInline(%q) => style %q, want %q", tc.text, gotStyle, tc.wantStyle)
+			}
+			if tc.wantIdx != nil && fmt.Sprint(gotIdx) != fmt.Sprint(tc.wantIdx) {
+				t.Errorf("parseInline(%q) => indexes %v, want %v", tc.text, gotIdx, tc.wantIdx)
+			}
+		})
+	}
+}
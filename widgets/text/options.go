@@ -17,6 +17,7 @@ package text
 import (
 	"fmt"
 
+	"github.com/mum4k/termdash/cell"
 	"github.com/mum4k/termdash/keyboard"
 	"github.com/mum4k/termdash/mouse"
 	"github.com/mum4k/termdash/private/wrap"
@@ -37,6 +38,9 @@ type options struct {
 	wrapMode         wrap.Mode
 	rollContent      bool
 	maxTextCells     int
+	maxLines         int
+	tabWidth         int
+	trimSpace        bool
 	disableScrolling bool
 	mouseUpButton    mouse.Button
 	mouseDownButton  mouse.Button
@@ -44,6 +48,13 @@ type options struct {
 	keyDown          keyboard.Key
 	keyPgUp          keyboard.Key
 	keyPgDown        keyboard.Key
+	lineNumbers      bool
+	lineNumbersOpts  []cell.Option
+	selectable       bool
+	selectedOpts     []cell.Option
+	header           *pinnedLine
+	footer           *pinnedLine
+	markdownInline   bool
 }
 
 // newOptions returns a new options instance.
@@ -82,6 +93,18 @@ func (o *options) validate() error {
 	if o.maxTextCells < 0 {
 		return fmt.Errorf("invalid MaxTextCells(%d), must be zero or a positive integer", o.maxTextCells)
 	}
+	if o.maxLines < 0 {
+		return fmt.Errorf("invalid MaxLines(%d), must be zero or a positive integer", o.maxLines)
+	}
+	if o.tabWidth < 0 {
+		return fmt.Errorf("invalid ExpandTabs(%d), must be zero or a positive integer", o.tabWidth)
+	}
+	if err := o.header.validate(); err != nil {
+		return fmt.Errorf("invalid Header: %v", err)
+	}
+	if err := o.footer.validate(); err != nil {
+		return fmt.Errorf("invalid Footer: %v", err)
+	}
 	return nil
 }
 
@@ -136,6 +159,15 @@ func RollContent() Option {
 	})
 }
 
+// Follow configures the text widget to follow the newest content, i.e. to
+// keep the last line visible as new text is written, which is useful for
+// displaying logs. Following pauses once the user scrolls up and resumes when
+// the user scrolls back to the last line. This is the same as RollContent.
+// Following can also be toggled at runtime, see Text.SetFollow.
+func Follow() Option {
+	return RollContent()
+}
+
 // DisableScrolling disables the scrolling of the content using keyboard and
 // mouse.
 func DisableScrolling() Option {
@@ -199,3 +231,119 @@ func MaxTextCells(max int) Option {
 		opts.maxTextCells = max
 	})
 }
+
+// MaxLines limits the text content to this number of lines, i.e. text
+// separated by the newline character. A trailing newline doesn't start a new
+// line. This is useful when tailing logs, see also the RollContent option.
+// When a write makes the content go over this number of lines, the Text widget
+// drops the oldest lines to accommodate the new ones. Wrapping and scrolling
+// operate on the retained lines only.
+// Can be combined with MaxTextCells, in which case both limits apply.
+// Use zero for no limit, which is the default.
+func MaxLines(max int) Option {
+	return option(func(opts *options) {
+		opts.maxLines = max
+	})
+}
+
+// ExpandTabs allows the text to contain tab ('\t') characters and converts
+// each of them to spaces when the text is written. Tab stops are placed every
+// width cells, so a tab expands to the number of spaces that moves the next
+// character to the following tab stop, relative to the column on the line
+// where the tab was written. The spaces get the cell options of the tab.
+// The columns are counted before the lines are wrapped.
+// Use zero to disallow tabs in the text, which is the default.
+func ExpandTabs(width int) Option {
+	return option(func(opts *options) {
+		opts.tabWidth = width
+	})
+}
+
+// TrimTrailingSpace configures the text widget to drop the space characters at
+// the end of each line when displaying the text. The trimming happens before
+// the lines are wrapped, the stored content isn't modified, so text written
+// later can still continue the line after the spaces.
+func TrimTrailingSpace() Option {
+	return option(func(opts *options) {
+		opts.trimSpace = true
+	})
+}
+
+// ShowLineNumbers configures the text widget to display a gutter with line
+// numbers on the left side of the canvas. Each line of the text (i.e. text
+// separated by the newline character) gets a right-aligned number that is
+// displayed only on the first row of the line when the line is wrapped. A
+// trailing newline at the end of the text doesn't start a new numbered line.
+// The width of the gutter is subtracted from the width available for the
+// text, i.e. it affects wrapping and trimming of the lines.
+// The provided cell options are applied to the line numbers.
+func ShowLineNumbers(co ...cell.Option) Option {
+	return option(func(opts *options) {
+		opts.lineNumbers = true
+		opts.lineNumbersOpts = co
+	})
+}
+
+// Header pins a line of text above the content. The header occupies the top
+// row of the canvas and doesn't scroll with the content, i.e. the content
+// scrolls in the rows that remain. The header is trimmed if it doesn't fit
+// the width of the canvas and can't contain newline characters.
+// The provided cell options are applied to the header.
+func Header(text string, co ...cell.Option) Option {
+	return option(func(opts *options) {
+		opts.header = &pinnedLine{
+			text:     text,
+			cellOpts: co,
+		}
+	})
+}
+
+// Footer pins a line of text below the content. The footer occupies the
+// bottom row of the canvas and doesn't scroll with the content, i.e. the
+// content scrolls in the rows that remain. The footer is trimmed if it doesn't
+// fit the width of the canvas and can't contain newline characters.
+// The provided cell options are applied to the footer.
+func Footer(text string, co ...cell.Option) Option {
+	return option(func(opts *options) {
+		opts.footer = &pinnedLine{
+			text:     text,
+			cellOpts: co,
+		}
+	})
+}
+
+// Selectable allows the user to select a region of the text content by
+// dragging the mouse with the left button held down, e.g. to copy it. The
+// selected text can be retrieved by calling Text.SelectedText. A click
+// without dragging clears the selection. The selection refers to the content,
+// so it remains in place when the content scrolls.
+// The provided cell options are applied to the selected cells, if none are
+// provided the selected cells are drawn with inverted colors.
+func Selectable(co ...cell.Option) Option {
+	return option(func(opts *options) {
+		opts.selectable = true
+		if len(co) == 0 {
+			co = []cell.Option{cell.Inverse()}
+		}
+		opts.selectedOpts = co
+	})
+}
+
+// ParseMarkdownInline configures the text widget to interpret markdown-like
+// markers in the written text and display the text between them styled
+// instead of displaying the markers:
+//
+//	*bold*, _italic_ and `code`
+//
+// Code is displayed with inverted colors. The parsing is conservative, so
+// that e.g. log output isn't clobbered. A marker only applies if a matching
+// marker closes it on the same line, the styled text doesn't start or end
+// with a space and the bold and italic markers aren't surrounded by letters or
+// digits. Each call to Text.Write is parsed separately. Markers preceded by a
+// backslash are displayed as is, e.g. \*not bold\*.
+// The styling is applied on top of the cell options provided to Text.Write.
+func ParseMarkdownInline() Option {
+	return option(func(opts *options) {
+		opts.markdownInline = true
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+// pinned.go contains code that draws the header and the footer.
+
+import (
+	"errors"
+	"image"
+	"strings"
+
+	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/private/canvas"
+	"github.com/mum4k/termdash/private/draw"
+	"github.com/mum4k/termdash/private/wrap"
+)
+
+// pinnedLine is a line of text that doesn't scroll with the content.
+type pinnedLine struct {
+	text     string
+	cellOpts []cell.Option
+}
+
+// validate validates the pinned line, nil lines are valid.
+func (pl *pinnedLine) validate() error {
+	if pl == nil || pl.text == "" {
+		return nil
+	}
+	if strings.ContainsRune(pl.text, '\n') {
+		return errors.New("the text cannot contain newline characters")
+	}
+	return wrap.ValidText(pl.text)
+}
+
+// draw draws the pinned line onto the specified row of the canvas.
+func (pl *pinnedLine) draw(cvs *canvas.Canvas, row int) error {
+	if pl.text == "" {
+		return nil
+	}
+	return draw.Text(cvs, pl.text, image.Point{0, row},
+		draw.TextCellOpts(pl.cellOpts...),
+		draw.TextOverrunMode(draw.OverrunModeTrim),
+	)
+}
+
+// pinnedLines returns the number of rows occupied by the header and the
+// footer.
+func (o *options) pinnedLines() int {
+	var lines int
+	if o.header != nil {
+		lines++
+	}
+	if o.footer != nil {
+		lines++
+	}
+	return lines
+}
+
+// drawPinned draws the header and the footer if requested onto the canvas.
+// Returns the area of the canvas that remains for the content.
+func (t *Text) drawPinned(cvs *canvas.Canvas) (image.Rectangle, error) {
+	ar := cvs.Area()
+	if t.opts.header != nil && ar.Dy() > 0 {
+		if err := t.opts.header.draw(cvs, ar.Min.Y); err != nil {
+			return image.ZR, err
+		}
+		ar.Min.Y++
+	}
+	if t.opts.footer != nil && ar.Dy() > 0 {
+		if err := t.opts.footer.draw(cvs, ar.Max.Y-1); err != nil {
+			return image.ZR, err
+		}
+		ar.Max.Y--
+	}
+	return ar, nil
+}
@@ -62,6 +62,21 @@ func newScrollTracker(opts *options) *scrollTracker {
 	return &scrollTracker{state: rollingDisabled}
 }
 
+// follow enables or disables rolling of the content. When enabled, the
+// content is rolled so that the last line is visible, even if rolling was
+// paused by the user scrolling up, outstanding scroll requests are discarded.
+// When disabled, the current scrolling position and outstanding scroll
+// requests are kept.
+func (st *scrollTracker) follow(enabled bool) {
+	if !enabled {
+		st.state = rollingDisabled
+		return
+	}
+	st.scroll = 0
+	st.scrollPage = 0
+	st.state = rollToEnd
+}
+
 // upOneLine processes a user request to scroll up by one line.
 func (st *scrollTracker) upOneLine() {
 	st.scroll--
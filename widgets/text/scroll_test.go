@@ -330,3 +330,73 @@ func TestNormalizeScroll(t *testing.T) {
 		})
 	}
 }
+
+func TestScrollTrackerFollow(t *testing.T) {
+	tests := []struct {
+		desc   string
+		opts   *options
+		events func(*scrollTracker)
+		want   int
+	}{
+		{
+			desc: "enabling follow rolls to the last line",
+			opts: &options{},
+			events: func(st *scrollTracker) {
+				st.follow(true)
+			},
+			want: 2,
+		},
+		{
+			desc: "enabling follow resumes paused rolling",
+			opts: &options{rollContent: true},
+			events: func(st *scrollTracker) {
+				st.firstLine(4, 2)
+				st.upOneLine()
+				st.firstLine(4, 2)
+				st.follow(true)
+			},
+			want: 2,
+		},
+		{
+			desc: "enabling follow discards outstanding scroll requests",
+			opts: &options{},
+			events: func(st *scrollTracker) {
+				st.upOneLine()
+				st.follow(true)
+			},
+			want: 2,
+		},
+		{
+			desc: "disabling follow keeps the scrolling position",
+			opts: &options{rollContent: true},
+			events: func(st *scrollTracker) {
+				st.firstLine(3, 2)
+				st.follow(false)
+			},
+			want: 1,
+		},
+		{
+			desc: "disabling follow keeps outstanding scroll requests",
+			opts: &options{rollContent: true},
+			events: func(st *scrollTracker) {
+				st.firstLine(4, 2)
+				st.upOneLine()
+				st.follow(false)
+			},
+			want: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			st := newScrollTracker(tc.opts)
+			if tc.events != nil {
+				tc.events(st)
+			}
+			got := st.firstLine(4, 2)
+			if got != tc.want {
+				t.Errorf("firstLine => got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"image"
+	"strings"
+
+	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/private/canvas/buffer"
+)
+
+// selection.go contains code that selects a region of the text content using
+// the mouse.
+
+// selection is a region of the text content selected by dragging the mouse.
+// The selection refers to the cells of the content as it was written, so it
+// survives scrolling and re-wrapping of the content.
+type selection struct {
+	// anchor is the cell where the selection started.
+	anchor *buffer.Cell
+	// focus is the cell where the selection currently ends.
+	focus *buffer.Cell
+	// dragging indicates that the left mouse button is still held down.
+	dragging bool
+}
+
+// SelectedText returns the text content selected by the user dragging the
+// mouse across the widget. Returns an empty string if nothing is selected.
+// Only available with the Selectable option.
+func (t *Text) SelectedText() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	start, end, ok := t.selectedRange()
+	if !ok {
+		return ""
+	}
+	var b strings.Builder
+	for _, c := range t.content[start : end+1] {
+		b.WriteRune(c.Rune)
+	}
+	return b.String()
+}
+
+// indexCells maps the cells of the content to their indexes.
+func indexCells(content []*buffer.Cell) map[*buffer.Cell]int {
+	res := make(map[*buffer.Cell]int, len(content))
+	for i, c := range content {
+		res[c] = i
+	}
+	return res
+}
+
+// selectedRange returns the indexes of the first and the last selected cell
+// of the content. Returns false if nothing is selected.
+// Clears the selection if its cells are no longer part of the content.
+func (t *Text) selectedRange() (int, int, bool) {
+	if t.sel == nil {
+		return 0, 0, false
+	}
+	start, ok := t.cellIndex(t.sel.anchor)
+	if !ok {
+		t.sel = nil
+		return 0, 0, false
+	}
+	end, ok := t.cellIndex(t.sel.focus)
+	if !ok {
+		t.sel = nil
+		return 0, 0, false
+	}
+	if start > end {
+		start, end = end, start
+	}
+	return start, end, true
+}
+
+// cellIndex returns the index of the cell in the content. Indexes the content
+// again if it changed since the index was built. Returns false if the cell
+// isn't part of the content.
+func (t *Text) cellIndex(c *buffer.Cell) (int, bool) {
+	if i, ok := t.cellIdx[c]; ok && i < len(t.content) && t.content[i] == c {
+		return i, true
+	}
+	t.cellIdx = indexCells(t.content)
+	i, ok := t.cellIdx[c]
+	return i, ok
+}
+
+// cellAt returns the cell of the content drawn at the point of the canvas or
+// the last cell drawn left of the point on the same row. Returns nil if no
+// such cell exists.
+func (t *Text) cellAt(p image.Point) *buffer.Cell {
+	for x := p.X; x >= 0; x-- {
+		if c, ok := t.cellPoints[image.Point{x, p.Y}]; ok {
+			return c
+		}
+	}
+	return nil
+}
+
+// selectTo starts a new selection at the point or extends the selection
+// being dragged to the point.
+func (t *Text) selectTo(p image.Point) {
+	c := t.cellAt(p)
+	if t.sel == nil || !t.sel.dragging {
+		if c == nil {
+			t.sel = nil
+			return
+		}
+		t.sel = &selection{
+			anchor:   c,
+			focus:    c,
+			dragging: true,
+		}
+		return
+	}
+	if c != nil {
+		t.sel.focus = c
+	}
+}
+
+// endSelection ends dragging of the selection. A click without dragging
+// clears the selection.
+func (t *Text) endSelection() {
+	if t.sel == nil {
+		return
+	}
+	t.sel.dragging = false
+	if t.sel.anchor == t.sel.focus {
+		t.sel = nil
+	}
+}
+
+// drawOpts returns the cell options for drawing the cell of the content at
+// the specified index, the selected cells are highlighted.
+func (t *Text) drawOpts(c *buffer.Cell, idx, selStart, selEnd int, selected bool) []cell.Option {
+	if !selected || idx < selStart || idx > selEnd {
+		return []cell.Option{c.Opts}
+	}
+	return append([]cell.Option{c.Opts}, t.opts.selectedOpts...)
+}
@@ -0,0 +1,388 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"image"
+	"testing"
+
+	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/mouse"
+	"github.com/mum4k/termdash/private/canvas"
+	"github.com/mum4k/termdash/private/canvas/testcanvas"
+	"github.com/mum4k/termdash/private/draw"
+	"github.com/mum4k/termdash/private/draw/testdraw"
+	"github.com/mum4k/termdash/private/faketerm"
+	"github.com/mum4k/termdash/terminal/terminalapi"
+	"github.com/mum4k/termdash/widgetapi"
+)
+
+// press returns a mouse event pressing the left button at the point.
+func press(x, y int) *terminalapi.Mouse {
+	return &terminalapi.Mouse{Position: image.Point{x, y}, Button: mouse.ButtonLeft}
+}
+
+// release returns a mouse event releasing the buttons at the point.
+func release(x, y int) *terminalapi.Mouse {
+	return &terminalapi.Mouse{Position: image.Point{x, y}, Button: mouse.ButtonRelease}
+}
+
+func TestSelection(t *testing.T) {
+	tests := []struct {
+		desc string
+		// canvas is the area the widget is drawn onto.
+		canvas image.Rectangle
+		opts   []Option
+		// text is written into the widget.
+		text string
+		// events are sent to the widget, it is redrawn after each event.
+		events []terminalapi.Event
+		// writes are written into the widget after the events.
+		writes []string
+		want   string
+	}{
+		{
+			desc:   "nothing is selected without the Selectable option",
+			canvas: image.Rect(0, 0, 20, 1),
+			text:   "hello world",
+			events: []terminalapi.Event{
+				press(0, 0),
+				press(4, 0),
+				release(4, 0),
+			},
+			want: "",
+		},
+		{
+			desc:   "drag selection across a line",
+			canvas: image.Rect(0, 0, 20, 1),
+			opts:   []Option{Selectable()},
+			text:   "hello world",
+			events: []terminalapi.Event{
+				press(0, 0),
+				press(2, 0),
+				press(4, 0),
+				release(4, 0),
+			},
+			want: "hello",
+		},
+		{
+			desc:   "drag selection towards the start of the line",
+			canvas: image.Rect(0, 0, 20, 1),
+			opts:   []Option{Selectable()},
+			text:   "hello world",
+			events: []terminalapi.Event{
+				press(10, 0),
+				press(6, 0),
+				release(6, 0),
+			},
+			want: "world",
+		},
+		{
+			desc:   "drag selection across multiple lines",
+			canvas: image.Rect(0, 0, 10, 2),
+			opts:   []Option{Selectable()},
+			text:   "first\nsecond",
+			events: []terminalapi.Event{
+				press(2, 0),
+				press(2, 1),
+				release(2, 1),
+			},
+			want: "rst\nsec",
+		},
+		{
+			desc:   "dragging past the end of the line selects until the end",
+			canvas: image.Rect(0, 0, 10, 2),
+			opts:   []Option{Selectable()},
+			text:   "ab\ncd",
+			events: []terminalapi.Event{
+				press(0, 0),
+				press(8, 0),
+				release(8, 0),
+			},
+			want: "ab",
+		},
+		{
+			desc:   "selection across wrapped lines",
+			canvas: image.Rect(0, 0, 5, 2),
+			opts:   []Option{Selectable(), WrapAtRunes()},
+			text:   "abcdefgh",
+			events: []terminalapi.Event{
+				press(3, 0),
+				press(1, 1),
+				release(1, 1),
+			},
+			want: "defg",
+		},
+		{
+			desc:   "click without dragging clears the selection",
+			canvas: image.Rect(0, 0, 20, 1),
+			opts:   []Option{Selectable()},
+			text:   "hello world",
+			events: []terminalapi.Event{
+				press(0, 0),
+				press(4, 0),
+				release(4, 0),
+				press(2, 0),
+				release(2, 0),
+			},
+			want: "",
+		},
+		{
+			desc:   "new drag replaces the selection",
+			canvas: image.Rect(0, 0, 20, 1),
+			opts:   []Option{Selectable()},
+			text:   "hello world",
+			events: []terminalapi.Event{
+				press(0, 0),
+				press(4, 0),
+				release(4, 0),
+				press(6, 0),
+				press(10, 0),
+				release(10, 0),
+			},
+			want: "world",
+		},
+		{
+			desc:   "drag starting outside of the text selects nothing",
+			canvas: image.Rect(0, 0, 20, 2),
+			opts:   []Option{Selectable()},
+			text:   "hello",
+			events: []terminalapi.Event{
+				press(0, 1),
+				press(4, 0),
+				release(4, 0),
+			},
+			want: "",
+		},
+		{
+			desc:   "selection survives scrolling",
+			canvas: image.Rect(0, 0, 10, 2),
+			opts:   []Option{Selectable()},
+			text:   "one\ntwo\nthree",
+			events: []terminalapi.Event{
+				press(0, 1),
+				press(2, 1),
+				release(2, 1),
+				&terminalapi.Keyboard{Key: DefaultScrollKeyDown},
+			},
+			want: "two",
+		},
+		{
+			desc:   "selection can be extended after scrolling",
+			canvas: image.Rect(0, 0, 10, 2),
+			opts:   []Option{Selectable()},
+			text:   "one\ntwo\nthree",
+			events: []terminalapi.Event{
+				press(0, 0),
+				&terminalapi.Mouse{Button: DefaultScrollMouseButtonDown},
+				press(1, 1),
+				release(1, 1),
+			},
+			want: "one\ntwo\nth",
+		},
+		{
+			desc:   "selection accounts for the line numbers gutter",
+			canvas: image.Rect(0, 0, 10, 1),
+			opts:   []Option{Selectable(), ShowLineNumbers()},
+			text:   "abc",
+			events: []terminalapi.Event{
+				press(2, 0),
+				press(3, 0),
+				release(3, 0),
+			},
+			want: "ab",
+		},
+		{
+			desc:   "selection remains when text is appended",
+			canvas: image.Rect(0, 0, 20, 1),
+			opts:   []Option{Selectable()},
+			text:   "hello",
+			events: []terminalapi.Event{
+				press(1, 0),
+				press(3, 0),
+				release(3, 0),
+			},
+			writes: []string{" world"},
+			want:   "ell",
+		},
+		{
+			desc:   "selection is cleared when the selected text is dropped",
+			canvas: image.Rect(0, 0, 20, 1),
+			opts:   []Option{Selectable(), MaxTextCells(5)},
+			text:   "hello",
+			events: []terminalapi.Event{
+				press(1, 0),
+				press(3, 0),
+				release(3, 0),
+			},
+			writes: []string{"world"},
+			want:   "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			widget, err := New(tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if err := widget.Write(tc.text); err != nil {
+				t.Fatalf("Write => unexpected error: %v", err)
+			}
+
+			cvs, err := canvas.New(tc.canvas)
+			if err != nil {
+				t.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+			if err := widget.Draw(cvs, &widgetapi.Meta{}); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			for _, ev := range tc.events {
+				switch e := ev.(type) {
+				case *terminalapi.Mouse:
+					if err := widget.Mouse(e, &widgetapi.EventMeta{}); err != nil {
+						t.Fatalf("Mouse => unexpected error: %v", err)
+					}
+				case *terminalapi.Keyboard:
+					if err := widget.Keyboard(e, &widgetapi.EventMeta{}); err != nil {
+						t.Fatalf("Keyboard => unexpected error: %v", err)
+					}
+				default:
+					t.Fatalf("unsupported event type: %T", ev)
+				}
+				if err := widget.Draw(cvs, &widgetapi.Meta{}); err != nil {
+					t.Fatalf("Draw => unexpected error: %v", err)
+				}
+			}
+
+			for _, w := range tc.writes {
+				if err := widget.Write(w); err != nil {
+					t.Fatalf("Write => unexpected error: %v", err)
+				}
+			}
+
+			if got := widget.SelectedText(); got != tc.want {
+				t.Errorf("SelectedText => %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSelectionDraws(t *testing.T) {
+	tests := []struct {
+		desc   string
+		canvas image.Rectangle
+		opts   []Option
+		text   string
+		events []*terminalapi.Mouse
+		want   func(size image.Point) *faketerm.Terminal
+	}{
+		{
+			desc:   "selected cells are inverted by default",
+			canvas: image.Rect(0, 0, 10, 1),
+			opts:   []Option{Selectable()},
+			text:   "hello",
+			events: []*terminalapi.Mouse{
+				press(1, 0),
+				press(3, 0),
+				release(3, 0),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "h", image.Point{0, 0})
+				testdraw.MustText(c, "ell", image.Point{1, 0}, draw.TextCellOpts(cell.Inverse()))
+				testdraw.MustText(c, "o", image.Point{4, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "selected cells use custom cell options",
+			canvas: image.Rect(0, 0, 10, 2),
+			opts:   []Option{Selectable(cell.BgColor(cell.ColorBlue))},
+			text:   "ab\ncd",
+			events: []*terminalapi.Mouse{
+				press(1, 0),
+				press(0, 1),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "a", image.Point{0, 0})
+				testdraw.MustText(c, "b", image.Point{1, 0}, draw.TextCellOpts(cell.BgColor(cell.ColorBlue)))
+				testdraw.MustText(c, "c", image.Point{0, 1}, draw.TextCellOpts(cell.BgColor(cell.ColorBlue)))
+				testdraw.MustText(c, "d", image.Point{1, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			widget, err := New(tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if err := widget.Write(tc.text); err != nil {
+				t.Fatalf("Write => unexpected error: %v", err)
+			}
+
+			cvs, err := canvas.New(tc.canvas)
+			if err != nil {
+				t.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+			if err := widget.Draw(cvs, &widgetapi.Meta{}); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+			for _, m := range tc.events {
+				if err := widget.Mouse(m, &widgetapi.EventMeta{}); err != nil {
+					t.Fatalf("Mouse => unexpected error: %v", err)
+				}
+			}
+
+			cvs, err = canvas.New(tc.canvas)
+			if err != nil {
+				t.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+			if err := widget.Draw(cvs, &widgetapi.Meta{}); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			got, err := faketerm.New(cvs.Size())
+			if err != nil {
+				t.Fatalf("faketerm.New => unexpected error: %v", err)
+			}
+			if err := cvs.Apply(got); err != nil {
+				t.Fatalf("Apply => unexpected error: %v", err)
+			}
+			if diff := faketerm.Diff(tc.want(cvs.Size()), got); diff != "" {
+				t.Errorf("Draw => %v", diff)
+			}
+		})
+	}
+}
+
+func TestSelectableWantsMouse(t *testing.T) {
+	widget, err := New(Selectable(), DisableScrolling())
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+	if got, want := widget.Options().WantMouse, widgetapi.MouseScopeWidget; got != want {
+		t.Errorf("Options => WantMouse %v, want %v", got, want)
+	}
+}
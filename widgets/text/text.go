@@ -20,7 +20,10 @@ import (
 	"image"
 	"strings"
 	"sync"
+	"unicode/utf8"
 
+	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/mouse"
 	"github.com/mum4k/termdash/private/canvas"
 	"github.com/mum4k/termdash/private/canvas/buffer"
 	"github.com/mum4k/termdash/private/runewidth"
@@ -38,6 +41,10 @@ import (
 // By default the widget supports scrolling of content with either the keyboard
 // or mouse. See the options for the default keys and mouse buttons.
 //
+// Parts of the text can be made clickable, see AddLink.
+//
+// The text can be selected using the mouse, see the Selectable option.
+//
 // Implements widgetapi.Widget. This object is thread-safe.
 type Text struct {
 	// content is the text content that will be displayed in the widget as
@@ -45,6 +52,16 @@ type Text struct {
 	content []*buffer.Cell
 	// wrapped is the content wrapped to the current width of the canvas.
 	wrapped [][]*buffer.Cell
+	// lineNums are the line numbers of the wrapped lines. Only populated when
+	// the ShowLineNumbers option was provided. Zero for wrapped lines that
+	// don't start a new line of the content.
+	lineNums []int
+	// bodyTop is the row of the widget's canvas where the scrolled text
+	// starts, i.e. one if a header is pinned above it and zero otherwise.
+	bodyTop int
+	// gutterWidth is the width of the line numbers gutter or zero if line
+	// numbers aren't displayed. Updated when the content changes.
+	gutterWidth int
 
 	// scroll tracks scrolling the position.
 	scroll *scrollTracker
@@ -57,6 +74,27 @@ type Text struct {
 	// invalidated.
 	contentChanged bool
 
+	// links are the clickable links added by the user.
+	links []*link
+	// linksChanged indicates if links were added since the last drawing.
+	linksChanged bool
+	// linkCells maps cells of the content to the links they belong to.
+	linkCells map[*buffer.Cell]*link
+	// linkPoints maps points on the canvas to the links drawn there during
+	// the last drawing.
+	linkPoints map[image.Point]*link
+
+	// sel is the region of the content selected by the user or nil if
+	// nothing is selected.
+	sel *selection
+	// cellIdx maps cells of the content to their indexes. Only populated when
+	// the Selectable option was provided.
+	cellIdx map[*buffer.Cell]int
+	// cellPoints maps points on the canvas to the cells of the content drawn
+	// there during the last drawing. Only populated when the Selectable
+	// option was provided.
+	cellPoints map[image.Point]*buffer.Cell
+
 	// mu protects the Text widget.
 	mu sync.Mutex
 
@@ -70,10 +108,12 @@ func New(opts ...Option) (*Text, error) {
 	if err := opt.validate(); err != nil {
 		return nil, err
 	}
-	return &Text{
+	t := &Text{
 		scroll: newScrollTracker(opt),
 		opts:   opt,
-	}, nil
+	}
+	t.updateGutterWidth()
+	return t, nil
 }
 
 // Reset resets the widget back to empty content.
@@ -87,9 +127,24 @@ func (t *Text) Reset() {
 func (t *Text) reset() {
 	t.content = nil
 	t.wrapped = nil
+	t.lineNums = nil
+	t.updateGutterWidth()
 	t.scroll = newScrollTracker(t.opts)
 	t.lastWidth = 0
 	t.contentChanged = true
+	t.sel = nil
+}
+
+// SetFollow enables or disables following of the newest content, see the
+// Follow option. Enabling it scrolls the content to the last line, even if
+// following was paused by the user scrolling up. Disabling it keeps the
+// current scrolling position and applies any scrolling requested by the user
+// since the last draw.
+func (t *Text) SetFollow(follow bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.opts.rollContent = follow
+	t.scroll.follow(follow)
 }
 
 // contentCells calculates the number of cells the content takes to display on
@@ -109,22 +164,37 @@ func (t *Text) contentCells() int {
 //	' ', '\n'
 //
 // Any newline ('\n') characters are interpreted as newlines when displaying
-// the text.
+// the text. The text can also contain tab ('\t') characters if the ExpandTabs
+// option was provided.
 func (t *Text) Write(text string, wOpts ...WriteOption) error {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	if err := wrap.ValidText(text); err != nil {
+	valid := text
+	if t.opts.tabWidth > 0 {
+		valid = strings.ReplaceAll(text, "\t", " ")
+	}
+	if err := wrap.ValidText(valid); err != nil {
 		return err
 	}
 
 	opts := newWriteOptions(wOpts...)
+	runes := utf8.RuneCountInString(text)
+	if err := opts.validateRanges(runes); err != nil {
+		return err
+	}
 	if opts.replace {
 		t.reset()
 	}
 
 	truncated := truncateToCells(text, t.opts.maxTextCells)
-	textCells := runewidth.StringWidth(truncated, runewidth.CountAsWidth('\n', 1))
+	// Truncation removes runes from the start of the text, the indexes in the
+	// ranges refer to the text before truncation.
+	styled := t.styledRunes(truncated, runes-utf8.RuneCountInString(truncated))
+	var textCells int
+	for _, sr := range styled {
+		textCells += runewidth.RuneWidth(sr.r, runewidth.CountAsWidth('\n', 1))
+	}
 	contentCells := t.contentCells()
 	// If MaxTextCells has been set, limit the content if needed.
 	if t.opts.maxTextCells > 0 && contentCells+textCells > t.opts.maxTextCells {
@@ -132,13 +202,66 @@ func (t *Text) Write(text string, wOpts ...WriteOption) error {
 		t.content = t.content[diff:]
 	}
 
-	for _, r := range truncated {
-		t.content = append(t.content, buffer.NewCell(r, opts.cellOpts))
+	col := lastLineWidth(t.content)
+	for _, sr := range styled {
+		r := sr.r
+		cellOpts := append([]cell.Option{opts.cellOptsFor(sr.idx)}, sr.opts...)
+		switch {
+		case r == '\t':
+			for i := tabCells(col, t.opts.tabWidth); i > 0; i-- {
+				t.content = append(t.content, buffer.NewCell(' ', cellOpts...))
+				col++
+			}
+		case r == '\n':
+			t.content = append(t.content, buffer.NewCell(r, cellOpts...))
+			col = 0
+		default:
+			t.content = append(t.content, buffer.NewCell(r, cellOpts...))
+			col += runewidth.RuneWidth(r)
+		}
+	}
+	if t.opts.tabWidth > 0 && t.opts.maxTextCells > 0 {
+		// Expanded tabs can make the content go over the limit again.
+		for t.contentCells() > t.opts.maxTextCells {
+			t.content = t.content[1:]
+		}
+	}
+	if t.opts.maxLines > 0 {
+		t.content = dropLines(t.content, t.opts.maxLines)
 	}
+	t.updateGutterWidth()
 	t.contentChanged = true
 	return nil
 }
 
+// updateGutterWidth updates the width of the line numbers gutter after the
+// content changed. Caller must hold t.mu.
+func (t *Text) updateGutterWidth() {
+	if !t.opts.lineNumbers {
+		t.gutterWidth = 0
+		return
+	}
+	t.gutterWidth = gutterWidth(numberedLines(splitLines(t.content)))
+}
+
+// dropLines drops the oldest lines from the content so that at most max lines
+// remain. A trailing newline doesn't start a new line.
+func dropLines(content []*buffer.Cell, max int) []*buffer.Cell {
+	drop := numberedLines(splitLines(content)) - max
+	if drop <= 0 {
+		return content
+	}
+	for i, c := range content {
+		if c.Rune != '\n' {
+			continue
+		}
+		if drop--; drop == 0 {
+			return content[i+1:]
+		}
+	}
+	return content
+}
+
 // minLinesForMarkers are the minimum amount of lines required on the canvas in
 // order to draw the scroll markers ('⇧' and '⇩').
 const minLinesForMarkers = 3
@@ -181,10 +304,12 @@ func (t *Text) drawScrollDown(cvs *canvas.Canvas, cur image.Point, fromLine int)
 }
 
 // draw draws the text context on the canvas starting at the specified line.
-func (t *Text) draw(cvs *canvas.Canvas) error {
+// If the gutter canvas isn't nil, line numbers are drawn onto it.
+func (t *Text) draw(cvs, gutter *canvas.Canvas) error {
 	var cur image.Point // Tracks the current drawing position on the canvas.
 	height := cvs.Area().Dy()
 	fromLine := t.scroll.firstLine(len(t.wrapped), height)
+	selStart, selEnd, selected := t.selectedRange()
 
 	for _, line := range t.wrapped[fromLine:] {
 		// Scroll up marker.
@@ -207,6 +332,13 @@ func (t *Text) draw(cvs *canvas.Canvas) error {
 			break // Skip all lines falling after (under) the canvas.
 		}
 
+		if gutter != nil {
+			num := t.lineNums[fromLine+cur.Y]
+			if err := drawLineNumber(gutter, cur.Y, t.gutterWidth, num, t.opts.lineNumbersOpts...); err != nil {
+				return err
+			}
+		}
+
 		for _, cell := range line {
 			tr, err := lineTrim(cvs, cur, cell.Rune, t.opts)
 			if err != nil {
@@ -214,13 +346,27 @@ func (t *Text) draw(cvs *canvas.Canvas) error {
 			}
 			cur = tr.curPoint
 			if tr.trimmed {
+				// The trim character might have replaced a cell of a link.
+				delete(t.linkPoints, image.Point{cvs.Area().Dx() - 1 + t.gutterWidth, cur.Y + t.bodyTop})
 				break // Skip over any characters trimmed on the current line.
 			}
 
-			cells, err := cvs.SetCell(cur, cell.Rune, cell.Opts)
+			cells, err := cvs.SetCell(cur, cell.Rune, t.drawOpts(cell, t.cellIdx[cell], selStart, selEnd, selected)...)
 			if err != nil {
 				return err
 			}
+			if t.opts.selectable {
+				for i := 0; i < cells; i++ {
+					t.cellPoints[image.Point{cur.X + i + t.gutterWidth, cur.Y + t.bodyTop}] = cell
+				}
+			}
+			if l, ok := t.linkCells[cell]; ok {
+				for i := 0; i < cells; i++ {
+					// Links are hit-tested on the widget's canvas, which
+					// includes the line numbers gutter and the header.
+					t.linkPoints[image.Point{cur.X + i + t.gutterWidth, cur.Y + t.bodyTop}] = l
+				}
+			}
 			cur = image.Point{cur.X + cells, cur.Y} // Move within the same line.
 		}
 		cur = image.Point{0, cur.Y + 1} // Move to the next line.
@@ -228,35 +374,139 @@ func (t *Text) draw(cvs *canvas.Canvas) error {
 	return nil
 }
 
+// visibleContent returns the content that is wrapped and displayed, i.e.
+// without the trailing spaces if the TrimTrailingSpace option was provided.
+func (t *Text) visibleContent() []*buffer.Cell {
+	if !t.opts.trimSpace {
+		return t.content
+	}
+	return trimTrailingSpace(t.content)
+}
+
+// wrap wraps the content to the specified width.
+func (t *Text) wrap(width int) error {
+	content := t.visibleContent()
+	if !t.opts.lineNumbers {
+		wr, err := wrap.Cells(content, width, t.opts.wrapMode)
+		if err != nil {
+			return err
+		}
+		t.wrapped = wr
+		return nil
+	}
+
+	wr, nums, err := wrapNumbered(content, width, t.opts.wrapMode)
+	if err != nil {
+		return err
+	}
+	t.wrapped = wr
+	t.lineNums = nums
+	return nil
+}
+
 // Draw draws the text onto the canvas.
 // Implements widgetapi.Widget.Draw.
 func (t *Text) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	width := cvs.Area().Dx()
+	width := cvs.Area().Dx() - t.gutterWidth
 	if len(t.content) > 0 && (t.contentChanged || t.lastWidth != width) {
 		// The previous text preprocessing (line wrapping) is invalidated when
 		// new text is added or the width of the canvas changed.
-		wr, err := wrap.Cells(t.content, width, t.opts.wrapMode)
-		if err != nil {
+		if err := t.wrap(width); err != nil {
 			return err
 		}
-		t.wrapped = wr
 	}
 	t.lastWidth = width
 
-	if len(t.wrapped) == 0 {
+	if t.contentChanged || t.linksChanged {
+		t.linkCells = resolveLinks(t.content, t.links)
+		t.linksChanged = false
+	}
+	t.linkPoints = map[image.Point]*link{}
+	if t.opts.selectable {
+		if t.contentChanged {
+			t.cellIdx = indexCells(t.content)
+		}
+		t.cellPoints = map[image.Point]*buffer.Cell{}
+	}
+
+	bodyAr, err := t.drawPinned(cvs)
+	if err != nil {
+		return err
+	}
+	t.bodyTop = bodyAr.Min.Y
+	if len(t.wrapped) == 0 || bodyAr.Dy() < 1 {
 		return nil // Nothing to draw if there's no text.
 	}
 
-	if err := t.draw(cvs); err != nil {
+	body := cvs
+	if bodyAr != cvs.Area() {
+		// The text scrolls between the pinned header and footer.
+		b, err := canvas.New(bodyAr)
+		if err != nil {
+			return err
+		}
+		body = b
+	}
+	if err := t.drawBody(body); err != nil {
 		return err
 	}
+	if body != cvs {
+		if err := body.CopyTo(cvs); err != nil {
+			return err
+		}
+	}
 	t.contentChanged = false
 	return nil
 }
 
+// drawBody draws the scrolled text and the line numbers gutter if requested
+// onto the canvas.
+func (t *Text) drawBody(cvs *canvas.Canvas) error {
+	if !t.opts.lineNumbers {
+		return t.draw(cvs, nil)
+	}
+
+	textCvs, err := canvas.New(image.Rect(t.gutterWidth, 0, cvs.Area().Dx(), cvs.Area().Dy()))
+	if err != nil {
+		return err
+	}
+	if err := t.draw(textCvs, cvs); err != nil {
+		return err
+	}
+	return textCvs.CopyTo(cvs)
+}
+
+// ContentSize returns the size of the content when wrapped to the specified
+// width. The height is the number of the wrapped lines, the width is the
+// width of the longest of them, including the line numbers gutter if any.
+// Returns a zero size if the width is too small to fit any text.
+// Implements widgetapi.ContentMeasurer.ContentSize.
+func (t *Text) ContentSize(width int) image.Point {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	gutter := t.gutterWidth
+	wrapped, err := wrap.Cells(t.visibleContent(), width-gutter, t.opts.wrapMode)
+	if err != nil || len(wrapped) == 0 {
+		return image.ZP
+	}
+
+	var maxWidth int
+	for _, line := range wrapped {
+		var w int
+		for _, c := range line {
+			w += runewidth.RuneWidth(c.Rune)
+		}
+		if w > maxWidth {
+			maxWidth = w
+		}
+	}
+	return image.Point{maxWidth + gutter, len(wrapped)}
+}
+
 // Keyboard implements widgetapi.Widget.Keyboard.
 func (t *Text) Keyboard(k *terminalapi.Keyboard, meta *widgetapi.EventMeta) error {
 	t.mu.Lock()
@@ -278,8 +528,26 @@ func (t *Text) Keyboard(k *terminalapi.Keyboard, meta *widgetapi.EventMeta) erro
 // Mouse implements widgetapi.Widget.Mouse.
 func (t *Text) Mouse(m *terminalapi.Mouse, meta *widgetapi.EventMeta) error {
 	t.mu.Lock()
+	if l, ok := t.linkPoints[m.Position]; ok && m.Button == mouse.ButtonLeft {
+		t.mu.Unlock()
+		// Mutex must be released when calling the callback.
+		// Users might call widget or container methods from the callback.
+		return l.onClick()
+	}
 	defer t.mu.Unlock()
 
+	if t.opts.selectable {
+		switch m.Button {
+		case mouse.ButtonLeft:
+			t.selectTo(m.Position)
+		case mouse.ButtonRelease:
+			t.endSelection()
+		}
+	}
+
+	if t.opts.disableScrolling {
+		return nil
+	}
 	switch b := m.Button; {
 	case b == t.opts.mouseUpButton:
 		t.scroll.upOneLine()
@@ -291,6 +559,9 @@ func (t *Text) Mouse(m *terminalapi.Mouse, meta *widgetapi.EventMeta) error {
 
 // Options of the widget
 func (t *Text) Options() widgetapi.Options {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
 	var ks widgetapi.KeyScope
 	var ms widgetapi.MouseScope
 	if t.opts.disableScrolling {
@@ -300,10 +571,16 @@ func (t *Text) Options() widgetapi.Options {
 		ks = widgetapi.KeyScopeFocused
 		ms = widgetapi.MouseScopeWidget
 	}
+	if len(t.links) > 0 || t.opts.selectable {
+		// Links are clickable and the text is selectable even if scrolling is
+		// disabled.
+		ms = widgetapi.MouseScopeWidget
+	}
 
 	return widgetapi.Options{
-		// At least one line with at least one full-width rune.
-		MinimumSize:  image.Point{1, 1},
+		// At least one line with at least one full-width rune next to the
+		// line numbers gutter and the pinned lines.
+		MinimumSize:  image.Point{1 + t.gutterWidth, 1 + t.opts.pinnedLines()},
 		WantMouse:    ms,
 		WantKeyboard: ks,
 	}
@@ -65,6 +65,28 @@ func TestTextDraws(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			desc: "fails when MaxLines is negative",
+			opts: []Option{
+				MaxLines(-1),
+			},
+			canvas: image.Rect(0, 0, 1, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc: "fails when ExpandTabs is negative",
+			opts: []Option{
+				ExpandTabs(-1),
+			},
+			canvas: image.Rect(0, 0, 1, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
 		{
 			desc: "fails when scroll mouse buttons aren't unique",
 			opts: []Option{
@@ -185,6 +207,30 @@ func TestTextDraws(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:   "default color doesn't force a color",
+			canvas: image.Rect(0, 0, 12, 1),
+			writes: func(widget *Text) error {
+				if err := widget.Write("red", WriteCellOpts(cell.FgColor(cell.ColorRed))); err != nil {
+					return err
+				}
+				return widget.Write("plain", WriteCellOpts(
+					cell.FgColor(cell.ColorDefault),
+					cell.BgColor(cell.ColorDefault),
+				))
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "red", image.Point{0, 0},
+					draw.TextCellOpts(cell.FgColor(cell.ColorRed)),
+				)
+				testdraw.MustText(c, "plain", image.Point{3, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc:   "respects newlines in the input text",
 			canvas: image.Rect(0, 0, 10, 10),
@@ -224,6 +270,135 @@ func TestTextDraws(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc:   "write fails when a range exceeds the text",
+			canvas: image.Rect(0, 0, 10, 1),
+			writes: func(widget *Text) error {
+				return widget.Write("hello", WriteRanges([]Range{
+					{From: 3, To: 6, Opts: []cell.Option{cell.FgColor(cell.ColorRed)}},
+				}))
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantWriteErr: true,
+		},
+		{
+			desc:   "write fails when a range starts before the text",
+			canvas: image.Rect(0, 0, 10, 1),
+			writes: func(widget *Text) error {
+				return widget.Write("hello", WriteRanges([]Range{
+					{From: -1, To: 2, Opts: []cell.Option{cell.FgColor(cell.ColorRed)}},
+				}))
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantWriteErr: true,
+		},
+		{
+			desc:   "write fails when a range starts after its end",
+			canvas: image.Rect(0, 0, 10, 1),
+			writes: func(widget *Text) error {
+				return widget.Write("hello", WriteRanges([]Range{
+					{From: 3, To: 2, Opts: []cell.Option{cell.FgColor(cell.ColorRed)}},
+				}))
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantWriteErr: true,
+		},
+		{
+			desc:   "range colors a substring in the middle of the text",
+			canvas: image.Rect(0, 0, 12, 1),
+			writes: func(widget *Text) error {
+				return widget.Write("hello world!", WriteRanges([]Range{
+					{From: 6, To: 11, Opts: []cell.Option{cell.FgColor(cell.ColorRed)}},
+				}))
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "hello ", image.Point{0, 0})
+				testdraw.MustText(c, "world", image.Point{6, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorRed)))
+				testdraw.MustText(c, "!", image.Point{11, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "range indexes refer to runes",
+			canvas: image.Rect(0, 0, 12, 1),
+			writes: func(widget *Text) error {
+				return widget.Write("héllo wörld!", WriteRanges([]Range{
+					{From: 6, To: 11, Opts: []cell.Option{cell.FgColor(cell.ColorRed)}},
+				}))
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "héllo ", image.Point{0, 0})
+				testdraw.MustText(c, "wörld", image.Point{6, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorRed)))
+				testdraw.MustText(c, "!", image.Point{11, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "ranges apply on top of the write cell options and of each other",
+			canvas: image.Rect(0, 0, 6, 1),
+			writes: func(widget *Text) error {
+				return widget.Write(
+					"abcdef",
+					WriteCellOpts(cell.BgColor(cell.ColorBlue)),
+					WriteRanges([]Range{
+						{From: 1, To: 4, Opts: []cell.Option{cell.FgColor(cell.ColorRed)}},
+						{From: 3, To: 5, Opts: []cell.Option{cell.FgColor(cell.ColorGreen)}},
+					}),
+				)
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "a", image.Point{0, 0}, draw.TextCellOpts(cell.BgColor(cell.ColorBlue)))
+				testdraw.MustText(c, "bc", image.Point{1, 0}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorRed),
+					cell.BgColor(cell.ColorBlue),
+				))
+				testdraw.MustText(c, "de", image.Point{3, 0}, draw.TextCellOpts(
+					cell.FgColor(cell.ColorGreen),
+					cell.BgColor(cell.ColorBlue),
+				))
+				testdraw.MustText(c, "f", image.Point{5, 0}, draw.TextCellOpts(cell.BgColor(cell.ColorBlue)))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "ranges apply to the text truncated by MaxTextCells",
+			canvas: image.Rect(0, 0, 10, 1),
+			opts: []Option{
+				MaxTextCells(7),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("hello world", WriteRanges([]Range{
+					{From: 6, To: 11, Opts: []cell.Option{cell.FgColor(cell.ColorRed)}},
+				}))
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "o ", image.Point{0, 0})
+				testdraw.MustText(c, "world", image.Point{2, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorRed)))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
 		{
 			desc:   "trims long lines",
 			canvas: image.Rect(0, 0, 10, 4),
@@ -644,39 +819,39 @@ func TestTextDraws(t *testing.T) {
 			},
 		},
 		{
-			desc:   "scrolls up on mouse wheel up a line at a time",
+			desc:   "follows the newest content on write",
 			canvas: image.Rect(0, 0, 10, 3),
 			opts: []Option{
-				RollContent(),
+				Follow(),
 			},
 			writes: func(widget *Text) error {
 				return widget.Write("line0\nline1\nline2\nline3\nline4")
 			},
 			events: func(widget *Text) {
-				// Draw once to roll the content all the way down before we scroll.
+				// Draw once to roll the content all the way down before writing.
 				if err := widget.Draw(testcanvas.MustNew(image.Rect(0, 0, 10, 3)), &widgetapi.Meta{}); err != nil {
 					panic(err)
 				}
-				widget.Mouse(&terminalapi.Mouse{
-					Button: mouse.ButtonWheelUp,
-				}, &widgetapi.EventMeta{})
+				if err := widget.Write("\nline5"); err != nil {
+					panic(err)
+				}
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				testdraw.MustText(c, "⇧", image.Point{0, 0})
-				testdraw.MustText(c, "line2", image.Point{0, 1})
-				testdraw.MustText(c, "⇩", image.Point{0, 2})
+				testdraw.MustText(c, "line4", image.Point{0, 1})
+				testdraw.MustText(c, "line5", image.Point{0, 2})
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
 		},
 		{
-			desc:   "scrolls up on up arrow a line at a time",
+			desc:   "following pauses when the user scrolls up",
 			canvas: image.Rect(0, 0, 10, 3),
 			opts: []Option{
-				RollContent(),
+				Follow(),
 			},
 			writes: func(widget *Text) error {
 				return widget.Write("line0\nline1\nline2\nline3\nline4")
@@ -686,9 +861,15 @@ func TestTextDraws(t *testing.T) {
 				if err := widget.Draw(testcanvas.MustNew(image.Rect(0, 0, 10, 3)), &widgetapi.Meta{}); err != nil {
 					panic(err)
 				}
-				widget.Keyboard(&terminalapi.Keyboard{
-					Key: keyboard.KeyArrowUp,
+				widget.Mouse(&terminalapi.Mouse{
+					Button: mouse.ButtonWheelUp,
 				}, &widgetapi.EventMeta{})
+				if err := widget.Draw(testcanvas.MustNew(image.Rect(0, 0, 10, 3)), &widgetapi.Meta{}); err != nil {
+					panic(err)
+				}
+				if err := widget.Write("\nline5"); err != nil {
+					panic(err)
+				}
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
@@ -702,10 +883,10 @@ func TestTextDraws(t *testing.T) {
 			},
 		},
 		{
-			desc:   "scrolls up on pageUp a page at a time",
+			desc:   "following resumes when the user scrolls back to the last line",
 			canvas: image.Rect(0, 0, 10, 3),
 			opts: []Option{
-				RollContent(),
+				Follow(),
 			},
 			writes: func(widget *Text) error {
 				return widget.Write("line0\nline1\nline2\nline3\nline4")
@@ -715,57 +896,61 @@ func TestTextDraws(t *testing.T) {
 				if err := widget.Draw(testcanvas.MustNew(image.Rect(0, 0, 10, 3)), &widgetapi.Meta{}); err != nil {
 					panic(err)
 				}
-				widget.Keyboard(&terminalapi.Keyboard{
-					Key: keyboard.KeyPgUp,
+				widget.Mouse(&terminalapi.Mouse{
+					Button: mouse.ButtonWheelUp,
+				}, &widgetapi.EventMeta{})
+				if err := widget.Draw(testcanvas.MustNew(image.Rect(0, 0, 10, 3)), &widgetapi.Meta{}); err != nil {
+					panic(err)
+				}
+				widget.Mouse(&terminalapi.Mouse{
+					Button: mouse.ButtonWheelDown,
 				}, &widgetapi.EventMeta{})
+				if err := widget.Draw(testcanvas.MustNew(image.Rect(0, 0, 10, 3)), &widgetapi.Meta{}); err != nil {
+					panic(err)
+				}
+				if err := widget.Write("\nline5"); err != nil {
+					panic(err)
+				}
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
-				testdraw.MustText(c, "line0", image.Point{0, 0})
-				testdraw.MustText(c, "line1", image.Point{0, 1})
-				testdraw.MustText(c, "⇩", image.Point{0, 2})
+				testdraw.MustText(c, "⇧", image.Point{0, 0})
+				testdraw.MustText(c, "line4", image.Point{0, 1})
+				testdraw.MustText(c, "line5", image.Point{0, 2})
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
 		},
 		{
-			desc:   "scrolls up using custom mouse button a line at a time",
+			desc:   "enabling following at runtime scrolls to the last line",
 			canvas: image.Rect(0, 0, 10, 3),
-			opts: []Option{
-				RollContent(),
-				ScrollMouseButtons(mouse.ButtonLeft, mouse.ButtonRight),
-			},
 			writes: func(widget *Text) error {
 				return widget.Write("line0\nline1\nline2\nline3\nline4")
 			},
 			events: func(widget *Text) {
-				// Draw once to roll the content all the way down before we scroll.
 				if err := widget.Draw(testcanvas.MustNew(image.Rect(0, 0, 10, 3)), &widgetapi.Meta{}); err != nil {
 					panic(err)
 				}
-				widget.Mouse(&terminalapi.Mouse{
-					Button: mouse.ButtonLeft,
-				}, &widgetapi.EventMeta{})
+				widget.SetFollow(true)
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				testdraw.MustText(c, "⇧", image.Point{0, 0})
-				testdraw.MustText(c, "line2", image.Point{0, 1})
-				testdraw.MustText(c, "⇩", image.Point{0, 2})
+				testdraw.MustText(c, "line3", image.Point{0, 1})
+				testdraw.MustText(c, "line4", image.Point{0, 2})
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
 		},
 		{
-			desc:   "scrolls up using custom key a line at a time",
+			desc:   "enabling following at runtime resumes paused following",
 			canvas: image.Rect(0, 0, 10, 3),
 			opts: []Option{
-				ScrollKeys('u', 'd', 'k', 'l'),
-				RollContent(),
+				Follow(),
 			},
 			writes: func(widget *Text) error {
 				return widget.Write("line0\nline1\nline2\nline3\nline4")
@@ -775,217 +960,1011 @@ func TestTextDraws(t *testing.T) {
 				if err := widget.Draw(testcanvas.MustNew(image.Rect(0, 0, 10, 3)), &widgetapi.Meta{}); err != nil {
 					panic(err)
 				}
-				widget.Keyboard(&terminalapi.Keyboard{
-					Key: 'u',
+				widget.Mouse(&terminalapi.Mouse{
+					Button: mouse.ButtonWheelUp,
 				}, &widgetapi.EventMeta{})
+				if err := widget.Draw(testcanvas.MustNew(image.Rect(0, 0, 10, 3)), &widgetapi.Meta{}); err != nil {
+					panic(err)
+				}
+				widget.SetFollow(true)
+				if err := widget.Write("\nline5"); err != nil {
+					panic(err)
+				}
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
 				testdraw.MustText(c, "⇧", image.Point{0, 0})
-				testdraw.MustText(c, "line2", image.Point{0, 1})
-				testdraw.MustText(c, "⇩", image.Point{0, 2})
+				testdraw.MustText(c, "line4", image.Point{0, 1})
+				testdraw.MustText(c, "line5", image.Point{0, 2})
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
 		},
 		{
-			desc:   "scrolls up using custom key a page at a time",
+			desc:   "disabling following at runtime keeps the scrolling position",
 			canvas: image.Rect(0, 0, 10, 3),
 			opts: []Option{
-				ScrollKeys('u', 'd', 'k', 'l'),
-				RollContent(),
+				Follow(),
 			},
 			writes: func(widget *Text) error {
 				return widget.Write("line0\nline1\nline2\nline3\nline4")
 			},
 			events: func(widget *Text) {
-				// Draw once to roll the content all the way down before we scroll.
+				// Draw once to roll the content all the way down.
 				if err := widget.Draw(testcanvas.MustNew(image.Rect(0, 0, 10, 3)), &widgetapi.Meta{}); err != nil {
 					panic(err)
 				}
-				widget.Keyboard(&terminalapi.Keyboard{
-					Key: 'k',
-				}, &widgetapi.EventMeta{})
+				widget.SetFollow(false)
+				if err := widget.Write("\nline5"); err != nil {
+					panic(err)
+				}
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
 
-				testdraw.MustText(c, "line0", image.Point{0, 0})
-				testdraw.MustText(c, "line1", image.Point{0, 1})
+				testdraw.MustText(c, "⇧", image.Point{0, 0})
+				testdraw.MustText(c, "line3", image.Point{0, 1})
 				testdraw.MustText(c, "⇩", image.Point{0, 2})
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
 		},
 		{
-			desc:   "tests maxTextCells length being applied - multiline",
+			desc:   "scrolls up on mouse wheel up a line at a time",
 			canvas: image.Rect(0, 0, 10, 3),
 			opts: []Option{
-				MaxTextCells(10),
 				RollContent(),
 			},
 			writes: func(widget *Text) error {
 				return widget.Write("line0\nline1\nline2\nline3\nline4")
 			},
+			events: func(widget *Text) {
+				// Draw once to roll the content all the way down before we scroll.
+				if err := widget.Draw(testcanvas.MustNew(image.Rect(0, 0, 10, 3)), &widgetapi.Meta{}); err != nil {
+					panic(err)
+				}
+				widget.Mouse(&terminalapi.Mouse{
+					Button: mouse.ButtonWheelUp,
+				}, &widgetapi.EventMeta{})
+			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
-				// \n still counts as a chacter in the string length
-				testdraw.MustText(c, "ine3", image.Point{0, 0})
-				testdraw.MustText(c, "line4", image.Point{0, 1})
+
+				testdraw.MustText(c, "⇧", image.Point{0, 0})
+				testdraw.MustText(c, "line2", image.Point{0, 1})
+				testdraw.MustText(c, "⇩", image.Point{0, 2})
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
 		},
 		{
-			desc:   "tests maxTextCells - multiple writes - first one fits",
+			desc:   "scrolls up on up arrow a line at a time",
 			canvas: image.Rect(0, 0, 10, 3),
 			opts: []Option{
-				MaxTextCells(10),
 				RollContent(),
 			},
 			writes: func(widget *Text) error {
-				if err := widget.Write("line0\nline"); err != nil {
-					return err
+				return widget.Write("line0\nline1\nline2\nline3\nline4")
+			},
+			events: func(widget *Text) {
+				// Draw once to roll the content all the way down before we scroll.
+				if err := widget.Draw(testcanvas.MustNew(image.Rect(0, 0, 10, 3)), &widgetapi.Meta{}); err != nil {
+					panic(err)
 				}
-				return widget.Write("1\nline2\nline3\nline4")
+				widget.Keyboard(&terminalapi.Keyboard{
+					Key: keyboard.KeyArrowUp,
+				}, &widgetapi.EventMeta{})
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
-				// \n still counts as a chacter in the string length
-				testdraw.MustText(c, "ine3", image.Point{0, 0})
-				testdraw.MustText(c, "line4", image.Point{0, 1})
+
+				testdraw.MustText(c, "⇧", image.Point{0, 0})
+				testdraw.MustText(c, "line2", image.Point{0, 1})
+				testdraw.MustText(c, "⇩", image.Point{0, 2})
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
 		},
 		{
-			desc:   "tests maxTextCells - multiple writes - first one does not fit",
+			desc:   "scrolls up on pageUp a page at a time",
 			canvas: image.Rect(0, 0, 10, 3),
 			opts: []Option{
-				MaxTextCells(10),
 				RollContent(),
 			},
 			writes: func(widget *Text) error {
-				if err := widget.Write("line0\nline123"); err != nil {
-					return err
+				return widget.Write("line0\nline1\nline2\nline3\nline4")
+			},
+			events: func(widget *Text) {
+				// Draw once to roll the content all the way down before we scroll.
+				if err := widget.Draw(testcanvas.MustNew(image.Rect(0, 0, 10, 3)), &widgetapi.Meta{}); err != nil {
+					panic(err)
 				}
-				return widget.Write("1\nline2\nline3\nline4")
+				widget.Keyboard(&terminalapi.Keyboard{
+					Key: keyboard.KeyPgUp,
+				}, &widgetapi.EventMeta{})
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
-				testdraw.MustText(c, "ine3", image.Point{0, 0})
-				testdraw.MustText(c, "line4", image.Point{0, 1})
+
+				testdraw.MustText(c, "line0", image.Point{0, 0})
+				testdraw.MustText(c, "line1", image.Point{0, 1})
+				testdraw.MustText(c, "⇩", image.Point{0, 2})
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
 		},
 		{
-			desc:   "tests maxTextCells - accounts for pre-existing full-width runes on the content",
+			desc:   "scrolls up using custom mouse button a line at a time",
+			canvas: image.Rect(0, 0, 10, 3),
+			opts: []Option{
+				RollContent(),
+				ScrollMouseButtons(mouse.ButtonLeft, mouse.ButtonRight),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("line0\nline1\nline2\nline3\nline4")
+			},
+			events: func(widget *Text) {
+				// Draw once to roll the content all the way down before we scroll.
+				if err := widget.Draw(testcanvas.MustNew(image.Rect(0, 0, 10, 3)), &widgetapi.Meta{}); err != nil {
+					panic(err)
+				}
+				widget.Mouse(&terminalapi.Mouse{
+					Button: mouse.ButtonLeft,
+				}, &widgetapi.EventMeta{})
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "⇧", image.Point{0, 0})
+				testdraw.MustText(c, "line2", image.Point{0, 1})
+				testdraw.MustText(c, "⇩", image.Point{0, 2})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "scrolls up using custom key a line at a time",
+			canvas: image.Rect(0, 0, 10, 3),
+			opts: []Option{
+				ScrollKeys('u', 'd', 'k', 'l'),
+				RollContent(),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("line0\nline1\nline2\nline3\nline4")
+			},
+			events: func(widget *Text) {
+				// Draw once to roll the content all the way down before we scroll.
+				if err := widget.Draw(testcanvas.MustNew(image.Rect(0, 0, 10, 3)), &widgetapi.Meta{}); err != nil {
+					panic(err)
+				}
+				widget.Keyboard(&terminalapi.Keyboard{
+					Key: 'u',
+				}, &widgetapi.EventMeta{})
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "⇧", image.Point{0, 0})
+				testdraw.MustText(c, "line2", image.Point{0, 1})
+				testdraw.MustText(c, "⇩", image.Point{0, 2})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "scrolls up using custom key a page at a time",
+			canvas: image.Rect(0, 0, 10, 3),
+			opts: []Option{
+				ScrollKeys('u', 'd', 'k', 'l'),
+				RollContent(),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("line0\nline1\nline2\nline3\nline4")
+			},
+			events: func(widget *Text) {
+				// Draw once to roll the content all the way down before we scroll.
+				if err := widget.Draw(testcanvas.MustNew(image.Rect(0, 0, 10, 3)), &widgetapi.Meta{}); err != nil {
+					panic(err)
+				}
+				widget.Keyboard(&terminalapi.Keyboard{
+					Key: 'k',
+				}, &widgetapi.EventMeta{})
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "line0", image.Point{0, 0})
+				testdraw.MustText(c, "line1", image.Point{0, 1})
+				testdraw.MustText(c, "⇩", image.Point{0, 2})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "tests maxTextCells length being applied - multiline",
+			canvas: image.Rect(0, 0, 10, 3),
+			opts: []Option{
+				MaxTextCells(10),
+				RollContent(),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("line0\nline1\nline2\nline3\nline4")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				// \n still counts as a chacter in the string length
+				testdraw.MustText(c, "ine3", image.Point{0, 0})
+				testdraw.MustText(c, "line4", image.Point{0, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "tests maxTextCells - multiple writes - first one fits",
+			canvas: image.Rect(0, 0, 10, 3),
+			opts: []Option{
+				MaxTextCells(10),
+				RollContent(),
+			},
+			writes: func(widget *Text) error {
+				if err := widget.Write("line0\nline"); err != nil {
+					return err
+				}
+				return widget.Write("1\nline2\nline3\nline4")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				// \n still counts as a chacter in the string length
+				testdraw.MustText(c, "ine3", image.Point{0, 0})
+				testdraw.MustText(c, "line4", image.Point{0, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "tests maxTextCells - multiple writes - first one does not fit",
+			canvas: image.Rect(0, 0, 10, 3),
+			opts: []Option{
+				MaxTextCells(10),
+				RollContent(),
+			},
+			writes: func(widget *Text) error {
+				if err := widget.Write("line0\nline123"); err != nil {
+					return err
+				}
+				return widget.Write("1\nline2\nline3\nline4")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "ine3", image.Point{0, 0})
+				testdraw.MustText(c, "line4", image.Point{0, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "tests maxTextCells - accounts for pre-existing full-width runes on the content",
 			canvas: image.Rect(0, 0, 10, 3),
 			opts: []Option{
 				MaxTextCells(3),
 				RollContent(),
 			},
 			writes: func(widget *Text) error {
-				if err := widget.Write("界"); err != nil {
-					return err
-				}
+				if err := widget.Write("界"); err != nil {
+					return err
+				}
+				return widget.Write("ab")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "ab", image.Point{0, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "tests maxTextCells exact length of 5",
+			canvas: image.Rect(0, 0, 10, 1),
+			opts: []Option{
+				RollContent(),
+				MaxTextCells(5),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("12345")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				// Line return (\n) counts as one character
+				testdraw.MustText(
+					c,
+					"12345",
+					image.Point{0, 0},
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "tests maxTextCells partial bufffer replacement",
+			canvas: image.Rect(0, 0, 10, 1),
+			opts: []Option{
+				RollContent(),
+				MaxTextCells(10),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("hello wor你12345678")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(
+					c,
+					"你12345678",
+					image.Point{0, 0},
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "tests maxTextCells length not being limited",
+			canvas: image.Rect(0, 0, 72, 1),
+			opts: []Option{
+				RollContent(),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("1234567890abcdefghijklmnopqrstuvwxyz")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(
+					c,
+					"1234567890abcdefghijklmnopqrstuvwxyz",
+					image.Point{0, 0},
+				)
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "tests maxTextCells length being applied - single line",
+			canvas: image.Rect(0, 0, 10, 3),
+			opts: []Option{
+				MaxTextCells(5),
+				RollContent(),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("1234567890abcdefghijklmnopqrstuvwxyz")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "vwxyz", image.Point{0, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "MaxLines drops the oldest lines",
+			canvas: image.Rect(0, 0, 10, 4),
+			opts: []Option{
+				MaxLines(2),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("line0\nline1\nline2\nline3")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "line2", image.Point{0, 0})
+				testdraw.MustText(c, "line3", image.Point{0, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "MaxLines drops the oldest lines across multiple writes",
+			canvas: image.Rect(0, 0, 10, 4),
+			opts: []Option{
+				MaxLines(2),
+				RollContent(),
+			},
+			writes: func(widget *Text) error {
+				for _, l := range []string{"line0\n", "line1\n", "line2\n", "line3\n"} {
+					if err := widget.Write(l); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "line2", image.Point{0, 0})
+				testdraw.MustText(c, "line3", image.Point{0, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "MaxLines keeps content that fits",
+			canvas: image.Rect(0, 0, 10, 4),
+			opts: []Option{
+				MaxLines(3),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("line0\nline1\nline2\n")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "line0", image.Point{0, 0})
+				testdraw.MustText(c, "line1", image.Point{0, 1})
+				testdraw.MustText(c, "line2", image.Point{0, 2})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "MaxLines wraps and scrolls the retained lines",
+			canvas: image.Rect(0, 0, 3, 3),
+			opts: []Option{
+				MaxLines(2),
+				WrapAtRunes(),
+				RollContent(),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("aaaa\nbbbb\ncccc")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "⇧", image.Point{0, 0})
+				testdraw.MustText(c, "ccc", image.Point{0, 1})
+				testdraw.MustText(c, "c", image.Point{0, 2})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "ParseMarkdownInline styles the text between markers",
+			canvas: image.Rect(0, 0, 12, 2),
+			opts: []Option{
+				ParseMarkdownInline(),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("*a* _b_ `c`\n\\*d\\*", WriteCellOpts(cell.FgColor(cell.ColorRed)))
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				red := cell.FgColor(cell.ColorRed)
+				testdraw.MustText(c, "a", image.Point{0, 0}, draw.TextCellOpts(red, cell.Bold()))
+				testdraw.MustText(c, " ", image.Point{1, 0}, draw.TextCellOpts(red))
+				testdraw.MustText(c, "b", image.Point{2, 0}, draw.TextCellOpts(red, cell.Italic()))
+				testdraw.MustText(c, " ", image.Point{3, 0}, draw.TextCellOpts(red))
+				testdraw.MustText(c, "c", image.Point{4, 0}, draw.TextCellOpts(red, cell.Inverse()))
+				testdraw.MustText(c, "*d*", image.Point{0, 1}, draw.TextCellOpts(red))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "markers are displayed without ParseMarkdownInline",
+			canvas: image.Rect(0, 0, 12, 1),
+			writes: func(widget *Text) error {
+				return widget.Write("*a* _b_ `c`")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "*a* _b_ `c`", image.Point{0, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "ExpandTabs expands tabs to the next tab stop",
+			canvas: image.Rect(0, 0, 10, 4),
+			opts: []Option{
+				ExpandTabs(4),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("a\tb\nabc\tc\nabcd\td\n\te")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "a   b", image.Point{0, 0})
+				testdraw.MustText(c, "abc c", image.Point{0, 1})
+				testdraw.MustText(c, "abcd    d", image.Point{0, 2})
+				testdraw.MustText(c, "    e", image.Point{0, 3})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "ExpandTabs expands consecutive tabs and tabs after full-width runes",
+			canvas: image.Rect(0, 0, 10, 2),
+			opts: []Option{
+				ExpandTabs(3),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("a\t\tb\n世\tc")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "a     b", image.Point{0, 0})
+				testdraw.MustText(c, "世 c", image.Point{0, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "ExpandTabs counts the column across multiple writes",
+			canvas: image.Rect(0, 0, 10, 2),
+			opts: []Option{
+				ExpandTabs(4),
+			},
+			writes: func(widget *Text) error {
+				for _, s := range []string{"a", "b", "\tc\n", "\td"} {
+					if err := widget.Write(s); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "ab  c", image.Point{0, 0})
+				testdraw.MustText(c, "    d", image.Point{0, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "ExpandTabs gives the spaces the cell options of the tab",
+			canvas: image.Rect(0, 0, 10, 1),
+			opts: []Option{
+				ExpandTabs(4),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("a\tb", WriteCellOpts(cell.BgColor(cell.ColorRed)))
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "a   b", image.Point{0, 0}, draw.TextCellOpts(cell.BgColor(cell.ColorRed)))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "ExpandTabs counts columns before wrapping",
+			canvas: image.Rect(0, 0, 3, 2),
+			opts: []Option{
+				ExpandTabs(4),
+				WrapAtRunes(),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("a\tb")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "a  ", image.Point{0, 0})
+				testdraw.MustText(c, " b", image.Point{0, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "ExpandTabs respects MaxTextCells",
+			canvas: image.Rect(0, 0, 10, 1),
+			opts: []Option{
+				ExpandTabs(4),
+				MaxTextCells(4),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("ab\tc")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "b  c", image.Point{0, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "write fails for tabs without ExpandTabs",
+			canvas: image.Rect(0, 0, 10, 1),
+			writes: func(widget *Text) error {
+				return widget.Write("a\tb")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantWriteErr: true,
+		},
+		{
+			desc:   "TrimTrailingSpace drops trailing spaces before wrapping",
+			canvas: image.Rect(0, 0, 3, 3),
+			opts: []Option{
+				TrimTrailingSpace(),
+				WrapAtRunes(),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("ab    \ncd  ")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "ab", image.Point{0, 0})
+				testdraw.MustText(c, "cd", image.Point{0, 1})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "wraps trailing spaces without TrimTrailingSpace",
+			canvas: image.Rect(0, 0, 3, 3),
+			opts: []Option{
+				WrapAtRunes(),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("ab    \ncd")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "ab ", image.Point{0, 0})
+				testdraw.MustText(c, "   ", image.Point{0, 1})
+				testdraw.MustText(c, "cd", image.Point{0, 2})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "TrimTrailingSpace keeps spaces inside the line",
+			canvas: image.Rect(0, 0, 10, 1),
+			opts: []Option{
+				TrimTrailingSpace(),
+			},
+			writes: func(widget *Text) error {
+				if err := widget.Write("ab  ", WriteCellOpts(cell.BgColor(cell.ColorRed))); err != nil {
+					return err
+				}
+				return widget.Write("cd  ", WriteCellOpts(cell.BgColor(cell.ColorRed)))
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "ab  cd", image.Point{0, 0}, draw.TextCellOpts(cell.BgColor(cell.ColorRed)))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "TrimTrailingSpace drops expanded tabs at the end of lines",
+			canvas: image.Rect(0, 0, 10, 2),
+			opts: []Option{
+				ExpandTabs(4),
+				TrimTrailingSpace(),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("ab\t\n\tcd\t", WriteCellOpts(cell.BgColor(cell.ColorRed)))
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+				testdraw.MustText(c, "ab", image.Point{0, 0}, draw.TextCellOpts(cell.BgColor(cell.ColorRed)))
+				testdraw.MustText(c, "    cd", image.Point{0, 1}, draw.TextCellOpts(cell.BgColor(cell.ColorRed)))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc: "fails when the header contains a newline",
+			opts: []Option{
+				Header("a\nb"),
+			},
+			canvas: image.Rect(0, 0, 1, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc: "fails when the footer contains a control character",
+			opts: []Option{
+				Footer("a\tb"),
+			},
+			canvas: image.Rect(0, 0, 1, 1),
+			want: func(size image.Point) *faketerm.Terminal {
+				return faketerm.MustNew(size)
+			},
+			wantErr: true,
+		},
+		{
+			desc:   "draws the header and the footer without content",
+			canvas: image.Rect(0, 0, 10, 3),
+			opts: []Option{
+				Header("head", cell.FgColor(cell.ColorRed)),
+				Footer("foot", cell.FgColor(cell.ColorBlue)),
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "head", image.Point{0, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorRed)))
+				testdraw.MustText(c, "foot", image.Point{0, 2}, draw.TextCellOpts(cell.FgColor(cell.ColorBlue)))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "header and footer stay pinned while the content scrolls",
+			canvas: image.Rect(0, 0, 10, 5),
+			opts: []Option{
+				Header("head", cell.FgColor(cell.ColorRed)),
+				Footer("foot", cell.FgColor(cell.ColorBlue)),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("line0\nline1\nline2\nline3\nline4\nline5\nline6")
+			},
+			events: func(widget *Text) {
+				widget.Keyboard(&terminalapi.Keyboard{
+					Key: keyboard.KeyPgDn,
+				}, &widgetapi.EventMeta{})
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "head", image.Point{0, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorRed)))
+				testdraw.MustText(c, "⇧", image.Point{0, 1})
+				testdraw.MustText(c, "line4", image.Point{0, 2})
+				testdraw.MustText(c, "⇩", image.Point{0, 3})
+				testdraw.MustText(c, "foot", image.Point{0, 4}, draw.TextCellOpts(cell.FgColor(cell.ColorBlue)))
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "rolled content scrolls under the header",
+			canvas: image.Rect(0, 0, 10, 3),
+			opts: []Option{
+				RollContent(),
+				Header("head"),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("line0\nline1\nline2")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "head", image.Point{0, 0})
+				testdraw.MustText(c, "line1", image.Point{0, 1})
+				testdraw.MustText(c, "line2", image.Point{0, 2})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "trims the header and the footer to the width",
+			canvas: image.Rect(0, 0, 4, 3),
+			opts: []Option{
+				Header("header"),
+				Footer("footer"),
+			},
+			writes: func(widget *Text) error {
 				return widget.Write("ab")
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
-				testdraw.MustText(c, "ab", image.Point{0, 0})
+
+				testdraw.MustText(c, "head", image.Point{0, 0})
+				testdraw.MustText(c, "ab", image.Point{0, 1})
+				testdraw.MustText(c, "foot", image.Point{0, 2})
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
 		},
 		{
-			desc:   "tests maxTextCells exact length of 5",
-			canvas: image.Rect(0, 0, 10, 1),
+			desc:   "draws line numbers under the header",
+			canvas: image.Rect(0, 0, 10, 3),
 			opts: []Option{
-				RollContent(),
-				MaxTextCells(5),
+				ShowLineNumbers(),
+				Header("head"),
 			},
 			writes: func(widget *Text) error {
-				return widget.Write("12345")
+				return widget.Write("one\ntwo\nthree")
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
-				// Line return (\n) counts as one character
-				testdraw.MustText(
-					c,
-					"12345",
-					image.Point{0, 0},
-				)
+
+				testdraw.MustText(c, "head", image.Point{0, 0})
+				testdraw.MustText(c, "1", image.Point{0, 1})
+				testdraw.MustText(c, "one", image.Point{2, 1})
+				testdraw.MustText(c, "2", image.Point{0, 2})
+				testdraw.MustText(c, "two", image.Point{2, 2})
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
 		},
 		{
-			desc:   "tests maxTextCells partial bufffer replacement",
-			canvas: image.Rect(0, 0, 10, 1),
+			desc:   "draws line numbers",
+			canvas: image.Rect(0, 0, 10, 3),
 			opts: []Option{
-				RollContent(),
-				MaxTextCells(10),
+				ShowLineNumbers(),
 			},
 			writes: func(widget *Text) error {
-				return widget.Write("hello wor你12345678")
+				return widget.Write("one\ntwo\nthree")
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
-				testdraw.MustText(
-					c,
-					"你12345678",
-					image.Point{0, 0},
-				)
+
+				testdraw.MustText(c, "1", image.Point{0, 0})
+				testdraw.MustText(c, "one", image.Point{2, 0})
+				testdraw.MustText(c, "2", image.Point{0, 1})
+				testdraw.MustText(c, "two", image.Point{2, 1})
+				testdraw.MustText(c, "3", image.Point{0, 2})
+				testdraw.MustText(c, "three", image.Point{2, 2})
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
 		},
 		{
-			desc:   "tests maxTextCells length not being limited",
-			canvas: image.Rect(0, 0, 72, 1),
+			desc:   "line numbers track lines that are wrapped",
+			canvas: image.Rect(0, 0, 8, 4),
 			opts: []Option{
-				RollContent(),
+				ShowLineNumbers(),
+				WrapAtRunes(),
 			},
 			writes: func(widget *Text) error {
-				return widget.Write("1234567890abcdefghijklmnopqrstuvwxyz")
+				return widget.Write("ab\ncdefghijk\nl")
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
-				testdraw.MustText(
-					c,
-					"1234567890abcdefghijklmnopqrstuvwxyz",
-					image.Point{0, 0},
-				)
+
+				testdraw.MustText(c, "1", image.Point{0, 0})
+				testdraw.MustText(c, "ab", image.Point{2, 0})
+				testdraw.MustText(c, "2", image.Point{0, 1})
+				testdraw.MustText(c, "cdefgh", image.Point{2, 1})
+				testdraw.MustText(c, "ijk", image.Point{2, 2})
+				testdraw.MustText(c, "3", image.Point{0, 3})
+				testdraw.MustText(c, "l", image.Point{2, 3})
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
 		},
 		{
-			desc:   "tests maxTextCells length being applied - single line",
+			desc:   "line numbers account for the gutter width when trimming",
+			canvas: image.Rect(0, 0, 5, 1),
+			opts: []Option{
+				ShowLineNumbers(),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("abcdef")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "1", image.Point{0, 0})
+				testdraw.MustText(c, "ab…", image.Point{2, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "line numbers are right-aligned and skip the scroll markers",
 			canvas: image.Rect(0, 0, 10, 3),
 			opts: []Option{
-				MaxTextCells(5),
-				RollContent(),
+				ShowLineNumbers(),
 			},
 			writes: func(widget *Text) error {
-				return widget.Write("1234567890abcdefghijklmnopqrstuvwxyz")
+				return widget.Write("a\nb\nc\nd\ne\nf\ng\nh\ni\nj")
 			},
 			want: func(size image.Point) *faketerm.Terminal {
 				ft := faketerm.MustNew(size)
 				c := testcanvas.MustNew(ft.Area())
-				testdraw.MustText(c, "vwxyz", image.Point{0, 0})
+
+				testdraw.MustText(c, " 1", image.Point{0, 0})
+				testdraw.MustText(c, "a", image.Point{3, 0})
+				testdraw.MustText(c, " 2", image.Point{0, 1})
+				testdraw.MustText(c, "b", image.Point{3, 1})
+				testdraw.MustText(c, "⇩", image.Point{3, 2})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "line numbers follow the scrolled content",
+			canvas: image.Rect(0, 0, 10, 3),
+			opts: []Option{
+				ShowLineNumbers(),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("line0\nline1\nline2\nline3")
+			},
+			events: func(widget *Text) {
+				widget.Mouse(&terminalapi.Mouse{
+					Button: mouse.ButtonWheelDown,
+				}, &widgetapi.EventMeta{})
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "⇧", image.Point{2, 0})
+				testdraw.MustText(c, "3", image.Point{0, 1})
+				testdraw.MustText(c, "line2", image.Point{2, 1})
+				testdraw.MustText(c, "4", image.Point{0, 2})
+				testdraw.MustText(c, "line3", image.Point{2, 2})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "trailing newline doesn't start a numbered line",
+			canvas: image.Rect(0, 0, 5, 2),
+			opts: []Option{
+				ShowLineNumbers(),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("a\n")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "1", image.Point{0, 0})
+				testdraw.MustText(c, "a", image.Point{2, 0})
+				testcanvas.MustApply(c, ft)
+				return ft
+			},
+		},
+		{
+			desc:   "line numbers with custom cell options",
+			canvas: image.Rect(0, 0, 5, 2),
+			opts: []Option{
+				ShowLineNumbers(cell.FgColor(cell.ColorRed)),
+			},
+			writes: func(widget *Text) error {
+				return widget.Write("a\nb")
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				c := testcanvas.MustNew(ft.Area())
+
+				testdraw.MustText(c, "1", image.Point{0, 0}, draw.TextCellOpts(cell.FgColor(cell.ColorRed)))
+				testdraw.MustText(c, "a", image.Point{2, 0})
+				testdraw.MustText(c, "2", image.Point{0, 1}, draw.TextCellOpts(cell.FgColor(cell.ColorRed)))
+				testdraw.MustText(c, "b", image.Point{2, 1})
 				testcanvas.MustApply(c, ft)
 				return ft
 			},
@@ -1045,6 +2024,8 @@ func TestOptions(t *testing.T) {
 	tests := []struct {
 		desc string
 		opts []Option
+		// text if not empty is written to the widget.
+		text string
 		want widgetapi.Options
 	}{
 		{
@@ -1055,6 +2036,43 @@ func TestOptions(t *testing.T) {
 				WantMouse:    widgetapi.MouseScopeWidget,
 			},
 		},
+		{
+			desc: "the header and the footer increase the minimum height",
+			opts: []Option{
+				Header("head"),
+				Footer("foot"),
+			},
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{1, 3},
+				WantKeyboard: widgetapi.KeyScopeFocused,
+				WantMouse:    widgetapi.MouseScopeWidget,
+			},
+		},
+		{
+			desc: "line numbers increase the minimum width",
+			opts: []Option{
+				ShowLineNumbers(),
+			},
+			text: "1\n2\n3\n4\n5\n6\n7\n8\n9\n10",
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{4, 1},
+				WantKeyboard: widgetapi.KeyScopeFocused,
+				WantMouse:    widgetapi.MouseScopeWidget,
+			},
+		},
+		{
+			desc: "the minimum width follows the lines that remain",
+			opts: []Option{
+				ShowLineNumbers(),
+				MaxLines(5),
+			},
+			text: "1\n2\n3\n4\n5\n6\n7\n8\n9\n10",
+			want: widgetapi.Options{
+				MinimumSize:  image.Point{3, 1},
+				WantKeyboard: widgetapi.KeyScopeFocused,
+				WantMouse:    widgetapi.MouseScopeWidget,
+			},
+		},
 		{
 			desc: "disabling scrolling removes keyboard and mouse",
 			opts: []Option{
@@ -1074,6 +2092,11 @@ func TestOptions(t *testing.T) {
 			if err != nil {
 				t.Fatalf("New => unexpected error: %v", err)
 			}
+			if tc.text != "" {
+				if err := text.Write(tc.text); err != nil {
+					t.Fatalf("Write => unexpected error: %v", err)
+				}
+			}
 
 			got := text.Options()
 			if diff := pretty.Compare(tc.want, got); diff != "" {
@@ -1083,6 +2106,106 @@ func TestOptions(t *testing.T) {
 	}
 }
 
+func TestContentSize(t *testing.T) {
+	tests := []struct {
+		desc  string
+		opts  []Option
+		text  string
+		width int
+		want  image.Point
+	}{
+		{
+			desc:  "no content",
+			width: 10,
+			want:  image.Point{0, 0},
+		},
+		{
+			desc:  "zero width",
+			text:  "hello",
+			width: 0,
+			want:  image.Point{0, 0},
+		},
+		{
+			desc:  "lines that fit aren't wrapped",
+			text:  "hello\nhi",
+			width: 10,
+			want:  image.Point{5, 2},
+		},
+		{
+			desc:  "trimmed lines can exceed the width",
+			text:  "hello world\nhi",
+			width: 5,
+			want:  image.Point{11, 2},
+		},
+		{
+			desc: "trailing spaces are trimmed",
+			opts: []Option{
+				TrimTrailingSpace(),
+			},
+			text:  "hello   \nhi ",
+			width: 10,
+			want:  image.Point{5, 2},
+		},
+		{
+			desc: "wraps lines at runes",
+			opts: []Option{
+				WrapAtRunes(),
+			},
+			text:  "hello world\nhi",
+			width: 5,
+			want:  image.Point{5, 4},
+		},
+		{
+			desc: "wraps lines at words",
+			opts: []Option{
+				WrapAtWords(),
+			},
+			text:  "hello world again",
+			width: 12,
+			want:  image.Point{11, 2},
+		},
+		{
+			desc: "accounts for full-width runes",
+			opts: []Option{
+				WrapAtRunes(),
+			},
+			text:  "世界世界",
+			width: 5,
+			want:  image.Point{4, 2},
+		},
+		{
+			desc: "includes the line numbers gutter",
+			opts: []Option{
+				WrapAtRunes(),
+				ShowLineNumbers(),
+			},
+			text:  "hello world\nhi",
+			width: 7,
+			want:  image.Point{7, 4},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			txt, err := New(tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if tc.text != "" {
+				if err := txt.Write(tc.text); err != nil {
+					t.Fatalf("Write => unexpected error: %v", err)
+				}
+			}
+
+			var cm widgetapi.ContentMeasurer = txt
+			got := cm.ContentSize(tc.width)
+			if diff := pretty.Compare(tc.want, got); diff != "" {
+				t.Errorf("ContentSize => unexpected diff (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestTruncateToCells(t *testing.T) {
 	tests := []struct {
 		desc     string
@@ -0,0 +1,65 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"github.com/mum4k/termdash/private/canvas/buffer"
+	"github.com/mum4k/termdash/private/runewidth"
+)
+
+// whitespace.go contains code that expands tabs and trims trailing spaces.
+
+// lastLineWidth returns the width in cells of the last line of the content,
+// i.e. the column at which the next appended rune will be placed.
+func lastLineWidth(content []*buffer.Cell) int {
+	var width int
+	for i := len(content) - 1; i >= 0; i-- {
+		if content[i].Rune == '\n' {
+			break
+		}
+		width += runewidth.RuneWidth(content[i].Rune)
+	}
+	return width
+}
+
+// tabCells returns the number of space cells a tab placed at the specified
+// column expands to, so that the next rune lands on a tab stop. Tab stops are
+// placed every tabWidth cells.
+func tabCells(col, tabWidth int) int {
+	return tabWidth - col%tabWidth
+}
+
+// trimTrailingSpace returns the content without the space characters at the
+// end of each line. The returned slice references the same cells.
+func trimTrailingSpace(content []*buffer.Cell) []*buffer.Cell {
+	var (
+		res    []*buffer.Cell
+		spaces []*buffer.Cell
+	)
+	for _, c := range content {
+		switch c.Rune {
+		case ' ':
+			spaces = append(spaces, c)
+			continue
+		case '\n':
+			spaces = nil
+		default:
+			res = append(res, spaces...)
+			spaces = nil
+		}
+		res = append(res, c)
+	}
+	return res
+}
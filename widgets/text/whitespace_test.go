@@ -0,0 +1,90 @@
+// Copyright 2018 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package text
+
+import (
+	"testing"
+
+	"github.com/mum4k/termdash/private/canvas/buffer"
+)
+
+func TestLastLineWidth(t *testing.T) {
+	tests := []struct {
+		desc string
+		text string
+		want int
+	}{
+		{desc: "no content", text: "", want: 0},
+		{desc: "single line", text: "abc", want: 3},
+		{desc: "last of multiple lines", text: "abcdef\nab", want: 2},
+		{desc: "trailing newline", text: "abc\n", want: 0},
+		{desc: "full-width runes", text: "a世", want: 3},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := lastLineWidth(buffer.NewCells(tc.text)); got != tc.want {
+				t.Errorf("lastLineWidth(%q) => %d, want %d", tc.text, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTabCells(t *testing.T) {
+	tests := []struct {
+		col      int
+		tabWidth int
+		want     int
+	}{
+		{col: 0, tabWidth: 4, want: 4},
+		{col: 1, tabWidth: 4, want: 3},
+		{col: 3, tabWidth: 4, want: 1},
+		{col: 4, tabWidth: 4, want: 4},
+		{col: 5, tabWidth: 1, want: 1},
+	}
+
+	for _, tc := range tests {
+		if got := tabCells(tc.col, tc.tabWidth); got != tc.want {
+			t.Errorf("tabCells(%d, %d) => %d, want %d", tc.col, tc.tabWidth, got, tc.want)
+		}
+	}
+}
+
+func TestTrimTrailingSpace(t *testing.T) {
+	tests := []struct {
+		desc string
+		text string
+		want string
+	}{
+		{desc: "no content", text: "", want: ""},
+		{desc: "no spaces", text: "ab\ncd", want: "ab\ncd"},
+		{desc: "keeps spaces inside lines", text: " a b\nc  d", want: " a b\nc  d"},
+		{desc: "trims the end of each line", text: "ab  \ncd \ne", want: "ab\ncd\ne"},
+		{desc: "trims the last line", text: "ab\ncd  ", want: "ab\ncd"},
+		{desc: "lines with only spaces become empty", text: "   \n  ", want: "\n"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			var got string
+			for _, c := range trimTrailingSpace(buffer.NewCells(tc.text)) {
+				got += string(c.Rune)
+			}
+			if got != tc.want {
+				t.Errorf("trimTrailingSpace(%q) => %q, want %q", tc.text, got, tc.want)
+			}
+		})
+	}
+}
@@ -17,6 +17,8 @@ package text
 // write_options.go contains options used when writing content to the Text widget.
 
 import (
+	"fmt"
+
 	"github.com/mum4k/termdash/cell"
 )
 
@@ -30,6 +32,7 @@ type WriteOption interface {
 type writeOptions struct {
 	cellOpts *cell.Options
 	replace  bool
+	ranges   []Range
 }
 
 // newWriteOptions returns new writeOptions instance.
@@ -58,6 +61,57 @@ func WriteCellOpts(opts ...cell.Option) WriteOption {
 	})
 }
 
+// Range identifies a part of the written text by the indexes of its runes and
+// the cell options that apply to it.
+type Range struct {
+	// From is the index of the first rune in the range.
+	From int
+	// To is the index of the rune after the last rune in the range, i.e. the
+	// range doesn't include the rune at this index.
+	To int
+	// Opts are the cell options applied to the runes in the range, on top of
+	// the options provided via WriteCellOpts.
+	Opts []cell.Option
+}
+
+// WriteRanges sets cell options on parts of the written text, e.g. to
+// highlight a single word without splitting the text into multiple writes.
+// The indexes in the ranges refer to runes (not bytes) of the text provided
+// to Write and must be within its length, the From index must not be larger
+// than the To index. If ranges overlap, the options of the later range apply
+// on top of the earlier ones.
+func WriteRanges(ranges []Range) WriteOption {
+	return writeOption(func(wOpts *writeOptions) {
+		wOpts.ranges = append(wOpts.ranges, ranges...)
+	})
+}
+
+// validateRanges validates the ranges against the text with the specified
+// number of runes.
+func (wo *writeOptions) validateRanges(runes int) error {
+	for _, r := range wo.ranges {
+		if r.From < 0 || r.To > runes || r.From > r.To {
+			return fmt.Errorf("invalid WriteRanges range [%d, %d), must be within the written text of %d runes and From must not be larger than To", r.From, r.To, runes)
+		}
+	}
+	return nil
+}
+
+// cellOptsFor returns the cell options of the rune at the specified index
+// within the written text.
+func (wo *writeOptions) cellOptsFor(idx int) *cell.Options {
+	opts := []cell.Option{wo.cellOpts}
+	for _, r := range wo.ranges {
+		if idx >= r.From && idx < r.To {
+			opts = append(opts, r.Opts...)
+		}
+	}
+	if len(opts) == 1 {
+		return wo.cellOpts
+	}
+	return cell.NewOptions(opts...)
+}
+
 // WriteReplace instructs the text widget to replace the entire text content on
 // this write instead of appending.
 func WriteReplace() WriteOption {
@@ -277,11 +277,17 @@ type fieldEditor struct {
 
 	// onChange if provided is the handler called when fieldData changes
 	onChange ChangeFn
+
+	// history tracks the edits for undo and redo.
+	history *editHistory
 }
 
 // newFieldEditor returns a new fieldEditor instance.
 func newFieldEditor(onChange ChangeFn) *fieldEditor {
-	return &fieldEditor{onChange: onChange}
+	return &fieldEditor{
+		onChange: onChange,
+		history:  newEditHistory(),
+	}
 }
 
 // minFieldWidth is the minimum supported width of the text input field.
@@ -328,6 +334,7 @@ func (fe *fieldEditor) content() string {
 }
 
 // reset resets the content back to zero.
+// This also clears the edit history.
 func (fe *fieldEditor) reset() {
 	*fe = *newFieldEditor(fe.onChange)
 }
@@ -339,6 +346,7 @@ func (fe *fieldEditor) insert(r rune) {
 		// Don't insert invisible runes.
 		return
 	}
+	fe.history.recordInsert(fe.state())
 	fe.data.insertAt(fe.curDataPos, r)
 	fe.curDataPos++
 	if fe.onChange != nil {
@@ -352,10 +360,8 @@ func (fe *fieldEditor) delete() {
 		// Cursor not on a rune, nothing to do.
 		return
 	}
-	fe.data.deleteAt(fe.curDataPos)
-	if fe.onChange != nil {
-		fe.onChange(string(fe.data))
-	}
+	fe.history.record(fe.state())
+	fe.deleteAtCursor()
 }
 
 // deleteBefore deletes the rune that is immediately to the left of the cursor.
@@ -364,8 +370,73 @@ func (fe *fieldEditor) deleteBefore() {
 		// Cursor at the beginning, nothing to do.
 		return
 	}
+	fe.history.record(fe.state())
 	fe.cursorLeft()
-	fe.delete()
+	fe.deleteAtCursor()
+}
+
+// deleteAtCursor deletes the rune at the current position of the cursor
+// without recording the edit in the history.
+func (fe *fieldEditor) deleteAtCursor() {
+	fe.data.deleteAt(fe.curDataPos)
+	if fe.onChange != nil {
+		fe.onChange(string(fe.data))
+	}
+}
+
+// setContent replaces the content of the field and moves the cursor to its
+// end. The replacement is recorded as one edit in the history.
+func (fe *fieldEditor) setContent(text string) {
+	fe.history.record(fe.state())
+	fe.data = fieldData(text)
+	fe.curDataPos = len(fe.data)
+	if fe.onChange != nil {
+		fe.onChange(string(fe.data))
+	}
+}
+
+// state returns a copy of the current state of the editor.
+func (fe *fieldEditor) state() editState {
+	return editState{
+		data:       append(fieldData(nil), fe.data...),
+		curDataPos: fe.curDataPos,
+	}
+}
+
+// restore restores the editor into the provided state.
+func (fe *fieldEditor) restore(st editState) {
+	fe.data = st.data
+	fe.curDataPos = st.curDataPos
+	if fe.onChange != nil {
+		fe.onChange(string(fe.data))
+	}
+}
+
+// undo reverts the last edit, restoring the content and the cursor position.
+// Returns false if there was nothing to undo.
+func (fe *fieldEditor) undo() bool {
+	st, ok := fe.history.undoStep(fe.state())
+	if !ok {
+		return false
+	}
+	fe.restore(st)
+	return true
+}
+
+// redo reapplies the last undone edit, restoring the content and the cursor
+// position. Returns false if there was nothing to redo.
+func (fe *fieldEditor) redo() bool {
+	st, ok := fe.history.redoStep(fe.state())
+	if !ok {
+		return false
+	}
+	fe.restore(st)
+	return true
+}
+
+// clearHistory forgets all the recorded edits.
+func (fe *fieldEditor) clearHistory() {
+	fe.history = newEditHistory()
 }
 
 // cursorRight moves the cursor one position to the right.
@@ -0,0 +1,89 @@
+// Copyright 2019 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package textinput
+
+// filter.go contains ready-made functions that can be provided to the Filter
+// option.
+
+import (
+	"strings"
+	"unicode"
+)
+
+// emailSpecial are the runes other than ASCII letters and digits that can
+// appear in an email address.
+const emailSpecial = "@.!#$%&'*+/=?^_`{|}~-"
+
+// FilterInteger returns a FilterFn that accepts digits and the plus and minus
+// signs, i.e. the runes of an integer number.
+func FilterInteger() FilterFn {
+	return FilterAny(isASCIIDigit, FilterRunes("+-"))
+}
+
+// FilterNumeric returns a FilterFn that accepts digits, the plus and minus
+// signs and the decimal point, i.e. the runes of a decimal number.
+func FilterNumeric() FilterFn {
+	return FilterAny(isASCIIDigit, FilterRunes("+-."))
+}
+
+// FilterAlphanumeric returns a FilterFn that accepts letters and digits,
+// including letters and digits outside of the ASCII range.
+func FilterAlphanumeric() FilterFn {
+	return func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	}
+}
+
+// FilterEmail returns a FilterFn that accepts the runes that can appear in an
+// email address, i.e. ASCII letters, digits, the at sign, the dot and the
+// special characters allowed in the local part.
+// This only filters runes, it doesn't validate that the entered text is an
+// email address.
+func FilterEmail() FilterFn {
+	return FilterAny(isASCIILetter, isASCIIDigit, FilterRunes(emailSpecial))
+}
+
+// FilterRunes returns a FilterFn that accepts only the runes contained in the
+// provided string.
+func FilterRunes(allowed string) FilterFn {
+	return func(r rune) bool {
+		return strings.ContainsRune(allowed, r)
+	}
+}
+
+// FilterAny returns a FilterFn that accepts a rune if any of the provided
+// functions accepts it. Can be used to extend the ready-made filters, e.g.
+// FilterAny(FilterAlphanumeric(), FilterRunes(" _")).
+// Returns a FilterFn that rejects all runes if no functions are provided.
+func FilterAny(fns ...FilterFn) FilterFn {
+	return func(r rune) bool {
+		for _, fn := range fns {
+			if fn(r) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// isASCIIDigit determines if the rune is one of the digits 0-9.
+func isASCIIDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// isASCIILetter determines if the rune is one of the letters a-z or A-Z.
+func isASCIILetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
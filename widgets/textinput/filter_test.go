@@ -0,0 +1,85 @@
+// Copyright 2019 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package textinput
+
+import (
+	"testing"
+)
+
+func TestFilters(t *testing.T) {
+	tests := []struct {
+		desc   string
+		filter FilterFn
+		accept string
+		reject string
+	}{
+		{
+			desc:   "FilterInteger",
+			filter: FilterInteger(),
+			accept: "0123456789+-",
+			reject: "a.,e x٣",
+		},
+		{
+			desc:   "FilterNumeric",
+			filter: FilterNumeric(),
+			accept: "0123456789+-.",
+			reject: "a,e x٣",
+		},
+		{
+			desc:   "FilterAlphanumeric",
+			filter: FilterAlphanumeric(),
+			accept: "azAZ09žλ٣",
+			reject: " .-_@!",
+		},
+		{
+			desc:   "FilterEmail",
+			filter: FilterEmail(),
+			accept: "azAZ09@.!#$%&'*+/=?^_`{|}~-",
+			reject: " ,:;<>()[]\\\"ž",
+		},
+		{
+			desc:   "FilterRunes",
+			filter: FilterRunes("ab "),
+			accept: "ab ",
+			reject: "cAB1",
+		},
+		{
+			desc:   "FilterAny combines filters",
+			filter: FilterAny(FilterAlphanumeric(), FilterRunes(" _")),
+			accept: "aZ9 _",
+			reject: "-.@",
+		},
+		{
+			desc:   "FilterAny without filters rejects all runes",
+			filter: FilterAny(),
+			reject: "a1 ",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			for _, r := range tc.accept {
+				if !tc.filter(r) {
+					t.Errorf("filter(%q) => false, want true", r)
+				}
+			}
+			for _, r := range tc.reject {
+				if tc.filter(r) {
+					t.Errorf("filter(%q) => true, want false", r)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2019 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package textinput
+
+// history.go contains code that tracks the edits of the text input field so
+// that they can be undone and redone.
+
+import "time"
+
+// timeNow returns the current time.
+// Replaced from tests.
+var timeNow = time.Now
+
+// coalesceWindow is the maximum duration between consecutive single rune
+// insertions that are undone together as one step.
+const coalesceWindow = time.Second
+
+// editState is the state of the field editor before or after an edit.
+type editState struct {
+	// data are the data in the text input field.
+	data fieldData
+	// curDataPos is the position of the cursor within the data.
+	curDataPos int
+}
+
+// editHistory tracks the states of the field editor for undo and redo.
+// This object isn't thread-safe.
+type editHistory struct {
+	// undo are the states before each of the undoable edits, the last one is
+	// restored first.
+	undo []editState
+	// redo are the states that were undone, the last one is restored first.
+	redo []editState
+
+	// insertPos is the position of the cursor after the last insertion or -1
+	// if the next insertion starts a new undo step.
+	insertPos int
+	// lastInsert is the time of the last insertion.
+	lastInsert time.Time
+}
+
+// newEditHistory returns a new empty editHistory.
+func newEditHistory() *editHistory {
+	return &editHistory{insertPos: -1}
+}
+
+// record records the state before an edit as a new undo step.
+// Any undone steps can no longer be redone.
+func (eh *editHistory) record(st editState) {
+	eh.undo = append(eh.undo, st)
+	eh.redo = nil
+	eh.insertPos = -1
+}
+
+// recordInsert records the state before an insertion of a single rune.
+// The insertion is coalesced into the previous undo step if it continues the
+// previous insertion at the same position within the coalesceWindow.
+func (eh *editHistory) recordInsert(st editState) {
+	now := timeNow()
+	if eh.insertPos != st.curDataPos || now.Sub(eh.lastInsert) >= coalesceWindow {
+		eh.record(st)
+	}
+	eh.insertPos = st.curDataPos + 1
+	eh.lastInsert = now
+}
+
+// undoStep returns the state before the last edit and records the current
+// state so it can be redone. Returns false if there is nothing to undo.
+func (eh *editHistory) undoStep(cur editState) (editState, bool) {
+	if len(eh.undo) == 0 {
+		return editState{}, false
+	}
+	st := eh.undo[len(eh.undo)-1]
+	eh.undo = eh.undo[:len(eh.undo)-1]
+	eh.redo = append(eh.redo, cur)
+	eh.insertPos = -1
+	return st, true
+}
+
+// redoStep returns the state after the last undone edit and records the
+// current state so it can be undone again. Returns false if there is nothing
+// to redo.
+func (eh *editHistory) redoStep(cur editState) (editState, bool) {
+	if len(eh.redo) == 0 {
+		return editState{}, false
+	}
+	st := eh.redo[len(eh.redo)-1]
+	eh.redo = eh.redo[:len(eh.redo)-1]
+	eh.undo = append(eh.undo, cur)
+	eh.insertPos = -1
+	return st, true
+}
@@ -0,0 +1,252 @@
+// Copyright 2019 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package textinput
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mum4k/termdash/keyboard"
+	"github.com/mum4k/termdash/terminal/terminalapi"
+	"github.com/mum4k/termdash/widgetapi"
+)
+
+// keys sends the keyboard events to the text input.
+func keys(ti *TextInput, keys ...keyboard.Key) error {
+	for _, k := range keys {
+		if err := ti.Keyboard(&terminalapi.Keyboard{Key: k}, &widgetapi.EventMeta{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestUndoRedo(t *testing.T) {
+	tests := []struct {
+		desc string
+		opts []Option
+		// ops performs operations on the text input, advance moves the clock.
+		ops         func(ti *TextInput, advance func(time.Duration)) error
+		wantContent string
+		wantCurPos  int
+	}{
+		{
+			desc: "undo without edits does nothing",
+			ops: func(ti *TextInput, _ func(time.Duration)) error {
+				return keys(ti, keyboard.KeyCtrlZ, keyboard.KeyCtrlY)
+			},
+		},
+		{
+			desc: "undoes typing in quick succession as one step",
+			ops: func(ti *TextInput, _ func(time.Duration)) error {
+				return keys(ti, 'a', 'b', 'c', keyboard.KeyCtrlZ)
+			},
+			wantContent: "",
+			wantCurPos:  0,
+		},
+		{
+			desc: "pause in typing starts a new undo step",
+			ops: func(ti *TextInput, advance func(time.Duration)) error {
+				if err := keys(ti, 'a', 'b'); err != nil {
+					return err
+				}
+				advance(coalesceWindow)
+				return keys(ti, 'c', 'd', keyboard.KeyCtrlZ)
+			},
+			wantContent: "ab",
+			wantCurPos:  2,
+		},
+		{
+			desc: "moving the cursor starts a new undo step",
+			ops: func(ti *TextInput, _ func(time.Duration)) error {
+				return keys(ti, 'a', 'b', keyboard.KeyArrowLeft, 'c', keyboard.KeyCtrlZ)
+			},
+			wantContent: "ab",
+			wantCurPos:  1,
+		},
+		{
+			desc: "undoes deletion and restores the cursor",
+			ops: func(ti *TextInput, _ func(time.Duration)) error {
+				return keys(ti, 'a', 'b', 'c', keyboard.KeyHome, keyboard.KeyDelete, keyboard.KeyCtrlZ)
+			},
+			wantContent: "abc",
+			wantCurPos:  0,
+		},
+		{
+			desc: "undoes backspace and restores the cursor",
+			ops: func(ti *TextInput, _ func(time.Duration)) error {
+				return keys(ti, 'a', 'b', 'c', keyboard.KeyArrowLeft, keyboard.KeyBackspace2, keyboard.KeyCtrlZ)
+			},
+			wantContent: "abc",
+			wantCurPos:  2,
+		},
+		{
+			desc: "each deletion is a separate undo step",
+			ops: func(ti *TextInput, _ func(time.Duration)) error {
+				return keys(ti, 'a', 'b', 'c', keyboard.KeyBackspace2, keyboard.KeyBackspace2, keyboard.KeyCtrlZ)
+			},
+			wantContent: "ab",
+			wantCurPos:  2,
+		},
+		{
+			desc: "deletions that change nothing aren't recorded",
+			ops: func(ti *TextInput, _ func(time.Duration)) error {
+				return keys(ti, 'a', keyboard.KeyDelete, keyboard.KeyHome, keyboard.KeyBackspace2, keyboard.KeyCtrlZ)
+			},
+			wantContent: "",
+			wantCurPos:  0,
+		},
+		{
+			desc: "undoes multiple steps",
+			ops: func(ti *TextInput, _ func(time.Duration)) error {
+				return keys(ti, 'a', 'b', keyboard.KeyBackspace2, 'c', keyboard.KeyCtrlZ, keyboard.KeyCtrlZ)
+			},
+			wantContent: "ab",
+			wantCurPos:  2,
+		},
+		{
+			desc: "redo reapplies the undone steps",
+			ops: func(ti *TextInput, _ func(time.Duration)) error {
+				return keys(ti,
+					'a', 'b', keyboard.KeyBackspace2, 'c',
+					keyboard.KeyCtrlZ, keyboard.KeyCtrlZ, keyboard.KeyCtrlZ,
+					keyboard.KeyCtrlY, keyboard.KeyCtrlY,
+				)
+			},
+			wantContent: "a",
+			wantCurPos:  1,
+		},
+		{
+			desc: "redo after redoing everything does nothing",
+			ops: func(ti *TextInput, _ func(time.Duration)) error {
+				return keys(ti, 'a', 'b', keyboard.KeyCtrlZ, keyboard.KeyCtrlY, keyboard.KeyCtrlY)
+			},
+			wantContent: "ab",
+			wantCurPos:  2,
+		},
+		{
+			desc: "new edit discards the redo steps",
+			ops: func(ti *TextInput, _ func(time.Duration)) error {
+				return keys(ti, 'a', keyboard.KeyBackspace2, keyboard.KeyCtrlZ, 'b', keyboard.KeyCtrlY)
+			},
+			wantContent: "ab",
+			wantCurPos:  2,
+		},
+		{
+			desc: "typing after undo isn't coalesced with the undone insertion",
+			ops: func(ti *TextInput, _ func(time.Duration)) error {
+				return keys(ti, 'a', 'b', keyboard.KeyBackspace2, keyboard.KeyCtrlZ, 'c', keyboard.KeyCtrlZ)
+			},
+			wantContent: "ab",
+			wantCurPos:  2,
+		},
+		{
+			desc: "default text cannot be undone",
+			opts: []Option{
+				DefaultText("abc"),
+			},
+			ops: func(ti *TextInput, _ func(time.Duration)) error {
+				return keys(ti, 'd', keyboard.KeyCtrlZ, keyboard.KeyCtrlZ)
+			},
+			wantContent: "abc",
+			wantCurPos:  3,
+		},
+		{
+			desc: "clearing the content clears the history",
+			ops: func(ti *TextInput, _ func(time.Duration)) error {
+				if err := keys(ti, 'a', 'b'); err != nil {
+					return err
+				}
+				ti.ReadAndClear()
+				return keys(ti, keyboard.KeyCtrlZ)
+			},
+			wantContent: "",
+			wantCurPos:  0,
+		},
+		{
+			desc: "undo and redo using methods",
+			ops: func(ti *TextInput, _ func(time.Duration)) error {
+				if err := keys(ti, 'a', 'b', keyboard.KeyBackspace2); err != nil {
+					return err
+				}
+				ti.Undo()
+				ti.Undo()
+				ti.Redo()
+				return nil
+			},
+			wantContent: "ab",
+			wantCurPos:  2,
+		},
+		{
+			desc: "custom undo and redo keys",
+			opts: []Option{
+				UndoKeys(keyboard.KeyCtrlU, keyboard.KeyCtrlR),
+			},
+			ops: func(ti *TextInput, _ func(time.Duration)) error {
+				return keys(ti, 'a', keyboard.KeyBackspace2, keyboard.KeyCtrlU, keyboard.KeyCtrlU, keyboard.KeyCtrlR, keyboard.KeyCtrlZ)
+			},
+			wantContent: "a",
+			wantCurPos:  1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			now := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+			timeNow = func() time.Time { return now }
+			defer func() { timeNow = time.Now }()
+
+			ti, err := New(tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			if tc.ops != nil {
+				advance := func(d time.Duration) { now = now.Add(d) }
+				if err := tc.ops(ti, advance); err != nil {
+					t.Fatalf("ops => unexpected error: %v", err)
+				}
+			}
+
+			if got := ti.Read(); got != tc.wantContent {
+				t.Errorf("Read => %q, want %q", got, tc.wantContent)
+			}
+			if got := ti.editor.curDataPos; got != tc.wantCurPos {
+				t.Errorf("curDataPos => %d, want %d", got, tc.wantCurPos)
+			}
+		})
+	}
+}
+
+func TestUndoRedoReturnValues(t *testing.T) {
+	ti, err := New()
+	if err != nil {
+		t.Fatalf("New => unexpected error: %v", err)
+	}
+	if ti.Undo() {
+		t.Errorf("Undo without edits => true, want false")
+	}
+	if err := keys(ti, 'a'); err != nil {
+		t.Fatalf("keys => unexpected error: %v", err)
+	}
+	if ti.Redo() {
+		t.Errorf("Redo without undone edits => true, want false")
+	}
+	if !ti.Undo() {
+		t.Errorf("Undo after edit => false, want true")
+	}
+	if !ti.Redo() {
+		t.Errorf("Redo after Undo => false, want true")
+	}
+}
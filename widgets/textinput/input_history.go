@@ -0,0 +1,81 @@
+// Copyright 2019 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package textinput
+
+// input_history.go contains code that remembers the submitted values so that
+// they can be recalled.
+
+// inputHistory stores the submitted values and tracks navigation through
+// them.
+// This object isn't thread-safe.
+type inputHistory struct {
+	// size is the maximum number of stored values.
+	size int
+	// values are the submitted values, the newest one is last.
+	values []string
+
+	// pos is the index of the value currently recalled into the field.
+	// Equal to len(values) when no value is recalled.
+	pos int
+	// draft is the text that was in the field when the navigation started.
+	draft string
+}
+
+// newInputHistory returns a new inputHistory that stores up to the specified
+// number of values.
+func newInputHistory(size int) *inputHistory {
+	return &inputHistory{size: size}
+}
+
+// add adds the submitted value as the newest one and resets the navigation.
+// Empty values and values equal to the newest one aren't stored.
+func (ih *inputHistory) add(value string) {
+	if value != "" && (len(ih.values) == 0 || ih.values[len(ih.values)-1] != value) {
+		ih.values = append(ih.values, value)
+		if over := len(ih.values) - ih.size; over > 0 {
+			ih.values = ih.values[over:]
+		}
+	}
+	ih.pos = len(ih.values)
+	ih.draft = ""
+}
+
+// older returns the value older than the currently recalled one.
+// Argument current is the text currently in the field, it is remembered when
+// the navigation starts. Returns false if there is no older value.
+func (ih *inputHistory) older(current string) (string, bool) {
+	if ih.pos == 0 {
+		return "", false
+	}
+	if ih.pos == len(ih.values) {
+		ih.draft = current
+	}
+	ih.pos--
+	return ih.values[ih.pos], true
+}
+
+// newer returns the value newer than the currently recalled one. Navigating
+// past the newest value returns the text that was in the field when the
+// navigation started. Returns false if no value is recalled.
+func (ih *inputHistory) newer() (string, bool) {
+	if ih.pos == len(ih.values) {
+		return "", false
+	}
+	ih.pos++
+	if ih.pos == len(ih.values) {
+		return ih.draft, true
+	}
+	return ih.values[ih.pos], true
+}
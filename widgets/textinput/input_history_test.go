@@ -0,0 +1,221 @@
+// Copyright 2019 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package textinput
+
+import (
+	"testing"
+
+	"github.com/mum4k/termdash/keyboard"
+)
+
+// submit types the text and submits it.
+func submit(ti *TextInput, text string) error {
+	for _, r := range text {
+		if err := keys(ti, keyboard.Key(r)); err != nil {
+			return err
+		}
+	}
+	return keys(ti, keyboard.KeyEnter)
+}
+
+func TestInputHistory(t *testing.T) {
+	tests := []struct {
+		desc string
+		opts []Option
+		// submitted are the values submitted before the navigation.
+		submitted []string
+		// typed is the text typed after the submissions.
+		typed       string
+		keys        []keyboard.Key
+		wantContent string
+		wantCurPos  int
+		wantNewErr  bool
+	}{
+		{
+			desc: "fails on negative history size",
+			opts: []Option{
+				History(-1),
+			},
+			wantNewErr: true,
+		},
+		{
+			desc:       "up and down do nothing without the History option",
+			opts:       []Option{ClearOnSubmit()},
+			submitted:  []string{"abc"},
+			keys:       []keyboard.Key{keyboard.KeyArrowUp},
+			wantCurPos: 0,
+		},
+		{
+			desc:        "up does nothing when nothing was submitted",
+			opts:        []Option{History(5)},
+			typed:       "ab",
+			keys:        []keyboard.Key{keyboard.KeyArrowUp},
+			wantContent: "ab",
+			wantCurPos:  2,
+		},
+		{
+			desc:        "up recalls the newest value with the cursor at the end",
+			opts:        []Option{History(5), ClearOnSubmit()},
+			submitted:   []string{"one", "two", "three"},
+			keys:        []keyboard.Key{keyboard.KeyArrowUp},
+			wantContent: "three",
+			wantCurPos:  5,
+		},
+		{
+			desc:      "up cycles towards older values",
+			opts:      []Option{History(5), ClearOnSubmit()},
+			submitted: []string{"one", "two", "three"},
+			keys: []keyboard.Key{
+				keyboard.KeyArrowUp,
+				keyboard.KeyArrowUp,
+			},
+			wantContent: "two",
+			wantCurPos:  3,
+		},
+		{
+			desc:      "up stops at the oldest value",
+			opts:      []Option{History(5), ClearOnSubmit()},
+			submitted: []string{"one", "two", "three"},
+			keys: []keyboard.Key{
+				keyboard.KeyArrowUp,
+				keyboard.KeyArrowUp,
+				keyboard.KeyArrowUp,
+				keyboard.KeyArrowUp,
+			},
+			wantContent: "one",
+			wantCurPos:  3,
+		},
+		{
+			desc:      "down cycles towards newer values",
+			opts:      []Option{History(5), ClearOnSubmit()},
+			submitted: []string{"one", "two", "three"},
+			keys: []keyboard.Key{
+				keyboard.KeyArrowUp,
+				keyboard.KeyArrowUp,
+				keyboard.KeyArrowUp,
+				keyboard.KeyArrowDown,
+			},
+			wantContent: "two",
+			wantCurPos:  3,
+		},
+		{
+			desc:      "navigating past the newest value restores the typed text",
+			opts:      []Option{History(5), ClearOnSubmit()},
+			submitted: []string{"one", "two"},
+			typed:     "draft",
+			keys: []keyboard.Key{
+				keyboard.KeyArrowUp,
+				keyboard.KeyArrowUp,
+				keyboard.KeyArrowDown,
+				keyboard.KeyArrowDown,
+			},
+			wantContent: "draft",
+			wantCurPos:  5,
+		},
+		{
+			desc:      "down does nothing when no value is recalled",
+			opts:      []Option{History(5), ClearOnSubmit()},
+			submitted: []string{"one"},
+			typed:     "draft",
+			keys: []keyboard.Key{
+				keyboard.KeyArrowDown,
+			},
+			wantContent: "draft",
+			wantCurPos:  5,
+		},
+		{
+			desc:      "remembers only the configured number of values",
+			opts:      []Option{History(2), ClearOnSubmit()},
+			submitted: []string{"one", "two", "three"},
+			keys: []keyboard.Key{
+				keyboard.KeyArrowUp,
+				keyboard.KeyArrowUp,
+				keyboard.KeyArrowUp,
+			},
+			wantContent: "two",
+			wantCurPos:  3,
+		},
+		{
+			desc:      "doesn't remember empty and repeated values",
+			opts:      []Option{History(5), ClearOnSubmit()},
+			submitted: []string{"one", "", "two", "two"},
+			keys: []keyboard.Key{
+				keyboard.KeyArrowUp,
+				keyboard.KeyArrowUp,
+			},
+			wantContent: "one",
+			wantCurPos:  3,
+		},
+		{
+			desc:      "recalled value can be edited and submitted",
+			opts:      []Option{History(5), ClearOnSubmit()},
+			submitted: []string{"one", "two"},
+			keys: []keyboard.Key{
+				keyboard.KeyArrowUp,
+				keyboard.KeyArrowUp,
+				'!',
+				keyboard.KeyEnter,
+				keyboard.KeyArrowUp,
+			},
+			wantContent: "one!",
+			wantCurPos:  4,
+		},
+		{
+			desc:      "recalling a value can be undone",
+			opts:      []Option{History(5), ClearOnSubmit()},
+			submitted: []string{"one"},
+			typed:     "draft",
+			keys: []keyboard.Key{
+				keyboard.KeyArrowUp,
+				keyboard.KeyCtrlZ,
+			},
+			wantContent: "draft",
+			wantCurPos:  5,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ti, err := New(tc.opts...)
+			if (err != nil) != tc.wantNewErr {
+				t.Errorf("New => unexpected error: %v, wantNewErr: %v", err, tc.wantNewErr)
+			}
+			if err != nil {
+				return
+			}
+
+			for _, s := range tc.submitted {
+				if err := submit(ti, s); err != nil {
+					t.Fatalf("submit => unexpected error: %v", err)
+				}
+			}
+			for _, r := range tc.typed {
+				if err := keys(ti, keyboard.Key(r)); err != nil {
+					t.Fatalf("keys => unexpected error: %v", err)
+				}
+			}
+			if err := keys(ti, tc.keys...); err != nil {
+				t.Fatalf("keys => unexpected error: %v", err)
+			}
+
+			if got := ti.Read(); got != tc.wantContent {
+				t.Errorf("Read => %q, want %q", got, tc.wantContent)
+			}
+			if got := ti.editor.curDataPos; got != tc.wantCurPos {
+				t.Errorf("curDataPos => %d, want %d", got, tc.wantCurPos)
+			}
+		})
+	}
+}
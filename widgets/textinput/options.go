@@ -19,9 +19,11 @@ package textinput
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/mum4k/termdash/align"
 	"github.com/mum4k/termdash/cell"
+	"github.com/mum4k/termdash/keyboard"
 	"github.com/mum4k/termdash/linestyle"
 	"github.com/mum4k/termdash/private/runewidth"
 	"github.com/mum4k/termdash/private/wrap"
@@ -48,6 +50,7 @@ type options struct {
 	placeHolderColor cell.Color
 	highlightedColor cell.Color
 	cursorColor      cell.Color
+	cursorBlink      time.Duration
 	border           linestyle.LineStyle
 	borderColor      cell.Color
 
@@ -57,15 +60,21 @@ type options struct {
 	labelCellOpts []cell.Option
 	labelAlign    align.Horizontal
 
-	placeHolder  string
-	hideTextWith rune
-	defaultText  string
+	placeHolder                string
+	showPlaceHolderWhenFocused bool
+	hideTextWith               rune
+	defaultText                string
 
 	filter                   FilterFn
 	onSubmit                 SubmitFn
 	onChange                 ChangeFn
 	clearOnSubmit            bool
 	exclusiveKeyboardOnFocus bool
+
+	undoKey keyboard.Key
+	redoKey keyboard.Key
+
+	historySize int
 }
 
 // validate validates the provided options.
@@ -94,6 +103,15 @@ func (o *options) validate() error {
 			}
 		}
 	}
+	if min, size := 0, o.historySize; size < min {
+		return fmt.Errorf("invalid History(%d), must be value in range %d <= value", size, min)
+	}
+	if o.cursorBlink < 0 {
+		return fmt.Errorf("invalid CursorBlink(%v), must be zero or a positive duration", o.cursorBlink)
+	}
+	if o.undoKey == o.redoKey {
+		return fmt.Errorf("invalid UndoKeys, the undo and redo keys must be different, both are %v", o.undoKey)
+	}
 	return nil
 }
 
@@ -105,6 +123,8 @@ func newOptions() *options {
 		highlightedColor: cell.ColorNumber(DefaultHighlightedColorNumber),
 		cursorColor:      cell.ColorNumber(DefaultCursorColorNumber),
 		labelAlign:       DefaultLabelAlign,
+		undoKey:          DefaultUndoKey,
+		redoKey:          DefaultRedoKey,
 	}
 }
 
@@ -208,13 +228,23 @@ func LabelAlign(la align.Horizontal) Option {
 }
 
 // PlaceHolder sets text to be displayed in the input field when it is empty.
-// This text disappears when the text input field becomes focused.
+// This text disappears when the text input field becomes focused, unless the
+// ShowPlaceHolderWhenFocused option is provided.
 func PlaceHolder(text string) Option {
 	return option(func(opts *options) {
 		opts.placeHolder = text
 	})
 }
 
+// ShowPlaceHolderWhenFocused keeps the placeholder text visible while the
+// text input field is focused and empty. The placeholder is dimmed while the
+// field is focused and disappears once the user types the first character.
+func ShowPlaceHolderWhenFocused() Option {
+	return option(func(opts *options) {
+		opts.showPlaceHolderWhenFocused = true
+	})
+}
+
 // DefaultPlaceHolderColorNumber is the default color number for the
 // PlaceHolderColor option.
 const DefaultPlaceHolderColorNumber = 194
@@ -244,6 +274,7 @@ type FilterFn func(rune) bool
 
 // Filter sets a function that will be used to filter characters the user can
 // input.
+// Functions like FilterNumeric or FilterAlphanumeric return ready-made filters.
 func Filter(fn FilterFn) Option {
 	return option(func(opts *options) {
 		opts.filter = fn
@@ -285,6 +316,19 @@ func OnChange(fn ChangeFn) Option {
 	})
 }
 
+// CursorBlink makes the cursor alternate between visible and hidden while the
+// text input field is focused, the cursor changes its state once the interval
+// elapses. The cursor is visible while the user types and always visible when
+// the field isn't focused.
+// The state of the cursor is advanced on the ticks of the dashboard, see
+// widgetapi.Ticker, so the cursor blinks at most once per redraw interval.
+// Setting the interval to zero disables blinking, this is the default.
+func CursorBlink(interval time.Duration) Option {
+	return option(func(opts *options) {
+		opts.cursorBlink = interval
+	})
+}
+
 // ClearOnSubmit sets the text input to be cleared when a submit of the content
 // is triggered by the user pressing the Enter key.
 func ClearOnSubmit() Option {
@@ -309,3 +353,32 @@ func DefaultText(text string) Option {
 		opts.defaultText = text
 	})
 }
+
+// The default keys that undo and redo the edits.
+const (
+	DefaultUndoKey = keyboard.KeyCtrlZ
+	DefaultRedoKey = keyboard.KeyCtrlY
+)
+
+// UndoKeys configures the keyboard keys that undo and redo the edits of the
+// text in the input field. The keys must be different.
+// Defaults to DefaultUndoKey and DefaultRedoKey.
+func UndoKeys(undo, redo keyboard.Key) Option {
+	return option(func(opts *options) {
+		opts.undoKey = undo
+		opts.redoKey = redo
+	})
+}
+
+// History makes the text input field remember up to the specified number of
+// most recently submitted values. Like in a shell prompt, the Up and Down
+// arrow keys cycle through the remembered values, replacing the text in the
+// field. Navigating past the newest value restores the text that was being
+// edited. Empty values and repeated submissions of the same value are
+// remembered only once.
+// Set to zero to disable, which is the default.
+func History(size int) Option {
+	return option(func(opts *options) {
+		opts.historySize = size
+	})
+}
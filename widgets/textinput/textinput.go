@@ -19,6 +19,7 @@ import (
 	"image"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/mum4k/termdash/align"
 	"github.com/mum4k/termdash/cell"
@@ -42,7 +43,8 @@ import (
 //
 // The text can be submitted by pressing enter or read at any time by calling
 // Read. The text input field can be navigated using arrows, the Home and End
-// button and using mouse.
+// button and using mouse. The edits can be undone and redone, by default using
+// Ctrl+Z and Ctrl+Y.
 //
 // Implements widgetapi.Widget. This object is thread-safe.
 type TextInput struct {
@@ -52,10 +54,23 @@ type TextInput struct {
 	// editor tracks the edits and the state of the text input field.
 	editor *fieldEditor
 
+	// history are the previously submitted values, nil if the History option
+	// wasn't provided.
+	history *inputHistory
+
 	// forField is the area that was occupied by the text input field last
 	// time Draw() was called.
 	forField image.Rectangle
 
+	// focused indicates if the widget was focused last time Draw() was
+	// called.
+	focused bool
+	// cursorHidden indicates if the blinking cursor is currently hidden, see
+	// the CursorBlink option.
+	cursorHidden bool
+	// blinkedAt is the time when the blinking cursor last changed its state.
+	blinkedAt time.Time
+
 	// opts are the provided options.
 	opts *options
 }
@@ -76,6 +91,10 @@ func New(opts ...Option) (*TextInput, error) {
 	for _, r := range ti.opts.defaultText {
 		ti.editor.insert(r)
 	}
+	ti.editor.clearHistory()
+	if opt.historySize > 0 {
+		ti.history = newInputHistory(opt.historySize)
+	}
 	return ti, nil
 }
 
@@ -108,6 +127,28 @@ func (ti *TextInput) ReadAndClear() string {
 	return c
 }
 
+// Undo reverts the last edit of the text, restoring the content and the cursor
+// position. Consecutive insertions of runes typed in quick succession are
+// undone together. Clearing the content, e.g. by calling ReadAndClear, also
+// clears the edit history.
+// Returns false if there was nothing to undo.
+func (ti *TextInput) Undo() bool {
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+
+	return ti.editor.undo()
+}
+
+// Redo reapplies the last edit that was reverted by Undo.
+// Returns false if there was nothing to redo. Any new edit made after Undo
+// discards the edits that could have been redone.
+func (ti *TextInput) Redo() bool {
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+
+	return ti.editor.redo()
+}
+
 // drawLabel draws the text label in the area.
 func (ti *TextInput) drawLabel(cvs *canvas.Canvas, labelAr image.Rectangle) error {
 	start, err := alignfor.Text(labelAr, ti.opts.label, ti.opts.labelAlign, align.VerticalMiddle)
@@ -139,12 +180,18 @@ func (ti *TextInput) drawField(cvs *canvas.Canvas, text string) error {
 	)
 }
 
-// drawCursor draws the cursor within the text input field.
-func (ti *TextInput) drawCursor(cvs *canvas.Canvas, curPos int) error {
-	p := image.Point{
+// cursorPoint returns the point on the canvas where the cursor at the
+// specified position within the text input field is.
+func (ti *TextInput) cursorPoint(curPos int) image.Point {
+	return image.Point{
 		curPos + ti.forField.Min.X,
 		ti.forField.Min.Y,
 	}
+}
+
+// drawCursor draws the cursor within the text input field.
+func (ti *TextInput) drawCursor(cvs *canvas.Canvas, curPos int) error {
+	p := ti.cursorPoint(curPos)
 	if err := cvs.SetCellOpts(
 		p,
 		cell.FgColor(ti.opts.highlightedColor),
@@ -160,6 +207,30 @@ func (ti *TextInput) drawCursor(cvs *canvas.Canvas, curPos int) error {
 	return nil
 }
 
+// Tick advances the state of the blinking cursor.
+// Implements widgetapi.Ticker.Tick.
+func (ti *TextInput) Tick() error {
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+
+	if ti.opts.cursorBlink == 0 || !ti.focused {
+		return nil
+	}
+	if now := timeNow(); now.Sub(ti.blinkedAt) >= ti.opts.cursorBlink {
+		ti.cursorHidden = !ti.cursorHidden
+		ti.blinkedAt = now
+	}
+	return nil
+}
+
+// showCursor makes the blinking cursor visible and restarts the blink
+// interval.
+// The caller must hold ti.mu.
+func (ti *TextInput) showCursor() {
+	ti.cursorHidden = false
+	ti.blinkedAt = timeNow()
+}
+
 // Draw draws the TextInput widget onto the canvas.
 // Implements widgetapi.Widget.Draw.
 func (ti *TextInput) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
@@ -202,22 +273,46 @@ func (ti *TextInput) Draw(cvs *canvas.Canvas, meta *widgetapi.Meta) error {
 		return err
 	}
 
-	if meta.Focused {
-		if err := ti.drawCursor(cvs, curPos); err != nil {
+	if ti.opts.placeHolder != "" && text == "" && (!meta.Focused || ti.opts.showPlaceHolderWhenFocused) {
+		if err := ti.drawPlaceHolder(cvs, meta.Focused); err != nil {
 			return err
 		}
-	} else if ti.opts.placeHolder != "" && text == "" {
-		if err := draw.Text(
-			cvs, ti.opts.placeHolder, ti.forField.Min,
-			draw.TextMaxX(ti.forField.Max.X),
-			draw.TextCellOpts(cell.FgColor(ti.opts.placeHolderColor)),
-		); err != nil {
-			return err
+	}
+
+	if meta.Focused && !ti.focused {
+		ti.showCursor()
+	}
+	ti.focused = meta.Focused
+	if !meta.Focused {
+		ti.cursorHidden = false
+	}
+
+	if meta.Focused {
+		if !ti.cursorHidden {
+			if err := ti.drawCursor(cvs, curPos); err != nil {
+				return err
+			}
 		}
+		// Place the terminal cursor at the edit position, e.g. for IME.
+		meta.SetCursor(ti.cursorPoint(curPos))
 	}
 	return nil
 }
 
+// drawPlaceHolder draws the placeholder text into the field.
+// The placeholder is dimmed while the field is focused.
+func (ti *TextInput) drawPlaceHolder(cvs *canvas.Canvas, focused bool) error {
+	cOpts := []cell.Option{cell.FgColor(ti.opts.placeHolderColor)}
+	if focused {
+		cOpts = append(cOpts, cell.Dim())
+	}
+	return draw.Text(
+		cvs, ti.opts.placeHolder, ti.forField.Min,
+		draw.TextMaxX(ti.forField.Max.X),
+		draw.TextCellOpts(cOpts...),
+	)
+}
+
 // keyboard processes keyboard events.
 // Returns a bool indicating if the content was submitted and the text in the
 // field at submission time.
@@ -226,7 +321,14 @@ func (ti *TextInput) keyboard(k *terminalapi.Keyboard) (bool, string) {
 	ti.mu.Lock()
 	defer ti.mu.Unlock()
 
+	ti.showCursor()
 	switch k.Key {
+	case ti.opts.undoKey:
+		ti.editor.undo()
+
+	case ti.opts.redoKey:
+		ti.editor.redo()
+
 	case keyboard.KeyBackspace, keyboard.KeyBackspace2:
 		ti.editor.deleteBefore()
 
@@ -245,8 +347,25 @@ func (ti *TextInput) keyboard(k *terminalapi.Keyboard) (bool, string) {
 	case keyboard.KeyEnd, keyboard.KeyCtrlE:
 		ti.editor.cursorEnd()
 
+	case keyboard.KeyArrowUp:
+		if ti.history != nil {
+			if text, ok := ti.history.older(ti.editor.content()); ok {
+				ti.editor.setContent(text)
+			}
+		}
+
+	case keyboard.KeyArrowDown:
+		if ti.history != nil {
+			if text, ok := ti.history.newer(); ok {
+				ti.editor.setContent(text)
+			}
+		}
+
 	case keyboard.KeyEnter:
 		text := ti.editor.content()
+		if ti.history != nil {
+			ti.history.add(text)
+		}
 		if ti.opts.clearOnSubmit {
 			ti.editor.reset()
 		}
@@ -19,6 +19,7 @@ import (
 	"image"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/mum4k/termdash/align"
@@ -103,6 +104,13 @@ func TestTextInput(t *testing.T) {
 			},
 			wantNewErr: true,
 		},
+		{
+			desc: "fails on negative CursorBlink",
+			opts: []Option{
+				CursorBlink(-1),
+			},
+			wantNewErr: true,
+		},
 		{
 			desc: "fails on HideTextWith control rune",
 			opts: []Option{
@@ -117,6 +125,13 @@ func TestTextInput(t *testing.T) {
 			},
 			wantNewErr: true,
 		},
+		{
+			desc: "fails when the undo and redo keys are the same",
+			opts: []Option{
+				UndoKeys(keyboard.KeyCtrlZ, keyboard.KeyCtrlZ),
+			},
+			wantNewErr: true,
+		},
 		{
 			desc: "fails on invalid DefaultText which has control characters",
 			opts: []Option{
@@ -271,6 +286,115 @@ func TestTextInput(t *testing.T) {
 				return ft
 			},
 		},
+		{
+			desc: "doesn't draw place holder text when empty and focused",
+			opts: []Option{
+				PlaceHolder("holder"),
+			},
+			canvas: image.Rect(0, 0, 10, 1),
+			meta: &widgetapi.Meta{
+				Focused: true,
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetAreaCells(
+					cvs,
+					cvs.Area(),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testcanvas.MustSetCell(
+					cvs,
+					image.Point{0, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "draws dimmed place holder text when empty and focused with ShowPlaceHolderWhenFocused",
+			opts: []Option{
+				PlaceHolder("holder"),
+				ShowPlaceHolderWhenFocused(),
+			},
+			canvas: image.Rect(0, 0, 10, 1),
+			meta: &widgetapi.Meta{
+				Focused: true,
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetAreaCells(
+					cvs,
+					cvs.Area(),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testdraw.MustText(
+					cvs,
+					"holder",
+					image.Point{0, 0},
+					draw.TextCellOpts(
+						cell.FgColor(cell.ColorNumber(DefaultPlaceHolderColorNumber)),
+						cell.Dim(),
+					),
+				)
+				testcanvas.MustSetCell(
+					cvs,
+					image.Point{0, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
+		{
+			desc: "hides place holder text after typing with ShowPlaceHolderWhenFocused",
+			opts: []Option{
+				PlaceHolder("holder"),
+				ShowPlaceHolderWhenFocused(),
+			},
+			canvas: image.Rect(0, 0, 10, 1),
+			meta: &widgetapi.Meta{
+				Focused: true,
+			},
+			events: []terminalapi.Event{
+				&terminalapi.Keyboard{Key: 'a'},
+			},
+			want: func(size image.Point) *faketerm.Terminal {
+				ft := faketerm.MustNew(size)
+				cvs := testcanvas.MustNew(ft.Area())
+
+				testcanvas.MustSetAreaCells(
+					cvs,
+					cvs.Area(),
+					textFieldRune,
+					cell.BgColor(cell.ColorNumber(DefaultFillColorNumber)),
+				)
+				testdraw.MustText(
+					cvs,
+					"a",
+					image.Point{0, 0},
+				)
+				testcanvas.MustSetCell(
+					cvs,
+					image.Point{1, 0},
+					cursorRune,
+					cell.BgColor(cell.ColorNumber(DefaultCursorColorNumber)),
+					cell.FgColor(cell.ColorNumber(DefaultHighlightedColorNumber)),
+				)
+				testcanvas.MustApply(cvs, ft)
+				return ft
+			},
+		},
 		{
 			desc: "sets custom place holder text color",
 			opts: []Option{
@@ -1658,6 +1782,190 @@ func TestTextInputRead(t *testing.T) {
 	}
 }
 
+func TestTextInputCursor(t *testing.T) {
+	tests := []struct {
+		desc       string
+		opts       []Option
+		meta       *widgetapi.Meta
+		events     []*terminalapi.Keyboard
+		wantCursor image.Point
+		wantOk     bool
+	}{
+		{
+			desc: "doesn't request the cursor when not focused",
+			meta: &widgetapi.Meta{},
+		},
+		{
+			desc:   "requests the cursor at the start of an empty field",
+			meta:   &widgetapi.Meta{Focused: true},
+			wantOk: true,
+		},
+		{
+			desc: "requests the cursor at the edit position",
+			meta: &widgetapi.Meta{Focused: true},
+			events: []*terminalapi.Keyboard{
+				{Key: 'a'},
+				{Key: 'b'},
+				{Key: 'c'},
+				{Key: keyboard.KeyArrowLeft},
+			},
+			wantCursor: image.Point{2, 0},
+			wantOk:     true,
+		},
+		{
+			desc: "accounts for the label and the border",
+			opts: []Option{
+				Label("ab"),
+				Border(linestyle.Light),
+			},
+			meta:       &widgetapi.Meta{Focused: true},
+			wantCursor: image.Point{3, 1},
+			wantOk:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ti, err := New(tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+			for _, ev := range tc.events {
+				if err := ti.Keyboard(ev, &widgetapi.EventMeta{}); err != nil {
+					t.Fatalf("Keyboard => unexpected error: %v", err)
+				}
+			}
+
+			cvs, err := canvas.New(image.Rect(0, 0, 10, 3))
+			if err != nil {
+				t.Fatalf("canvas.New => unexpected error: %v", err)
+			}
+			if err := ti.Draw(cvs, tc.meta); err != nil {
+				t.Fatalf("Draw => unexpected error: %v", err)
+			}
+
+			gotCursor, gotOk := tc.meta.Cursor()
+			if gotCursor != tc.wantCursor || gotOk != tc.wantOk {
+				t.Errorf("Cursor => %v, %v, want %v, %v", gotCursor, gotOk, tc.wantCursor, tc.wantOk)
+			}
+		})
+	}
+}
+
+func TestCursorBlink(t *testing.T) {
+	// step is performed on the text input in order.
+	type step struct {
+		// advance is added to the current time before the tick.
+		advance time.Duration
+		// key if not zero is sent to the text input before the tick.
+		key keyboard.Key
+		// focused is the focus of the widget during the draw.
+		focused bool
+		// wantCursor indicates if the cursor should be drawn.
+		wantCursor bool
+	}
+
+	tests := []struct {
+		desc  string
+		opts  []Option
+		steps []step
+	}{
+		{
+			desc: "cursor doesn't blink by default",
+			steps: []step{
+				{focused: true, wantCursor: true},
+				{advance: time.Second, focused: true, wantCursor: true},
+				{advance: time.Second, focused: true, wantCursor: true},
+			},
+		},
+		{
+			desc: "cursor toggles when the interval elapses",
+			opts: []Option{
+				CursorBlink(500 * time.Millisecond),
+			},
+			steps: []step{
+				{focused: true, wantCursor: true},
+				{advance: 200 * time.Millisecond, focused: true, wantCursor: true},
+				{advance: 300 * time.Millisecond, focused: true, wantCursor: false},
+				{advance: 400 * time.Millisecond, focused: true, wantCursor: false},
+				{advance: 100 * time.Millisecond, focused: true, wantCursor: true},
+				{advance: 500 * time.Millisecond, focused: true, wantCursor: false},
+			},
+		},
+		{
+			desc: "cursor is visible again after blur",
+			opts: []Option{
+				CursorBlink(500 * time.Millisecond),
+			},
+			steps: []step{
+				{focused: true, wantCursor: true},
+				{advance: 500 * time.Millisecond, focused: true, wantCursor: false},
+				{advance: 500 * time.Millisecond, focused: false, wantCursor: false},
+				{advance: 500 * time.Millisecond, focused: false, wantCursor: false},
+				{focused: true, wantCursor: true},
+				{advance: 400 * time.Millisecond, focused: true, wantCursor: true},
+				{advance: 100 * time.Millisecond, focused: true, wantCursor: false},
+			},
+		},
+		{
+			desc: "typing makes the cursor visible",
+			opts: []Option{
+				CursorBlink(500 * time.Millisecond),
+			},
+			steps: []step{
+				{focused: true, wantCursor: true},
+				{advance: 500 * time.Millisecond, focused: true, wantCursor: false},
+				{key: 'a', focused: true, wantCursor: true},
+				{advance: 400 * time.Millisecond, focused: true, wantCursor: true},
+				{advance: 100 * time.Millisecond, focused: true, wantCursor: false},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+			timeNow = func() time.Time { return now }
+			defer func() { timeNow = time.Now }()
+
+			ti, err := New(tc.opts...)
+			if err != nil {
+				t.Fatalf("New => unexpected error: %v", err)
+			}
+
+			for i, s := range tc.steps {
+				now = now.Add(s.advance)
+				if s.key != 0 {
+					if err := ti.Keyboard(&terminalapi.Keyboard{Key: s.key}, &widgetapi.EventMeta{}); err != nil {
+						t.Fatalf("step %d: Keyboard => unexpected error: %v", i, err)
+					}
+				}
+				if err := ti.Tick(); err != nil {
+					t.Fatalf("step %d: Tick => unexpected error: %v", i, err)
+				}
+
+				cvs, err := canvas.New(image.Rect(0, 0, 10, 1))
+				if err != nil {
+					t.Fatalf("canvas.New => unexpected error: %v", err)
+				}
+				meta := &widgetapi.Meta{Focused: s.focused}
+				if err := ti.Draw(cvs, meta); err != nil {
+					t.Fatalf("step %d: Draw => unexpected error: %v", i, err)
+				}
+
+				p, _ := meta.Cursor()
+				c, err := cvs.Cell(p)
+				if err != nil {
+					t.Fatalf("step %d: Cell => unexpected error: %v", i, err)
+				}
+				if got := c.Opts.BgColor == ti.opts.cursorColor; got != s.wantCursor {
+					t.Errorf("step %d: cursor drawn => %v, want %v", i, got, s.wantCursor)
+				}
+			}
+		})
+	}
+}
+
 func TestOptions(t *testing.T) {
 	tests := []struct {
 		desc string